@@ -0,0 +1,256 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/internal/lib/cron"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const (
+	jobGapsTimeout       = time.Minute * 1
+	jobGapsReplayTimeout = time.Minute * 1
+	defaultJobGapsRange  = time.Hour * 24 * 7
+)
+
+type gapsCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	namespaceName string
+	startDate     string
+	endDate       string
+	replay        bool
+
+	projectName string
+	host        string
+}
+
+// NewGapsCommand initializes command to report the missing runs of jobs
+func NewGapsCommand() *cobra.Command {
+	gaps := &gapsCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "gaps",
+		Short: "Report the scheduled runs of jobs without a successful run within a time range",
+		Long: "Report the scheduled times expected from the schedule of every enabled job in the namespace, or in the project, " +
+			"which have no successful run within a time range. Replay requests covering the gaps of every job, one per contiguous range of missing runs, can be created with --replay.",
+		Example: `optimus job gaps [--namespace <namespace_name>] [--start-date "2006-01-02T15:04:05Z" --end-date "2006-01-09T15:04:05Z"] [--replay]`,
+		Args:    cobra.NoArgs,
+		RunE:    gaps.RunE,
+		PreRunE: gaps.PreRunE,
+	}
+	gaps.injectFlags(cmd)
+	return cmd
+}
+
+func (g *gapsCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&g.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&g.namespaceName, "namespace", "n", "", "Namespace of the jobs, all namespaces when not given")
+	cmd.Flags().StringVar(&g.startDate, "start-date", "", "Start of the range of scheduled time, defaults to 7 days before end date")
+	cmd.Flags().StringVar(&g.endDate, "end-date", "", "End of the range of scheduled time, defaults to now")
+	cmd.Flags().BoolVar(&g.replay, "replay", false, "Create replay requests covering the gaps of every job, one per contiguous range of missing runs")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&g.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&g.host, "host", "", "Optimus service endpoint url")
+}
+
+func (g *gapsCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(g.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if g.projectName == "" {
+		g.projectName = conf.Project.Name
+	}
+	if g.host == "" {
+		g.host = conf.Host
+	}
+	return nil
+}
+
+func (g *gapsCommand) RunE(_ *cobra.Command, _ []string) error {
+	req, err := g.createJobRunGapsRequest()
+	if err != nil {
+		return err
+	}
+
+	conn, err := g.connection.Create(g.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	run := pb.NewJobRunServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), jobGapsTimeout)
+	defer dialCancel()
+
+	resp, err := run.GetJobRunGaps(ctx, req)
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for job run gaps of project %s: %w", g.projectName, err)
+	}
+
+	if len(resp.GetGaps()) == 0 {
+		g.logger.Info("No missing runs found from %s to %s", req.GetStartDate().AsTime().Format(time.RFC3339), req.GetEndDate().AsTime().Format(time.RFC3339))
+		return nil
+	}
+	g.logger.Warn("Missing runs from %s to %s:\n%s", req.GetStartDate().AsTime().Format(time.RFC3339),
+		req.GetEndDate().AsTime().Format(time.RFC3339), stringifyJobRunGaps(resp.GetGaps()))
+
+	if !g.replay {
+		return nil
+	}
+	g.replayGaps(conn, resp.GetGaps())
+	return nil
+}
+
+// replayGaps creates a replay per contiguous range of the missing runs of every job, so the successful runs between
+// the ranges are not replayed
+func (g *gapsCommand) replayGaps(conn *grpc.ClientConn, gaps []*pb.JobRunGap) {
+	jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+	replayService := pb.NewReplayServiceClient(conn)
+	for _, gap := range gaps {
+		schedule, err := g.getJobSchedule(jobSpecService, gap)
+		if err != nil {
+			g.logger.Error("Unable to replay the missing runs of job %s: %s", gap.GetJobName(), err)
+			continue
+		}
+		for _, scheduledAt := range contiguousRanges(schedule, gap.GetScheduledAt()) {
+			g.createReplay(replayService, gap, scheduledAt[0], scheduledAt[len(scheduledAt)-1])
+		}
+	}
+}
+
+func (g *gapsCommand) getJobSchedule(jobSpecService pb.JobSpecificationServiceClient, gap *pb.JobRunGap) (*cron.ScheduleSpec, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jobGapsTimeout)
+	defer cancel()
+
+	resp, err := jobSpecService.GetJobSpecification(ctx, &pb.GetJobSpecificationRequest{
+		ProjectName:   g.projectName,
+		NamespaceName: gap.GetNamespaceName(),
+		JobName:       gap.GetJobName(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("request failed for job specification: %w", err)
+	}
+	return cron.ParseCronSchedule(resp.GetSpec().GetInterval())
+}
+
+func (g *gapsCommand) createReplay(replayService pb.ReplayServiceClient, gap *pb.JobRunGap, startTime, endTime *timestamppb.Timestamp) {
+	ctx, cancel := context.WithTimeout(context.Background(), jobGapsReplayTimeout)
+	defer cancel()
+
+	replayResp, err := replayService.Replay(ctx, &pb.ReplayRequest{
+		ProjectName:   g.projectName,
+		JobName:       gap.GetJobName(),
+		NamespaceName: gap.GetNamespaceName(),
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Description:   "replay of missing runs",
+	})
+	if err != nil {
+		g.logger.Error("Replay request failed for job %s from %s to %s: %s", gap.GetJobName(),
+			startTime.AsTime().Format(time.RFC3339), endTime.AsTime().Format(time.RFC3339), err)
+		return
+	}
+	g.logger.Info("Replay request for job %s from %s to %s is created with ID %s", gap.GetJobName(),
+		startTime.AsTime().Format(time.RFC3339), endTime.AsTime().Format(time.RFC3339), replayResp.GetId())
+}
+
+// contiguousRanges splits the missing scheduled times, in ascending order, where a scheduled time of the job between
+// them is not missing
+func contiguousRanges(schedule *cron.ScheduleSpec, scheduledAt []*timestamppb.Timestamp) [][]*timestamppb.Timestamp {
+	var ranges [][]*timestamppb.Timestamp
+	for i, missing := range scheduledAt {
+		if i == 0 || !schedule.Next(scheduledAt[i-1].AsTime()).Equal(missing.AsTime()) {
+			ranges = append(ranges, nil)
+		}
+		ranges[len(ranges)-1] = append(ranges[len(ranges)-1], missing)
+	}
+	return ranges
+}
+
+func (g *gapsCommand) createJobRunGapsRequest() (*pb.GetJobRunGapsRequest, error) {
+	end := time.Now().UTC()
+	if g.endDate != "" {
+		var err error
+		end, err = time.Parse(time.RFC3339, g.endDate)
+		if err != nil {
+			return nil, fmt.Errorf("end-date %w", err)
+		}
+	}
+	start := end.Add(-defaultJobGapsRange)
+	if g.startDate != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, g.startDate)
+		if err != nil {
+			return nil, fmt.Errorf("start-date %w", err)
+		}
+	}
+	return &pb.GetJobRunGapsRequest{
+		ProjectName:   g.projectName,
+		NamespaceName: g.namespaceName,
+		StartDate:     timestamppb.New(start),
+		EndDate:       timestamppb.New(end),
+	}, nil
+}
+
+func stringifyJobRunGaps(gaps []*pb.JobRunGap) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"namespace",
+		"job",
+		"missing runs",
+		"scheduled at",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, gap := range gaps {
+		scheduledAt := make([]string, len(gap.GetScheduledAt()))
+		for i, missing := range gap.GetScheduledAt() {
+			scheduledAt[i] = missing.AsTime().Format(time.RFC3339)
+		}
+		table.Append([]string{
+			gap.GetNamespaceName(),
+			gap.GetJobName(),
+			strconv.Itoa(len(scheduledAt)),
+			strings.Join(scheduledAt, "\n"),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
@@ -0,0 +1,34 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/raystack/optimus/internal/lib/cron"
+)
+
+func TestContiguousRanges(t *testing.T) {
+	schedule, err := cron.ParseCronSchedule("0 2 * * *")
+	assert.NoError(t, err)
+	scheduledOn := func(day int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2023, 1, day, 2, 0, 0, 0, time.UTC))
+	}
+
+	t.Run("returns nothing when there is no missing run", func(t *testing.T) {
+		assert.Empty(t, contiguousRanges(schedule, nil))
+	})
+	t.Run("returns a range per missing runs without a scheduled time between them", func(t *testing.T) {
+		ranges := contiguousRanges(schedule, []*timestamppb.Timestamp{
+			scheduledOn(1), scheduledOn(2), scheduledOn(3), scheduledOn(5), scheduledOn(8), scheduledOn(9),
+		})
+
+		assert.Equal(t, [][]*timestamppb.Timestamp{
+			{scheduledOn(1), scheduledOn(2), scheduledOn(3)},
+			{scheduledOn(5)},
+			{scheduledOn(8), scheduledOn(9)},
+		}, ranges)
+	})
+}
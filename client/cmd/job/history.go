@@ -0,0 +1,213 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/client/local/model"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const historyTimeout = time.Minute * 1
+
+type historyCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	noDiff bool
+
+	projectName string
+	host        string
+}
+
+// NewHistoryCommand initializes command to show the versions of a job spec
+func NewHistoryCommand() *cobra.Command {
+	history := &historyCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the versions of a job specification and what changed between them",
+		Long: "Every change of a job specification is recorded as a version along with who changed it and through what. " +
+			"The versions are listed latest first, followed by the diff of each version against the one before it.",
+		Example: `optimus job history <job_name> [--no-diff]`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    history.RunE,
+		PreRunE: history.PreRunE,
+	}
+	history.injectFlags(cmd)
+	return cmd
+}
+
+func (h *historyCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&h.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().BoolVar(&h.noDiff, "no-diff", false, "Only list the versions without the diff between them")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&h.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&h.host, "host", "", "Optimus service endpoint url")
+}
+
+func (h *historyCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(h.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if h.projectName == "" {
+		h.projectName = conf.Project.Name
+	}
+	if h.host == "" {
+		h.host = conf.Host
+	}
+	return nil
+}
+
+func (h *historyCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+
+	conn, err := h.connection.Create(h.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), historyTimeout)
+	defer dialCancel()
+
+	resp, err := jobSpecService.GetJobSpecificationHistory(ctx, &pb.GetJobSpecificationHistoryRequest{
+		ProjectName: h.projectName,
+		JobName:     jobName,
+	})
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for history of job %s: %w", jobName, err)
+	}
+
+	versions := resp.GetVersions()
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].GetVersion() > versions[j].GetVersion()
+	})
+	h.printVersions(versions)
+	if h.noDiff {
+		return nil
+	}
+
+	for i := 0; i < len(versions); i++ {
+		var previous *pb.JobSpecificationVersion
+		if i+1 < len(versions) {
+			previous = versions[i+1]
+		}
+		diff, err := diffVersions(previous, versions[i])
+		if err != nil {
+			return err
+		}
+		h.logger.Info("\n> version %d", versions[i].GetVersion())
+		if diff == "" {
+			h.logger.Info("no change in the specification")
+			continue
+		}
+		h.logger.Info(diff)
+	}
+	return nil
+}
+
+func (h *historyCommand) printVersions(versions []*pb.JobSpecificationVersion) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Version", "Namespace", "Changed By", "Source", "Created At"})
+	for _, version := range versions {
+		changedBy := version.GetChangedBy()
+		if changedBy == "" {
+			changedBy = "-"
+		}
+		table.Append([]string{
+			fmt.Sprintf("%d", version.GetVersion()),
+			version.GetNamespaceName(),
+			changedBy,
+			version.GetSource(),
+			version.GetCreatedAt().AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+	h.logger.Info("\nFound %d versions.", len(versions))
+}
+
+// diffVersions returns the unified diff of the spec and assets of the current version against the previous one,
+// the whole current version is reported as added when there is no previous version
+func diffVersions(previous, current *pb.JobSpecificationVersion) (string, error) {
+	var previousText, previousName string
+	if previous != nil {
+		text, err := versionText(previous)
+		if err != nil {
+			return "", err
+		}
+		previousText = text
+		previousName = fmt.Sprintf("version %d", previous.GetVersion())
+	}
+	currentText, err := versionText(current)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(previousText),
+		B:        difflib.SplitLines(currentText),
+		FromFile: previousName,
+		ToFile:   fmt.Sprintf("version %d", current.GetVersion()),
+		Context:  3,
+	})
+}
+
+func versionText(version *pb.JobSpecificationVersion) (string, error) {
+	jobSpec := model.ToJobSpec(version.GetSpec())
+	marshalled, err := yaml.Marshal(jobSpec)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling version %d: %w", version.GetVersion(), err)
+	}
+
+	var sb strings.Builder
+	sb.Write(marshalled)
+
+	assetNames := make([]string, 0, len(jobSpec.Asset))
+	for name := range jobSpec.Asset {
+		assetNames = append(assetNames, name)
+	}
+	sort.Strings(assetNames)
+	for _, name := range assetNames {
+		sb.WriteString("\n# asset: " + name + "\n")
+		sb.WriteString(jobSpec.Asset[name])
+		if !strings.HasSuffix(jobSpec.Asset[name], "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String(), nil
+}
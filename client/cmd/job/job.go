@@ -25,6 +25,17 @@ func NewJobCommand() *cobra.Command {
 		NewExportCommand(),
 		NewJobRunInputCommand(),
 		NewChangeNamespaceCommand(),
+		NewUpdateRunStateCommand(),
+		NewRenderDAGCommand(),
+		NewStatsCommand(),
+		NewGapsCommand(),
+		NewWatchCommand(),
+		NewListCommand(),
+		NewHistoryCommand(),
+		NewRollbackCommand(),
+		NewLibraryCommand(),
+		NewRenderCommand(),
+		NewRunLocalCommand(),
 	)
 	return cmd
 }
@@ -0,0 +1,215 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const libraryTimeout = time.Minute * 1
+
+// NewLibraryCommand initializes command to manage the template libraries shared by job assets
+func NewLibraryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "library",
+		Short: "Manage template libraries shared by the assets of jobs",
+		Long: "A template library is a file of template definitions that job assets pull in with " +
+			`{{ template "lib/<name>" . }} or {{ include "lib/<name>" . }}. ` +
+			"Libraries are registered for a project or a namespace, the one of the namespace takes precedence.",
+	}
+	cmd.AddCommand(
+		newLibraryRegisterCommand(),
+		newLibraryListCommand(),
+	)
+	return cmd
+}
+
+type libraryCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	libraryName   string
+	namespaceName string
+
+	projectName string
+	host        string
+}
+
+func (l *libraryCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&l.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&l.namespaceName, "namespace", "n", "", "Namespace of the template library, the library is shared by the whole project when not set")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&l.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&l.host, "host", "", "Optimus service endpoint url")
+}
+
+func (l *libraryCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(l.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if l.projectName == "" {
+		l.projectName = conf.Project.Name
+	}
+	if l.host == "" {
+		l.host = conf.Host
+	}
+	return nil
+}
+
+func newLibraryRegisterCommand() *cobra.Command {
+	register := &libraryCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register a new version of a template library from a file",
+		Long: "Registers the content of the file as the latest version of the template library. " +
+			"The name of the library defaults to the file name without its extension.",
+		Example: `optimus job library register <file_path> [--name <library_name>] [--namespace <namespace_name>]`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    register.registerRunE,
+		PreRunE: register.PreRunE,
+	}
+	register.injectFlags(cmd)
+	cmd.Flags().StringVar(&register.libraryName, "name", "", "Name of the template library")
+	return cmd
+}
+
+func (l *libraryCommand) registerRunE(_ *cobra.Command, args []string) error {
+	filePath := args[0]
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("error reading template library file %s: %w", filePath, err)
+	}
+
+	libraryName := l.libraryName
+	if libraryName == "" {
+		libraryName = strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	}
+
+	conn, err := l.connection.Create(l.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), libraryTimeout)
+	defer dialCancel()
+
+	resp, err := jobSpecService.RegisterTemplateLibrary(ctx, &pb.RegisterTemplateLibraryRequest{
+		ProjectName:   l.projectName,
+		NamespaceName: l.namespaceName,
+		Name:          libraryName,
+		Content:       string(content),
+	})
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for registering template library %s: %w", libraryName, err)
+	}
+
+	l.logger.Info("Template library [%s] is registered as version %d", libraryName, resp.GetLibrary().GetVersion())
+	affectedJobs := resp.GetAffectedJobs()
+	if len(affectedJobs) == 0 {
+		l.logger.Info("No job is using the template library.")
+		return nil
+	}
+	l.logger.Warn("The following %d jobs use the template library and are compiled with the new version from their next run:", len(affectedJobs))
+	for _, jobName := range affectedJobs {
+		l.logger.Warn("- %s", jobName)
+	}
+	l.logger.Info("Run `optimus job refresh` to redeploy them right away.")
+	return nil
+}
+
+func newLibraryListCommand() *cobra.Command {
+	list := &libraryCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the template libraries, or all versions of a single library",
+		Example: `optimus job library list [--namespace <namespace_name>]
+optimus job library list --name <library_name>`,
+		Args:    cobra.NoArgs,
+		RunE:    list.listRunE,
+		PreRunE: list.PreRunE,
+	}
+	list.injectFlags(cmd)
+	cmd.Flags().StringVar(&list.libraryName, "name", "", "Name of the template library to list the versions of")
+	return cmd
+}
+
+func (l *libraryCommand) listRunE(_ *cobra.Command, _ []string) error {
+	conn, err := l.connection.Create(l.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), libraryTimeout)
+	defer dialCancel()
+
+	resp, err := jobSpecService.GetTemplateLibraries(ctx, &pb.GetTemplateLibrariesRequest{
+		ProjectName:   l.projectName,
+		NamespaceName: l.namespaceName,
+		Name:          l.libraryName,
+	})
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for listing template libraries: %w", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Name", "Namespace", "Version", "Created At"})
+	for _, library := range resp.GetLibraries() {
+		namespaceName := library.GetNamespaceName()
+		if namespaceName == "" {
+			namespaceName = "-"
+		}
+		table.Append([]string{
+			library.GetName(),
+			namespaceName,
+			fmt.Sprintf("%d", library.GetVersion()),
+			library.GetCreatedAt().AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+	l.logger.Info("\nFound %d template libraries.", len(resp.GetLibraries()))
+	return nil
+}
@@ -0,0 +1,197 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const (
+	listJobsTimeout = time.Minute * 1
+
+	listStateEnabled  = "enabled"
+	listStateDisabled = "disabled"
+)
+
+type listCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	namespaceName string
+	state         string
+
+	projectName string
+	host        string
+}
+
+// NewListCommand initializes command to list the jobs of a project
+func NewListCommand() *cobra.Command {
+	list := &listCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the jobs of a project along with their state",
+		Long: "List the jobs of a project, or of a namespace, along with their state. With --state disabled, " +
+			"the disabled jobs are reported with who disabled them, why, since when and until when.",
+		Example: `optimus job list [--namespace <namespace_name>] [--state disabled]`,
+		Args:    cobra.NoArgs,
+		RunE:    list.RunE,
+		PreRunE: list.PreRunE,
+	}
+	list.injectFlags(cmd)
+	return cmd
+}
+
+func (l *listCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&l.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&l.namespaceName, "namespace", "n", "", "Namespace of the jobs, all namespaces when not given")
+	cmd.Flags().StringVar(&l.state, "state", "", "State of the jobs to list, enabled or disabled, all jobs when not given")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&l.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&l.host, "host", "", "Optimus service endpoint url")
+}
+
+func (l *listCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if l.state != "" && l.state != listStateEnabled && l.state != listStateDisabled {
+		return fmt.Errorf("invalid state %s, should be enabled or disabled", l.state)
+	}
+
+	// Load config
+	conf, err := internal.LoadOptionalConfig(l.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if l.projectName == "" {
+		l.projectName = conf.Project.Name
+	}
+	if l.host == "" {
+		l.host = conf.Host
+	}
+	return nil
+}
+
+func (l *listCommand) RunE(_ *cobra.Command, _ []string) error {
+	conn, err := l.connection.Create(l.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), listJobsTimeout)
+	defer dialCancel()
+
+	disabledResp, err := jobSpecService.GetDisabledJobs(ctx, &pb.GetDisabledJobsRequest{
+		ProjectName:   l.projectName,
+		NamespaceName: l.namespaceName,
+	})
+	if err != nil {
+		spinner.Stop()
+		return fmt.Errorf("request failed for disabled jobs of project %s: %w", l.projectName, err)
+	}
+	if l.state == listStateDisabled {
+		spinner.Stop()
+		l.printDisabledJobs(disabledResp.GetJobs())
+		return nil
+	}
+
+	jobsResp, err := jobSpecService.GetJobSpecifications(ctx, &pb.GetJobSpecificationsRequest{
+		ProjectName:   l.projectName,
+		NamespaceName: l.namespaceName,
+	})
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for jobs of project %s: %w", l.projectName, err)
+	}
+	l.printJobs(jobsResp.GetJobSpecificationResponses(), disabledResp.GetJobs())
+	return nil
+}
+
+func (l *listCommand) printJobs(jobs []*pb.JobSpecificationResponse, disabledJobs []*pb.DisabledJob) {
+	disabled := map[string]bool{}
+	for _, disabledJob := range disabledJobs {
+		disabled[disabledJob.GetNamespaceName()+"/"+disabledJob.GetJobName()] = true
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].GetNamespaceName() != jobs[j].GetNamespaceName() {
+			return jobs[i].GetNamespaceName() < jobs[j].GetNamespaceName()
+		}
+		return jobs[i].GetJob().GetName() < jobs[j].GetJob().GetName()
+	})
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Namespace", "Job", "Owner", "Schedule", "State"})
+	var count int
+	for _, jobResp := range jobs {
+		state := listStateEnabled
+		if disabled[jobResp.GetNamespaceName()+"/"+jobResp.GetJob().GetName()] {
+			state = listStateDisabled
+		}
+		if l.state != "" && l.state != state {
+			continue
+		}
+		table.Append([]string{
+			jobResp.GetNamespaceName(),
+			jobResp.GetJob().GetName(),
+			jobResp.GetJob().GetOwner(),
+			jobResp.GetJob().GetInterval(),
+			state,
+		})
+		count++
+	}
+	table.Render()
+	l.logger.Info("\nFound %d jobs.", count)
+}
+
+func (l *listCommand) printDisabledJobs(disabledJobs []*pb.DisabledJob) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Namespace", "Job", "Disabled By", "Disabled Since", "Enable At", "Remark"})
+	for _, disabledJob := range disabledJobs {
+		disabledBy := disabledJob.GetDisabledBy()
+		if disabledBy == "" {
+			disabledBy = "-"
+		}
+		enableAt := "-"
+		if disabledJob.GetEnableAt() != nil {
+			enableAt = disabledJob.GetEnableAt().AsTime().Format(time.RFC3339)
+		}
+		table.Append([]string{
+			disabledJob.GetNamespaceName(),
+			disabledJob.GetJobName(),
+			disabledBy,
+			disabledJob.GetDisabledAt().AsTime().Format(time.RFC3339),
+			enableAt,
+			disabledJob.GetRemark(),
+		})
+	}
+	table.Render()
+	l.logger.Info("\nFound %d disabled jobs.", len(disabledJobs))
+}
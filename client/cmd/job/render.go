@@ -0,0 +1,333 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/local/model"
+	"github.com/raystack/optimus/client/local/specio"
+	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/compiler"
+	"github.com/raystack/optimus/internal/models"
+)
+
+const (
+	renderTaskDirectory  = "task"
+	renderHooksDirectory = "hooks"
+
+	// unresolvedDestination is rendered for the destination of the job, which is resolved by the plugin on the server
+	unresolvedDestination = "<JOB_DESTINATION>"
+)
+
+var secretReferencePattern = regexp.MustCompile(`\.secret\.(\w+)`)
+
+type renderCommand struct {
+	logger         log.Logger
+	configFilePath string
+	clientConfig   *config.ClientConfig
+
+	namespaceName string
+	scheduledAt   string
+	outputDir     string
+	libraryDir    string
+}
+
+// NewRenderCommand initializes command to render the assets and configs of a job locally
+func NewRenderCommand() *cobra.Command {
+	render := &renderCommand{
+		logger:    logger.NewClientLogger(),
+		outputDir: "./render",
+	}
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render the assets and configs of a job for a scheduled time without the server",
+		Long: "Compiles the assets and the task and hook configs of the local job specification the same way a job run does, " +
+			"using the project and namespace configs of the client configuration. Secrets are rendered as placeholders, " +
+			"and the execution time is the scheduled time so the output can be diffed across versions.",
+		Example: "optimus job render <job_name> --namespace <namespace_name> --scheduled-at <2023-01-14T02:00:00Z> [--output-dir ./render]",
+		Args:    cobra.ExactArgs(1),
+		RunE:    render.RunE,
+		PreRunE: render.PreRunE,
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&render.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&render.namespaceName, "namespace", "n", "", "Namespace of the job")
+	cmd.Flags().StringVar(&render.scheduledAt, "scheduled-at", "", "Time the job run is scheduled at, in RFC3339")
+	cmd.Flags().StringVar(&render.outputDir, "output-dir", render.outputDir, "Directory the job is rendered into")
+	cmd.Flags().StringVar(&render.libraryDir, "library-dir", "", "Directory of template library files, named after the library, used by the assets")
+	cmd.MarkFlagRequired("namespace")
+	cmd.MarkFlagRequired("scheduled-at")
+	return cmd
+}
+
+func (r *renderCommand) PreRunE(_ *cobra.Command, _ []string) error {
+	conf, err := config.LoadClientConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+	r.clientConfig = conf
+	return nil
+}
+
+func (r *renderCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	scheduledAt, err := time.Parse(time.RFC3339, r.scheduledAt)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled at, please use %s: %w", time.RFC3339, err)
+	}
+
+	local, err := readLocalJob(r.clientConfig, r.namespaceName, jobName, nil)
+	if err != nil {
+		return err
+	}
+	job := local.job
+	libraries, err := readLibraries(r.libraryDir)
+	if err != nil {
+		return err
+	}
+
+	engine := compiler.NewEngine()
+	assetCompiler := &localAssetCompiler{engine: engine, libraries: libraries}
+	inputCompiler := service.NewJobInputCompiler(&localTenantService{details: local.details}, engine, assetCompiler, r.logger)
+
+	jobDir := filepath.Join(r.outputDir, jobName)
+	if err := os.RemoveAll(jobDir); err != nil {
+		return fmt.Errorf("error cleaning up %s: %w", jobDir, err)
+	}
+
+	executors := []scheduler.Executor{{Name: job.Task.Name, Type: scheduler.ExecutorTask}}
+	for _, hook := range job.Hooks {
+		executors = append(executors, scheduler.Executor{Name: hook.Name, Type: scheduler.ExecutorHook})
+	}
+	for _, executor := range executors {
+		runConfig := scheduler.RunConfig{Executor: executor, ScheduledAt: scheduledAt}
+		input, err := inputCompiler.Compile(context.Background(), job, runConfig, scheduledAt)
+		if err != nil {
+			return fmt.Errorf("error rendering %s %s: %w", executor.Type, executor.Name, err)
+		}
+
+		// the assets are the same for the task and the hooks, so they are only written along with the task
+		dirPath := filepath.Join(jobDir, renderTaskDirectory)
+		if executor.Type == scheduler.ExecutorHook {
+			dirPath = filepath.Join(jobDir, renderHooksDirectory, executor.Name)
+		} else {
+			if err := writeRenderedFiles(dirPath, input.Files); err != nil {
+				return err
+			}
+		}
+		if err := writeRenderedFiles(dirPath, map[string]string{
+			typeEnvFileName:    toEnvFileContent(input.Configs),
+			typeSecretFileName: toEnvFileContent(input.Secrets),
+		}); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Info("Job %s scheduled at %s is rendered into %s", jobName, scheduledAt.Format(time.RFC3339), jobDir)
+	r.logger.Warn("JOB_DESTINATION is rendered as %s and plugin specific asset compilation is not applied, both need the server.", unresolvedDestination)
+	return nil
+}
+
+// localJob is the job read from the local specification, along with the details of its tenant built
+// from the client configuration
+type localJob struct {
+	spec    *model.JobSpec
+	job     *scheduler.Job
+	details *tenant.WithDetails
+}
+
+// readLocalJob reads the job of the namespace, the secrets the job refers to are given the provided values
+// or rendered as placeholders
+func readLocalJob(clientConfig *config.ClientConfig, namespaceName, jobName string, secretValues map[string]string) (*localJob, error) {
+	namespace, err := clientConfig.GetNamespaceByName(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	jobSpecReadWriter, err := specio.NewJobSpecReadWriter(afero.NewOsFs(), specio.WithJobSpecParentReading())
+	if err != nil {
+		return nil, err
+	}
+	jobSpec, err := jobSpecReadWriter.ReadByName(namespace.Job.Path, jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := tenant.NewProject(clientConfig.Project.Name, toServerConfig(clientConfig.Project.Config))
+	if err != nil {
+		return nil, fmt.Errorf("invalid project in client configuration: %w", err)
+	}
+	ns, err := tenant.NewNamespace(namespace.Name, project.Name(), toServerConfig(namespace.Config))
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace in client configuration: %w", err)
+	}
+
+	var secrets tenant.PlainTextSecrets
+	for _, name := range secretsReferencedBy(jobSpec) {
+		value, ok := secretValues[name]
+		if !ok {
+			value = fmt.Sprintf("<secret:%s>", name)
+		}
+		secret, err := tenant.NewPlainTextSecret(name, value)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	details, err := tenant.NewTenantDetails(project, ns, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := toRenderedJob(jobSpec, details)
+	if err != nil {
+		return nil, err
+	}
+	return &localJob{spec: jobSpec, job: job, details: details}, nil
+}
+
+// readLibraries reads the template libraries from the library directory mapped by the file name without extension
+func readLibraries(libraryDir string) (map[string]string, error) {
+	if libraryDir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(libraryDir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading library directory %s: %w", libraryDir, err)
+	}
+	libraries := make(map[string]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(libraryDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading template library %s: %w", entry.Name(), err)
+		}
+		libraries[strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))] = string(content)
+	}
+	return libraries, nil
+}
+
+func toRenderedJob(jobSpec *model.JobSpec, tenantDetails *tenant.WithDetails) (*scheduler.Job, error) {
+	window, err := models.NewWindowWithCalendar(jobSpec.Version, jobSpec.Task.Window.TruncateTo, jobSpec.Task.Window.Offset,
+		jobSpec.Task.Window.Size, jobSpec.Task.Window.Calendar)
+	if err != nil {
+		return nil, fmt.Errorf("invalid window of job %s: %w", jobSpec.Name, err)
+	}
+	window, err = models.WindowWithCalendar(window, tenantDetails.GetConfigs())
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar of job %s: %w", jobSpec.Name, err)
+	}
+
+	hooks := make([]*scheduler.Hook, len(jobSpec.Hooks))
+	for i, hook := range jobSpec.Hooks {
+		hooks[i] = hook.ToSchedulerHook()
+	}
+	return &scheduler.Job{
+		Name:        scheduler.JobName(jobSpec.Name),
+		Tenant:      tenantDetails.ToTenant(),
+		Destination: unresolvedDestination,
+		Task:        &scheduler.Task{Name: jobSpec.Task.Name, Config: jobSpec.Task.Config},
+		Hooks:       hooks,
+		Window:      window,
+		Assets:      jobSpec.Asset,
+	}, nil
+}
+
+// toServerConfig upper cases the config keys the way the server registers them
+func toServerConfig(conf map[string]string) map[string]string {
+	serverConfig := make(map[string]string, len(conf))
+	for key, value := range conf {
+		serverConfig[strings.ToUpper(key)] = value
+	}
+	return serverConfig
+}
+
+func secretsReferencedBy(jobSpec *model.JobSpec) []string {
+	templates := []map[string]string{jobSpec.Task.Config, jobSpec.Asset}
+	for _, hook := range jobSpec.Hooks {
+		templates = append(templates, hook.Config)
+	}
+
+	names := make(map[string]bool)
+	for _, templateMap := range templates {
+		for _, content := range templateMap {
+			for _, match := range secretReferencePattern.FindAllStringSubmatch(content, -1) {
+				names[match[1]] = true
+			}
+		}
+	}
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+	return sortedNames
+}
+
+func toEnvFileContent(configs map[string]string) string {
+	keys := make([]string, 0, len(configs))
+	for key := range configs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("%s='%s'\n", key, configs[key]))
+	}
+	return sb.String()
+}
+
+func writeRenderedFiles(dirPath string, files map[string]string) error {
+	for fileName, content := range files {
+		filePath := filepath.Join(dirPath, fileName)
+		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+			return fmt.Errorf("failed to create directory at %s: %w", filepath.Dir(filePath), err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil { //nolint:gomnd
+			return fmt.Errorf("failed to write rendered file at %s: %w", filePath, err)
+		}
+	}
+	return nil
+}
+
+// localTenantService serves the tenant details built from the client configuration
+type localTenantService struct {
+	details *tenant.WithDetails
+}
+
+func (s *localTenantService) GetDetails(_ context.Context, _ tenant.Tenant) (*tenant.WithDetails, error) {
+	return s.details, nil
+}
+
+func (*localTenantService) GetSecrets(_ context.Context, _ tenant.Tenant) ([]*tenant.PlainTextSecret, error) {
+	return nil, errors.New("secrets are not available locally")
+}
+
+// localAssetCompiler compiles the assets with the template libraries read from the local directory
+type localAssetCompiler struct {
+	engine    *compiler.Engine
+	libraries map[string]string
+}
+
+func (c *localAssetCompiler) CompileJobRunAssets(_ context.Context, job *scheduler.Job, _ map[string]string, _ time.Time, contextForTask map[string]interface{}) (map[string]string, error) {
+	return c.engine.CompileWithLibraries(job.Assets, c.libraries, contextForTask)
+}
@@ -0,0 +1,286 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/client/cmd/internal/survey"
+	"github.com/raystack/optimus/client/local/specio"
+	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/resolver"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/ext/scheduler/airflow/dag"
+	"github.com/raystack/optimus/internal/models"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const (
+	renderDAGTimeout = time.Minute * 1
+
+	renderDAGFilePermission = 0o600
+)
+
+type renderDAGCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+	clientConfig   *config.ClientConfig
+	pluginRepo     *models.PluginRepository
+
+	local         bool
+	namespaceName string
+	outputPath    string
+
+	projectName string
+	host        string
+}
+
+// NewRenderDAGCommand initializes command to render the compiled job as it is deployed on the scheduler
+func NewRenderDAGCommand() *cobra.Command {
+	render := &renderDAGCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "render-dag",
+		Short: "Render the compiled scheduler DAG of a job",
+		Long: "Render the DAG of a job the same way as it is deployed on the scheduler. " +
+			"Use --local to compile the job spec from the local directory, eg. to diff the DAG before deployment. " +
+			"Local rendering uses the locally installed plugins and resolves the job upstreams through the server.",
+		Example:  "optimus job render-dag <job_name> [--local] [--namespace <namespace_name>] [--output <file_path>]",
+		Args:     cobra.ExactArgs(1),
+		RunE:     render.RunE,
+		PreRunE:  render.PreRunE,
+		PostRunE: render.PostRunE,
+	}
+	render.injectFlags(cmd)
+	return cmd
+}
+
+func (r *renderDAGCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().BoolVar(&r.local, "local", false, "Compile the job spec from the local directory instead of the server")
+	cmd.Flags().StringVarP(&r.namespaceName, "namespace", "n", "", "Namespace of the local job spec, asked when not given")
+	cmd.Flags().StringVarP(&r.outputPath, "output", "o", "", "File path to write the rendered DAG, printed when not given")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *renderDAGCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		if r.local {
+			return errors.New("client configuration is required to render the DAG from the local job spec")
+		}
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		r.connection = connection.NewInsecure(r.logger)
+		return nil
+	}
+
+	r.clientConfig = conf
+	r.connection = connection.New(r.logger, conf)
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+
+	if r.local {
+		r.pluginRepo, err = internal.InitPlugins(config.LogLevel(r.logger.Level()))
+		return err
+	}
+	return nil
+}
+
+func (r *renderDAGCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+
+	var compiledJob []byte
+	var err error
+	if r.local {
+		compiledJob, err = r.renderFromLocal(jobName)
+	} else {
+		compiledJob, err = r.renderFromServer(jobName)
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.outputPath == "" {
+		_, err = os.Stdout.Write(compiledJob)
+		return err
+	}
+	if err := os.WriteFile(r.outputPath, compiledJob, renderDAGFilePermission); err != nil {
+		return fmt.Errorf("failed to write the rendered DAG at %s: %w", r.outputPath, err)
+	}
+	r.logger.Info("Rendered DAG of job %s is written to %s", jobName, r.outputPath)
+	return nil
+}
+
+func (r *renderDAGCommand) PostRunE(_ *cobra.Command, _ []string) error {
+	if r.local {
+		internal.CleanupPlugins()
+	}
+	return nil
+}
+
+func (r *renderDAGCommand) renderFromServer(jobName string) ([]byte, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	run := pb.NewJobRunServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), renderDAGTimeout)
+	defer dialCancel()
+
+	resp, err := run.GetCompiledJob(ctx, &pb.GetCompiledJobRequest{
+		ProjectName: r.projectName,
+		JobName:     jobName,
+	})
+	spinner.Stop()
+	if err != nil {
+		return nil, fmt.Errorf("request failed for job %s: %w", jobName, err)
+	}
+	return []byte(resp.GetCompiledJob()), nil
+}
+
+func (r *renderDAGCommand) renderFromLocal(jobName string) ([]byte, error) {
+	namespace, err := r.getNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	jobSpecReadWriter, err := specio.NewJobSpecReadWriter(afero.NewOsFs(), specio.WithJobSpecParentReading())
+	if err != nil {
+		return nil, err
+	}
+	jobSpec, err := jobSpecReadWriter.ReadByName(namespace.Job.Path, jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	tnnt, err := tenant.NewTenant(r.projectName, namespace.Name)
+	if err != nil {
+		return nil, err
+	}
+	jobWithDetails, err := jobSpec.ToJobWithDetails(tnnt)
+	if err != nil {
+		return nil, err
+	}
+
+	// upstreams are inferred by the server, inspect the local spec to get them
+	inspectResp, err := r.inspectJob(jobSpec.ToProto(), namespace.Name)
+	if err != nil {
+		return nil, err
+	}
+	jobWithDetails.Job.Destination = inspectResp.GetBasicInfo().GetDestination()
+	upstreamJobs, err := toSchedulerUpstreamJobs(inspectResp.GetUpstreams())
+	if err != nil {
+		return nil, err
+	}
+	jobSpec.ApplyDependencyRunConfigs(r.projectName, upstreamJobs)
+	jobWithDetails.Upstreams.UpstreamJobs = upstreamJobs
+
+	if err := resolver.NewSimpleResolver().Resolve(context.Background(), []*scheduler.JobWithDetails{jobWithDetails}); err != nil {
+		return nil, err
+	}
+
+	compiler, err := dag.NewDagCompiler(r.host, r.pluginRepo)
+	if err != nil {
+		return nil, err
+	}
+	return compiler.Compile(jobWithDetails)
+}
+
+func (r *renderDAGCommand) getNamespace() (*config.Namespace, error) {
+	if r.namespaceName != "" {
+		return r.clientConfig.GetNamespaceByName(r.namespaceName)
+	}
+	return survey.NewNamespaceSurvey(r.logger).AskToSelectNamespace(r.clientConfig)
+}
+
+func (r *renderDAGCommand) inspectJob(spec *pb.JobSpecification, namespaceName string) (*pb.JobInspectResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("resolving upstreams...")
+	job := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), renderDAGTimeout)
+	defer dialCancel()
+
+	resp, err := job.JobInspect(ctx, &pb.JobInspectRequest{
+		ProjectName:   r.projectName,
+		NamespaceName: namespaceName,
+		Spec:          spec,
+	})
+	spinner.Stop()
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve upstreams of job %s: %w", spec.GetName(), err)
+	}
+	return resp, nil
+}
+
+func toSchedulerUpstreamJobs(upstreams *pb.JobInspectResponse_UpstreamSection) ([]*scheduler.JobUpstream, error) {
+	var upstreamJobs []*scheduler.JobUpstream
+	for _, dependency := range upstreams.GetInternalDependency() {
+		upstream, err := toSchedulerUpstreamJob(dependency, false)
+		if err != nil {
+			return nil, err
+		}
+		upstreamJobs = append(upstreamJobs, upstream)
+	}
+	for _, dependency := range upstreams.GetExternalDependency() {
+		upstream, err := toSchedulerUpstreamJob(dependency, true)
+		if err != nil {
+			return nil, err
+		}
+		upstreamJobs = append(upstreamJobs, upstream)
+	}
+	return upstreamJobs, nil
+}
+
+func toSchedulerUpstreamJob(dependency *pb.JobInspectResponse_JobDependency, external bool) (*scheduler.JobUpstream, error) {
+	tnnt, err := tenant.NewTenant(dependency.GetProjectName(), dependency.GetNamespaceName())
+	if err != nil {
+		return nil, err
+	}
+	return &scheduler.JobUpstream{
+		JobName:  dependency.GetName(),
+		Host:     dependency.GetHost(),
+		TaskName: dependency.GetTaskName(),
+		Tenant:   tnnt,
+		External: external,
+	}, nil
+}
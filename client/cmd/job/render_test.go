@@ -0,0 +1,278 @@
+package job
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/client/local/model"
+	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
+)
+
+const renderJobSpec = `version: 1
+name: sample-job
+owner: optimus@optimus.dev
+schedule:
+  start_date: "2023-01-01"
+  interval: 0 2 * * *
+task:
+  name: bq2bq
+  config:
+    TABLE: sample
+    TOKEN: "{{ .secret.API_TOKEN }}"
+  window:
+    size: 24h
+    offset: "0"
+    truncate_to: d
+hooks:
+  - name: transporter
+    config:
+      FILTER: "dt >= '{{ .DSTART | Date }}'"
+      PASSWORD: "{{ .secret.DB_PASSWORD }}"
+`
+
+func TestRender(t *testing.T) {
+	t.Run("readLocalJob", func(t *testing.T) {
+		t.Run("returns error when the namespace is not in the client configuration", func(t *testing.T) {
+			_, err := readLocalJob(newClientConfig(t), "other-namespace", "sample-job", nil)
+
+			assert.ErrorContains(t, err, "namespace [other-namespace] is not found")
+		})
+		t.Run("returns error when the job is not found", func(t *testing.T) {
+			_, err := readLocalJob(newClientConfig(t), "sample-namespace", "other-job", nil)
+
+			assert.ErrorContains(t, err, "spec with name [other-job] is not found")
+		})
+		t.Run("renders the secrets referred by the job as placeholders", func(t *testing.T) {
+			local, err := readLocalJob(newClientConfig(t), "sample-namespace", "sample-job", nil)
+			assert.NoError(t, err)
+
+			assert.Equal(t, map[string]string{
+				"API_TOKEN":   "<secret:API_TOKEN>",
+				"DB_PASSWORD": "<secret:DB_PASSWORD>",
+			}, local.details.SecretsMap())
+			assert.Equal(t, "sample-project", local.details.ToTenant().ProjectName().String())
+			assert.Equal(t, "sample-namespace", local.details.ToTenant().NamespaceName().String())
+			assert.Equal(t, "sample_dataset", local.details.GetConfigs()["DATASET"])
+			assert.Equal(t, scheduler.JobName("sample-job"), local.job.Name)
+		})
+		t.Run("gives the secrets referred by the job the provided values", func(t *testing.T) {
+			local, err := readLocalJob(newClientConfig(t), "sample-namespace", "sample-job", map[string]string{
+				"API_TOKEN": "token",
+				"UNUSED":    "unused",
+			})
+			assert.NoError(t, err)
+
+			assert.Equal(t, map[string]string{
+				"API_TOKEN":   "token",
+				"DB_PASSWORD": "<secret:DB_PASSWORD>",
+			}, local.details.SecretsMap())
+		})
+	})
+	t.Run("secretsReferencedBy", func(t *testing.T) {
+		t.Run("returns the sorted unique secrets referred by the task, the assets and the hooks", func(t *testing.T) {
+			jobSpec := &model.JobSpec{
+				Task: model.JobSpecTask{Config: map[string]string{
+					"TOKEN": "{{ .secret.API_TOKEN }}",
+					"TABLE": "sample",
+				}},
+				Asset: map[string]string{
+					"query.sql": "select '{{ .secret.SALT }}', '{{.secret.API_TOKEN}}'",
+				},
+				Hooks: []model.JobSpecHook{
+					{Name: "transporter", Config: map[string]string{"PASSWORD": "{{ .secret.DB_PASSWORD }}"}},
+				},
+			}
+
+			assert.Equal(t, []string{"API_TOKEN", "DB_PASSWORD", "SALT"}, secretsReferencedBy(jobSpec))
+		})
+		t.Run("returns nothing when the job refers to no secret", func(t *testing.T) {
+			jobSpec := &model.JobSpec{
+				Task: model.JobSpecTask{Config: map[string]string{"TABLE": "sample"}},
+			}
+
+			assert.Empty(t, secretsReferencedBy(jobSpec))
+		})
+	})
+	t.Run("toRenderedJob", func(t *testing.T) {
+		project, err := tenant.NewProject("sample-project", map[string]string{
+			tenant.ProjectSchedulerHost:  "http://airflow.example.com",
+			tenant.ProjectStoragePathKey: "gs://sample-bucket",
+			"CALENDAR_EXCHANGE":          "weekdays=mon-thu;holidays=2023-01-02",
+		})
+		assert.NoError(t, err)
+		namespace, err := tenant.NewNamespace("sample-namespace", project.Name(), nil)
+		assert.NoError(t, err)
+		details, err := tenant.NewTenantDetails(project, namespace, nil)
+		assert.NoError(t, err)
+
+		newJobSpec := func(window model.JobSpecTaskWindow) *model.JobSpec {
+			return &model.JobSpec{
+				Version: 3,
+				Name:    "sample-job",
+				Task:    model.JobSpecTask{Name: "bq2bq", Config: map[string]string{"TABLE": "sample"}, Window: window},
+				Asset:   map[string]string{"query.sql": "select 1"},
+				Hooks:   []model.JobSpecHook{{Name: "transporter", RunIf: "true"}},
+			}
+		}
+
+		t.Run("returns the job with the calendar of the window bound", func(t *testing.T) {
+			job, err := toRenderedJob(newJobSpec(model.JobSpecTaskWindow{Size: "1b", TruncateTo: "d", Calendar: "exchange"}), details)
+			assert.NoError(t, err)
+
+			assert.Equal(t, scheduler.JobName("sample-job"), job.Name)
+			assert.Equal(t, details.ToTenant(), job.Tenant)
+			assert.Equal(t, unresolvedDestination, job.Destination)
+			assert.Equal(t, &scheduler.Task{Name: "bq2bq", Config: map[string]string{"TABLE": "sample"}}, job.Task)
+			assert.Equal(t, map[string]string{"query.sql": "select 1"}, job.Assets)
+			assert.Len(t, job.Hooks, 1)
+			assert.Equal(t, "transporter", job.Hooks[0].Name)
+			assert.Equal(t, "true", job.Hooks[0].RunIf)
+			assert.Equal(t, "exchange", models.CalendarOf(job.Window))
+
+			// the run on tuesday consumes the previous business day, thursday, skipping the friday and the holiday on monday
+			startTime, err := job.Window.GetStartTime(time.Date(2023, 1, 3, 2, 0, 0, 0, time.UTC))
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2022, 12, 29, 0, 0, 0, 0, time.UTC), startTime)
+		})
+		t.Run("returns error when the calendar of the window is not declared", func(t *testing.T) {
+			_, err := toRenderedJob(newJobSpec(model.JobSpecTaskWindow{Size: "1b", TruncateTo: "d", Calendar: "other"}), details)
+
+			assert.ErrorContains(t, err, "invalid calendar of job sample-job")
+			assert.ErrorContains(t, err, "calendar [other] is not declared")
+		})
+		t.Run("returns error when the window version does not support calendars", func(t *testing.T) {
+			jobSpec := newJobSpec(model.JobSpecTaskWindow{Size: "24h", TruncateTo: "d", Calendar: "exchange"})
+			jobSpec.Version = 2
+
+			_, err := toRenderedJob(jobSpec, details)
+
+			assert.ErrorContains(t, err, "invalid window of job sample-job")
+			assert.ErrorContains(t, err, "calendar is not supported by window version [2]")
+		})
+	})
+	t.Run("readLibraries", func(t *testing.T) {
+		t.Run("returns nothing when the library directory is not given", func(t *testing.T) {
+			libraries, err := readLibraries("")
+
+			assert.NoError(t, err)
+			assert.Nil(t, libraries)
+		})
+		t.Run("returns error when the library directory can not be read", func(t *testing.T) {
+			_, err := readLibraries(filepath.Join(t.TempDir(), "missing"))
+
+			assert.ErrorContains(t, err, "error reading library directory")
+		})
+		t.Run("returns the libraries named after the files, skipping the directories", func(t *testing.T) {
+			libraryDir := t.TempDir()
+			assert.NoError(t, os.WriteFile(filepath.Join(libraryDir, "dates.sql"), []byte(`{{ define "partition" }}dt{{ end }}`), 0o600))
+			assert.NoError(t, os.WriteFile(filepath.Join(libraryDir, "macros"), []byte(`macros`), 0o600))
+			assert.NoError(t, os.MkdirAll(filepath.Join(libraryDir, "nested"), os.ModePerm))
+
+			libraries, err := readLibraries(libraryDir)
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"dates":  `{{ define "partition" }}dt{{ end }}`,
+				"macros": `macros`,
+			}, libraries)
+		})
+	})
+	t.Run("RunE", func(t *testing.T) {
+		t.Run("writes the assets and configs of the task and the configs of each hook", func(t *testing.T) {
+			outputDir := t.TempDir()
+			render := &renderCommand{
+				logger:        log.NewNoop(),
+				clientConfig:  newClientConfig(t),
+				namespaceName: "sample-namespace",
+				scheduledAt:   "2023-01-14T02:00:00Z",
+				outputDir:     outputDir,
+			}
+
+			assert.NoError(t, render.RunE(nil, []string{"sample-job"}))
+
+			readRendered := func(path ...string) string {
+				content, err := os.ReadFile(filepath.Join(append([]string{outputDir, "sample-job"}, path...)...))
+				assert.NoError(t, err)
+				return string(content)
+			}
+			assert.Equal(t, "select * from sample_dataset.sample where dt = '2023-01-13'", readRendered(renderTaskDirectory, "query.sql"))
+			assert.Equal(t, "DEND='2023-01-14T00:00:00Z'\n"+
+				"DSTART='2023-01-13T00:00:00Z'\n"+
+				"EXECUTION_TIME='2023-01-14T02:00:00Z'\n"+
+				"JOB_DESTINATION='<JOB_DESTINATION>'\n"+
+				"TABLE='sample'\n", readRendered(renderTaskDirectory, typeEnvFileName))
+			assert.Equal(t, "TOKEN='<secret:API_TOKEN>'\n", readRendered(renderTaskDirectory, typeSecretFileName))
+
+			assert.Equal(t, "DEND='2023-01-14T00:00:00Z'\n"+
+				"DSTART='2023-01-13T00:00:00Z'\n"+
+				"EXECUTION_TIME='2023-01-14T02:00:00Z'\n"+
+				"FILTER='dt >= '2023-01-13''\n"+
+				"JOB_DESTINATION='<JOB_DESTINATION>'\n", readRendered(renderHooksDirectory, "transporter", typeEnvFileName))
+			assert.Equal(t, "PASSWORD='<secret:DB_PASSWORD>'\n", readRendered(renderHooksDirectory, "transporter", typeSecretFileName))
+			_, err := os.Stat(filepath.Join(outputDir, "sample-job", renderHooksDirectory, "transporter", "query.sql"))
+			assert.True(t, os.IsNotExist(err))
+		})
+		t.Run("cleans up the previous rendering of the job", func(t *testing.T) {
+			outputDir := t.TempDir()
+			stalePath := filepath.Join(outputDir, "sample-job", renderHooksDirectory, "removed", typeEnvFileName)
+			assert.NoError(t, os.MkdirAll(filepath.Dir(stalePath), os.ModePerm))
+			assert.NoError(t, os.WriteFile(stalePath, []byte("STALE='true'\n"), 0o600))
+			render := &renderCommand{
+				logger:        log.NewNoop(),
+				clientConfig:  newClientConfig(t),
+				namespaceName: "sample-namespace",
+				scheduledAt:   "2023-01-14T02:00:00Z",
+				outputDir:     outputDir,
+			}
+
+			assert.NoError(t, render.RunE(nil, []string{"sample-job"}))
+
+			_, err := os.Stat(stalePath)
+			assert.True(t, os.IsNotExist(err))
+		})
+		t.Run("returns error when the scheduled time is invalid", func(t *testing.T) {
+			render := &renderCommand{
+				logger:        log.NewNoop(),
+				clientConfig:  newClientConfig(t),
+				namespaceName: "sample-namespace",
+				scheduledAt:   "2023-01-14",
+				outputDir:     t.TempDir(),
+			}
+
+			assert.ErrorContains(t, render.RunE(nil, []string{"sample-job"}), "invalid scheduled at")
+		})
+	})
+}
+
+// newClientConfig writes the sample job into a temporary job directory of the namespace of the client config
+func newClientConfig(t *testing.T) *config.ClientConfig {
+	t.Helper()
+
+	jobDir := filepath.Join(t.TempDir(), "jobs", "sample-job")
+	assert.NoError(t, os.MkdirAll(filepath.Join(jobDir, "assets"), os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(jobDir, "job.yaml"), []byte(renderJobSpec), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(jobDir, "assets", "query.sql"),
+		[]byte(`select * from {{ .proj.DATASET }}.sample where dt = '{{ .DSTART | Date }}'`), 0o600))
+
+	return &config.ClientConfig{
+		Project: config.Project{
+			Name: "sample-project",
+			Config: map[string]string{
+				"scheduler_host": "http://airflow.example.com",
+				"storage_path":   "gs://sample-bucket",
+				"dataset":        "sample_dataset",
+			},
+		},
+		Namespaces: []*config.Namespace{
+			{Name: "sample-namespace", Job: config.Job{Path: filepath.Dir(jobDir)}},
+		},
+	}
+}
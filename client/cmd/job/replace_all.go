@@ -2,9 +2,12 @@ package job
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"os/user"
 	"strings"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/raystack/optimus/client/cmd/internal/connection"
 	"github.com/raystack/optimus/client/cmd/internal/logger"
@@ -35,6 +39,9 @@ type replaceAllCommand struct {
 	selectedNamespaceNames []string
 	verbose                bool
 	configFilePath         string
+	changedBy              string
+	plan                   bool
+	planOutput             string
 }
 
 // NewReplaceAllCommand initializes command for ReplaceAll
@@ -48,7 +55,7 @@ func NewReplaceAllCommand() *cobra.Command {
 		Short: "Replace all current optimus project to server",
 		Long: heredoc.Doc(`Apply local changes to destination server which includes creating/updating/deleting
 				jobs`),
-		Example: "optimus job replace-all [--verbose]",
+		Example: "optimus job replace-all [--verbose] [--plan] [--plan-output plan.json]",
 		Annotations: map[string]string{
 			"group:core": "true",
 		},
@@ -58,6 +65,9 @@ func NewReplaceAllCommand() *cobra.Command {
 	cmd.Flags().StringVarP(&replaceAll.configFilePath, "config", "c", replaceAll.configFilePath, "File path for client configuration")
 	cmd.Flags().StringSliceVarP(&replaceAll.selectedNamespaceNames, "namespace-names", "N", nil, "Selected namespaces of optimus project")
 	cmd.Flags().BoolVarP(&replaceAll.verbose, "verbose", "v", false, "Print details related to replace-all stages")
+	cmd.Flags().StringVar(&replaceAll.changedBy, "changed-by", "", "Who replaced the jobs, recorded in the job history, defaults to the current user")
+	cmd.Flags().BoolVar(&replaceAll.plan, "plan", false, "Only show what is going to change, without saving anything")
+	cmd.Flags().StringVar(&replaceAll.planOutput, "plan-output", "", "Write the plan as json to the given file, implies --plan")
 	return cmd
 }
 
@@ -69,6 +79,16 @@ func (r *replaceAllCommand) PreRunE(_ *cobra.Command, _ []string) error {
 	}
 
 	r.connection = connection.New(r.logger, r.clientConfig)
+	if r.planOutput != "" {
+		r.plan = true
+	}
+
+	// the history of the jobs is still recorded without the author when the current user is unknown
+	if r.changedBy == "" {
+		if currentUser, err := user.Current(); err == nil {
+			r.changedBy = currentUser.Username
+		}
+	}
 	return nil
 }
 
@@ -96,6 +116,10 @@ func (r *replaceAllCommand) replaceAll(selectedNamespaces []*config.Namespace) e
 	if err := r.replaceAllJobs(conn, selectedNamespaces); err != nil {
 		return err
 	}
+	if r.plan {
+		r.logger.Info("plan job specifications finished, nothing is saved!\n")
+		return nil
+	}
 	r.logger.Info("replace all job specifications finished!\n")
 
 	return nil
@@ -157,7 +181,7 @@ func (r *replaceAllCommand) sendNamespaceJobRequest(
 	return nil
 }
 
-func (*replaceAllCommand) getReplaceAllRequest(projectName string, namespace *config.Namespace) (*pb.ReplaceAllJobSpecificationsRequest, error) {
+func (r *replaceAllCommand) getReplaceAllRequest(projectName string, namespace *config.Namespace) (*pb.ReplaceAllJobSpecificationsRequest, error) {
 	jobSpecReadWriter, err := specio.NewJobSpecReadWriter(afero.NewOsFs(), specio.WithJobSpecParentReading())
 	if err != nil {
 		return nil, err
@@ -176,6 +200,8 @@ func (*replaceAllCommand) getReplaceAllRequest(projectName string, namespace *co
 		Jobs:          jobSpecsProto,
 		ProjectName:   projectName,
 		NamespaceName: namespace.Name,
+		ChangedBy:     r.changedBy,
+		Plan:          r.plan,
 	}, nil
 }
 
@@ -195,13 +221,15 @@ func (r *replaceAllCommand) getJobStreamClient(ctx context.Context, conn *grpc.C
 func (r *replaceAllCommand) processJobReplaceAllResponses(stream pb.JobSpecificationService_ReplaceAllJobSpecificationsClient) error {
 	r.logger.Info("> Receiving responses:")
 
+	var plans []*pb.JobSpecificationsPlan
+	var streamErr error
 	for {
 		resp, err := stream.Recv()
 		if err != nil {
-			if errors.Is(err, io.EOF) {
-				break
+			if !errors.Is(err, io.EOF) {
+				streamErr = err
 			}
-			return err
+			break
 		}
 
 		if logStatus := resp.GetLogStatus(); logStatus != nil {
@@ -212,7 +240,68 @@ func (r *replaceAllCommand) processJobReplaceAllResponses(stream pb.JobSpecifica
 			}
 			continue
 		}
+
+		if plan := resp.GetPlan(); plan != nil {
+			plans = append(plans, plan)
+		}
+	}
+
+	// the plans received before the stream broke are still shown, those namespaces are already processed
+	for _, plan := range plans {
+		r.printPlan(plan)
+	}
+	if r.planOutput != "" {
+		if err := r.writePlans(plans); err != nil {
+			if streamErr != nil {
+				r.logger.Error(err.Error())
+				return streamErr
+			}
+			return err
+		}
 	}
+	return streamErr
+}
+
+func (r *replaceAllCommand) printPlan(plan *pb.JobSpecificationsPlan) {
+	actionSymbols := map[string]string{"add": "+", "update": "~", "delete": "-"}
+	actionCounts := map[string]int{}
+
+	r.logger.Info("\n> Plan for namespace [%s]:", plan.GetNamespaceName())
+	for _, jobPlan := range plan.GetJobs() {
+		actionCounts[jobPlan.GetAction()]++
+		r.logger.Info("%s %s", actionSymbols[jobPlan.GetAction()], jobPlan.GetJobName())
+		if jobPlan.GetAction() == "update" {
+			for _, diff := range jobPlan.GetDiffs() {
+				r.logger.Info("    %s: %q -> %q", diff.GetField(), diff.GetOldValue(), diff.GetNewValue())
+			}
+		}
+		for _, upstream := range jobPlan.GetUnresolvedUpstreams() {
+			r.logger.Warn("    upstream %s is not found", upstream)
+		}
+		for _, downstream := range jobPlan.GetBrokenDownstreams() {
+			r.logger.Warn("    downstream %s loses this job as its upstream", downstream)
+		}
+	}
+	r.logger.Info("[%s] %d to add, %d to update, and %d to delete", plan.GetNamespaceName(), actionCounts["add"], actionCounts["update"], actionCounts["delete"])
+}
 
+func (r *replaceAllCommand) writePlans(plans []*pb.JobSpecificationsPlan) error {
+	marshalledPlans := make([]json.RawMessage, len(plans))
+	for i, plan := range plans {
+		marshalled, err := protojson.Marshal(plan)
+		if err != nil {
+			return fmt.Errorf("error marshalling plan of namespace [%s]: %w", plan.GetNamespaceName(), err)
+		}
+		marshalledPlans[i] = marshalled
+	}
+
+	content, err := json.MarshalIndent(map[string][]json.RawMessage{"plans": marshalledPlans}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling plans: %w", err)
+	}
+	if err := os.WriteFile(r.planOutput, content, 0o600); err != nil {
+		return fmt.Errorf("error writing plan to %s: %w", r.planOutput, err)
+	}
+	r.logger.Info("plan is written to %s", r.planOutput)
 	return nil
 }
@@ -0,0 +1,139 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os/user"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const rollbackTimeout = time.Minute * 5
+
+type rollbackCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	version   int32
+	changedBy string
+
+	projectName   string
+	namespaceName string
+	host          string
+}
+
+// NewRollbackCommand initializes command to rollback a job to a previous version of its spec
+func NewRollbackCommand() *cobra.Command {
+	rollback := &rollbackCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback a job to a previous version of its specification",
+		Long: "Re-apply the specification of a previous version of the job, as listed by job history, " +
+			"which is recorded as a new version. Local job specifications are not changed, " +
+			"so the next replace-all applies them again unless they are reverted as well.",
+		Example: `optimus job rollback <job_name> --to <version> [--namespace <namespace_name>]`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    rollback.RunE,
+		PreRunE: rollback.PreRunE,
+	}
+	rollback.injectFlags(cmd)
+	internal.MarkFlagsRequired(cmd, []string{"to"})
+	return cmd
+}
+
+func (r *rollbackCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().Int32Var(&r.version, "to", 0, "Version of the job specification to rollback to")
+	cmd.Flags().StringVar(&r.changedBy, "changed-by", "", "Who rolled back the job, defaults to the current user")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVarP(&r.namespaceName, "namespace", "n", "", "Namespace of the job")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *rollbackCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if r.version <= 0 {
+		return fmt.Errorf("invalid version %d, should be positive", r.version)
+	}
+
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if r.changedBy == "" {
+		currentUser, err := user.Current()
+		if err != nil {
+			return fmt.Errorf("unable to get current user, please provide --changed-by: %w", err)
+		}
+		r.changedBy = currentUser.Username
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "namespace", "host"})
+		return nil
+	}
+
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	if r.namespaceName == "" {
+		if len(conf.Namespaces) != 1 {
+			return fmt.Errorf("namespace of the job is required, please provide --namespace")
+		}
+		r.namespaceName = conf.Namespaces[0].Name
+	}
+	return nil
+}
+
+func (r *rollbackCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer dialCancel()
+
+	_, err = jobSpecService.RollbackJobSpecification(ctx, &pb.RollbackJobSpecificationRequest{
+		ProjectName:   r.projectName,
+		NamespaceName: r.namespaceName,
+		JobName:       jobName,
+		Version:       r.version,
+		ChangedBy:     r.changedBy,
+	})
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for rollback of job %s: %w", jobName, err)
+	}
+
+	r.logger.Info("Job %s is rolled back to version %d.", jobName, r.version)
+	return nil
+}
@@ -120,7 +120,7 @@ func (r *runListCommand) callJobRun(jobRunRequest *pb.JobRunRequest) error {
 
 	jobRuns := jobRunResponse.GetJobRuns()
 	for _, jobRun := range jobRuns {
-		r.logger.Info("%s - %s", jobRun.GetScheduledAt().AsTime(), jobRun.GetState())
+		r.logger.Info("%s", formatJobRun(jobRun))
 	}
 	r.logger.Info("\nFound %d jobRun instances.", len(jobRuns))
 	return nil
@@ -0,0 +1,533 @@
+package job
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/ext/scheduler/airflow/dag"
+	"github.com/raystack/optimus/internal/compiler"
+	"github.com/raystack/optimus/internal/models"
+	"github.com/raystack/optimus/sdk/plugin"
+)
+
+const (
+	// containerJobDir is where the files of the job run are mounted, the same as the init container of the scheduler
+	containerJobDir = "/data"
+
+	runLocalEventsFileName = "events.jsonl"
+	runLocalLogsDirectory  = "logs"
+)
+
+type runLocalCommand struct {
+	logger         log.Logger
+	configFilePath string
+	clientConfig   *config.ClientConfig
+	pluginRepo     *models.PluginRepository
+
+	namespaceName string
+	scheduledAt   string
+	outputDir     string
+	libraryDir    string
+	secretsFile   string
+	runtime       string
+}
+
+// NewRunLocalCommand initializes command to run a job locally in containers
+func NewRunLocalCommand() *cobra.Command {
+	runLocal := &runLocalCommand{
+		logger:    logger.NewClientLogger(),
+		outputDir: "./run-local",
+		runtime:   "docker",
+	}
+
+	cmd := &cobra.Command{
+		Use:   "run-local",
+		Short: "Run the task and hooks of a job for a scheduled time in local containers",
+		Long: "Compiles the job run input from the local job specification, the same way as `optimus job render`, " +
+			"and runs the image of each plugin with its entrypoint in a docker compatible runtime. " +
+			"The pre hooks run before the task, the post hooks after the task succeeds and the fail hooks when it fails, " +
+			"unless changed by depends_on and run_if of the hooks. " +
+			"The events of the run are written to a file instead of being sent to the server.",
+		Example: "optimus job run-local <job_name> --namespace <namespace_name> --scheduled-at <2023-01-14T02:00:00Z> [--secrets-file .secrets.env]",
+		Args:    cobra.ExactArgs(1),
+		RunE:    runLocal.RunE,
+		PreRunE: runLocal.PreRunE,
+		PostRunE: func(_ *cobra.Command, _ []string) error {
+			internal.CleanupPlugins()
+			return nil
+		},
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&runLocal.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&runLocal.namespaceName, "namespace", "n", "", "Namespace of the job")
+	cmd.Flags().StringVar(&runLocal.scheduledAt, "scheduled-at", "", "Time the job run is scheduled at, in RFC3339")
+	cmd.Flags().StringVar(&runLocal.outputDir, "output-dir", runLocal.outputDir, "Directory for the files, logs and events of the run")
+	cmd.Flags().StringVar(&runLocal.libraryDir, "library-dir", "", "Directory of template library files, named after the library, used by the assets")
+	cmd.Flags().StringVar(&runLocal.secretsFile, "secrets-file", "", "File of NAME=value lines with the values of the secrets, secrets are placeholders otherwise")
+	cmd.Flags().StringVar(&runLocal.runtime, "runtime", runLocal.runtime, "Docker compatible container runtime, eg. podman")
+	cmd.MarkFlagRequired("namespace")
+	cmd.MarkFlagRequired("scheduled-at")
+	return cmd
+}
+
+func (r *runLocalCommand) PreRunE(_ *cobra.Command, _ []string) error {
+	conf, err := config.LoadClientConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+	r.clientConfig = conf
+
+	if _, err := exec.LookPath(r.runtime); err != nil {
+		return fmt.Errorf("container runtime %s is not found: %w", r.runtime, err)
+	}
+
+	r.pluginRepo, err = internal.InitPlugins(config.LogLevel(r.logger.Level()))
+	return err
+}
+
+func (r *runLocalCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	scheduledAt, err := time.Parse(time.RFC3339, r.scheduledAt)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled at, please use %s: %w", time.RFC3339, err)
+	}
+
+	secretValues, err := readSecretsFile(r.secretsFile)
+	if err != nil {
+		return err
+	}
+	local, err := readLocalJob(r.clientConfig, r.namespaceName, jobName, secretValues)
+	if err != nil {
+		return err
+	}
+	libraries, err := readLibraries(r.libraryDir)
+	if err != nil {
+		return err
+	}
+
+	task, err := dag.PrepareTask(local.job, r.pluginRepo)
+	if err != nil {
+		return err
+	}
+	hooks, err := dag.PrepareHooksForJob(local.job, r.pluginRepo)
+	if err != nil {
+		return err
+	}
+
+	engine := compiler.NewEngine()
+	assetCompiler := service.NewJobAssetsCompiler(engine, r.pluginRepo, &localLibraryRepo{libraries: libraries}, r.logger)
+	inputCompiler := service.NewJobInputCompiler(&localTenantService{details: local.details}, engine, assetCompiler, r.logger)
+
+	jobDir, err := filepath.Abs(filepath.Join(r.outputDir, jobName))
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(jobDir); err != nil {
+		return fmt.Errorf("error cleaning up %s: %w", jobDir, err)
+	}
+	if err := os.MkdirAll(filepath.Join(jobDir, runLocalLogsDirectory), os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory at %s: %w", jobDir, err)
+	}
+	events, err := newLocalEventSink(filepath.Join(jobDir, runLocalEventsFileName), jobName, scheduledAt)
+	if err != nil {
+		return err
+	}
+	defer events.Close()
+
+	run := &localRun{
+		command:       r,
+		local:         local,
+		inputCompiler: inputCompiler,
+		events:        events,
+		jobDir:        jobDir,
+		scheduledAt:   scheduledAt,
+	}
+
+	failed := false
+	orderedHooks := orderHooks(hooks)
+	runHooks := func(hooksOfType []dag.Hook) {
+		for _, hook := range filterHooks(orderedHooks, hooksOfType) {
+			if !shouldRunHook(hook, failed) {
+				run.skip(scheduler.ExecutorHook, hook.Name)
+				continue
+			}
+			if !run.execute(scheduler.ExecutorHook, hook.Name, hook.Image, hook.Entrypoint, behaviorOf(hook)) {
+				failed = true
+			}
+		}
+	}
+	runHooks(hooks.Pre)
+	if !failed {
+		failed = !run.execute(scheduler.ExecutorTask, task.Name, task.Image, task.Entrypoint, executorBehavior{})
+	} else {
+		run.skip(scheduler.ExecutorTask, task.Name)
+	}
+	runHooks(hooks.Post)
+	runHooks(hooks.Fail)
+
+	jobEvent := scheduler.JobSuccessEvent
+	if failed {
+		jobEvent = scheduler.JobFailureEvent
+	}
+	events.Send(jobEvent, "", nil)
+
+	r.printResults(run.results)
+	r.logger.Info("Logs and events of the run are written to %s", jobDir)
+	if failed {
+		return fmt.Errorf("local run of job %s failed", jobName)
+	}
+	return nil
+}
+
+func (*runLocalCommand) printResults(results []localRunResult) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Type", "Name", "Exit Code", "Duration", "Log"})
+	for _, result := range results {
+		exitCode := fmt.Sprintf("%d", result.exitCode)
+		if result.err != nil {
+			exitCode = result.err.Error()
+		}
+		if result.skipped {
+			exitCode = "skipped"
+		}
+		table.Append([]string{
+			result.executorType.String(),
+			result.name,
+			exitCode,
+			result.duration.Round(time.Second).String(),
+			result.logPath,
+		})
+	}
+	table.Render()
+}
+
+type localRunResult struct {
+	executorType scheduler.ExecutorType
+	name         string
+	exitCode     int
+	err          error
+	skipped      bool
+	duration     time.Duration
+	logPath      string
+}
+
+// executorBehavior is the retry and timeout of an executor, the same as its operator in the scheduler DAG
+type executorBehavior struct {
+	retries    int
+	retryDelay time.Duration
+	timeout    time.Duration
+}
+
+func behaviorOf(hook dag.Hook) executorBehavior { //nolint: gocritic
+	return executorBehavior{
+		retries:    hook.Retries,
+		retryDelay: time.Duration(hook.RetryDelaySeconds) * time.Second,
+		timeout:    time.Duration(hook.TimeoutSeconds) * time.Second,
+	}
+}
+
+// orderHooks sorts the hooks so that every hook comes after the hooks it depends on,
+// keeping the order of the job spec otherwise
+func orderHooks(hooks dag.Hooks) []dag.Hook { //nolint: gocritic
+	list := hooks.List()
+	dependencies := map[string][]string{}
+	for _, dependency := range hooks.Dependencies {
+		dependencies[dependency.After] = append(dependencies[dependency.After], dependency.Before)
+	}
+
+	ordered := make([]dag.Hook, 0, len(list))
+	visited := map[string]bool{}
+	hooksByName := map[string]dag.Hook{}
+	for _, hook := range list {
+		hooksByName[hook.Name] = hook
+	}
+	var visit func(hook dag.Hook)
+	visit = func(hook dag.Hook) {
+		// dependencies are validated to be acyclic in the job spec, marking before visiting guards the rest
+		if visited[hook.Name] {
+			return
+		}
+		visited[hook.Name] = true
+		for _, before := range dependencies[hook.Name] {
+			if beforeHook, ok := hooksByName[before]; ok {
+				visit(beforeHook)
+			}
+		}
+		ordered = append(ordered, hook)
+	}
+	for _, hook := range list {
+		visit(hook)
+	}
+	return ordered
+}
+
+// filterHooks returns the hooks of the ordered list that are in the given hooks of a type
+func filterHooks(ordered, ofType []dag.Hook) []dag.Hook {
+	names := map[string]bool{}
+	for _, hook := range ofType {
+		names[hook.Name] = true
+	}
+	var filtered []dag.Hook
+	for _, hook := range ordered {
+		if names[hook.Name] {
+			filtered = append(filtered, hook)
+		}
+	}
+	return filtered
+}
+
+// shouldRunHook follows the trigger rule of the hook in the scheduler DAG
+func shouldRunHook(hook dag.Hook, failed bool) bool { //nolint: gocritic
+	switch hook.TriggerRule {
+	case dag.TriggerRuleAllDone:
+		return true
+	case dag.TriggerRuleOneFailed:
+		return failed
+	default:
+		return !failed
+	}
+}
+
+// localRun runs the executors of a job run one after another in containers
+type localRun struct {
+	command       *runLocalCommand
+	local         *localJob
+	inputCompiler *service.InputCompiler
+	events        *localEventSink
+	jobDir        string
+	scheduledAt   time.Time
+
+	results []localRunResult
+}
+
+// execute runs the executor to completion, retrying it on failure, and tells whether it succeeded
+func (l *localRun) execute(executorType scheduler.ExecutorType, name, image string, entrypoint plugin.Entrypoint, behavior executorBehavior) bool {
+	startEvent, successEvent, retryEvent, failEvent := scheduler.TaskStartEvent, scheduler.TaskSuccessEvent, scheduler.TaskRetryEvent, scheduler.TaskFailEvent
+	if executorType == scheduler.ExecutorHook {
+		startEvent, successEvent, retryEvent, failEvent = scheduler.HookStartEvent, scheduler.HookSuccessEvent, scheduler.HookRetryEvent, scheduler.HookFailEvent
+	}
+
+	for attempt := 0; ; attempt++ {
+		result := localRunResult{
+			executorType: executorType,
+			name:         name,
+			logPath:      filepath.Join(l.jobDir, runLocalLogsDirectory, fmt.Sprintf("%s_%s.log", executorType, name)),
+		}
+		if attempt > 0 {
+			result.logPath = filepath.Join(l.jobDir, runLocalLogsDirectory, fmt.Sprintf("%s_%s.%d.log", executorType, name, attempt))
+		}
+		l.events.Send(startEvent, name, nil)
+		l.command.logger.Info("\n> running %s %s with image %s", executorType, name, image)
+
+		start := time.Now()
+		result.exitCode, result.err = l.runContainer(executorType, name, image, entrypoint, result.logPath, behavior.timeout)
+		result.duration = time.Since(start)
+		l.results = append(l.results, result)
+
+		if result.err == nil && result.exitCode == 0 {
+			l.events.Send(successEvent, name, &result.exitCode)
+			return true
+		}
+		if attempt >= behavior.retries {
+			l.command.logger.Error("%s %s failed", executorType, name)
+			l.events.Send(failEvent, name, &result.exitCode)
+			return false
+		}
+		l.command.logger.Warn("%s %s failed, retrying in %s", executorType, name, behavior.retryDelay)
+		l.events.Send(retryEvent, name, &result.exitCode)
+		time.Sleep(behavior.retryDelay)
+	}
+}
+
+// skip records an executor not run because of the outcome of the executors before it
+func (l *localRun) skip(executorType scheduler.ExecutorType, name string) {
+	l.command.logger.Info("\n> skipping %s %s", executorType, name)
+	l.results = append(l.results, localRunResult{
+		executorType: executorType,
+		name:         name,
+		skipped:      true,
+	})
+}
+
+func (l *localRun) runContainer(executorType scheduler.ExecutorType, name, image string, entrypoint plugin.Entrypoint, logPath string, timeout time.Duration) (int, error) {
+	executor := scheduler.Executor{Name: name, Type: executorType}
+	input, err := l.inputCompiler.Compile(context.Background(), l.local.job, scheduler.RunConfig{Executor: executor, ScheduledAt: l.scheduledAt}, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("error compiling input: %w", err)
+	}
+
+	// each executor has its own job dir, as each pod of the scheduler has its own volume
+	dataDir := filepath.Join(l.jobDir, fmt.Sprintf("%s_%s", executorType, name))
+	inputDir := filepath.Join(dataDir, taskInputDirectory)
+	if err := writeRenderedFiles(inputDir, input.Files); err != nil {
+		return 0, err
+	}
+	if err := writeRenderedFiles(inputDir, map[string]string{
+		typeEnvFileName:    toEnvFileContent(input.Configs),
+		typeSecretFileName: toEnvFileContent(input.Secrets),
+	}); err != nil {
+		return 0, err
+	}
+
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create log file at %s: %w", logPath, err)
+	}
+	defer logFile.Close()
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, l.command.runtime, l.containerArgs(dataDir, image, entrypoint)...) //nolint:gosec
+	output := io.MultiWriter(os.Stdout, logFile)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return 0, fmt.Errorf("timed out after %s", timeout)
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode(), nil
+		}
+		return 0, err
+	}
+	return 0, nil
+}
+
+func (l *localRun) containerArgs(dataDir, image string, entrypoint plugin.Entrypoint) []string {
+	labels := make([]string, 0, len(l.local.spec.Labels))
+	for key, value := range l.local.spec.Labels {
+		labels = append(labels, fmt.Sprintf("%s=%s", strings.TrimSpace(key), strings.TrimSpace(value)))
+	}
+	sort.Strings(labels)
+
+	return []string{
+		"run", "--rm",
+		"--volume", fmt.Sprintf("%s:%s", dataDir, containerJobDir),
+		"--env", "JOB_DIR=" + containerJobDir,
+		"--env", "JOB_NAME=" + l.local.spec.Name,
+		"--env", "JOB_LABELS=" + strings.Join(labels, ","),
+		"--entrypoint", entrypoint.Shell,
+		image,
+		"-c", containerEntrypointScript(entrypoint.Script),
+	}
+}
+
+// containerEntrypointScript exports the configs and secrets of the job run before running the plugin
+// entrypoint, the same as the operators of the scheduler
+func containerEntrypointScript(pluginScript string) string {
+	pathConfig := containerJobDir + "/" + taskInputDirectory + "/" + typeEnvFileName
+	pathSecret := containerJobDir + "/" + taskInputDirectory + "/" + typeSecretFileName
+	script := fmt.Sprintf("set -o allexport; source %s; set +o allexport; cat %s; ", pathConfig, pathConfig)
+	script += fmt.Sprintf("set -o allexport; source %s; set +o allexport; ", pathSecret)
+	script += fmt.Sprintf(`if [ "$%s" = "true" ]; then echo "skipping hook, run_if is false"; exit 0; fi; `, scheduler.HookSkipConfig)
+	return script + pluginScript
+}
+
+// readSecretsFile reads the secret values from NAME=value lines, ignoring empty lines and comments
+func readSecretsFile(filePath string) (map[string]string, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening secrets file %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	secrets := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid line in secrets file %s, expected NAME=value", filePath)
+		}
+		secrets[strings.TrimSpace(name)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return secrets, scanner.Err()
+}
+
+// localEventSink stands in for the server, writing the events of the local run as json lines
+type localEventSink struct {
+	file        *os.File
+	encoder     *json.Encoder
+	jobName     string
+	scheduledAt time.Time
+}
+
+type localEvent struct {
+	Type        scheduler.JobEventType `json:"type"`
+	JobName     string                 `json:"job_name"`
+	Name        string                 `json:"name,omitempty"`
+	ScheduledAt string                 `json:"scheduled_at"`
+	EventTime   string                 `json:"event_time"`
+	ExitCode    *int                   `json:"exit_code,omitempty"`
+}
+
+func newLocalEventSink(filePath, jobName string, scheduledAt time.Time) (*localEventSink, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create events file at %s: %w", filePath, err)
+	}
+	return &localEventSink{
+		file:        file,
+		encoder:     json.NewEncoder(file),
+		jobName:     jobName,
+		scheduledAt: scheduledAt,
+	}, nil
+}
+
+func (s *localEventSink) Send(eventType scheduler.JobEventType, name string, exitCode *int) {
+	// the events are only informational, failing to record one should not fail the run
+	_ = s.encoder.Encode(localEvent{
+		Type:        eventType,
+		JobName:     s.jobName,
+		Name:        name,
+		ScheduledAt: s.scheduledAt.Format(time.RFC3339),
+		EventTime:   time.Now().Format(time.RFC3339),
+		ExitCode:    exitCode,
+	})
+}
+
+func (s *localEventSink) Close() error {
+	return s.file.Close()
+}
+
+// localLibraryRepo serves the template libraries read from the local directory
+type localLibraryRepo struct {
+	libraries map[string]string
+}
+
+func (r *localLibraryRepo) GetTemplateLibraries(_ context.Context, _ tenant.Tenant) (map[string]string, error) {
+	return r.libraries, nil
+}
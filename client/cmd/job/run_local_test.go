@@ -0,0 +1,328 @@
+package job
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/ext/scheduler/airflow/dag"
+	"github.com/raystack/optimus/internal/compiler"
+	"github.com/raystack/optimus/sdk/plugin"
+)
+
+func TestRunLocal(t *testing.T) {
+	hookNames := func(hooks []dag.Hook) []string {
+		names := make([]string, len(hooks))
+		for i, hook := range hooks {
+			names[i] = hook.Name
+		}
+		return names
+	}
+
+	t.Run("orderHooks", func(t *testing.T) {
+		t.Run("keeps the order of the job spec when the hooks have no dependency", func(t *testing.T) {
+			hooks := dag.Hooks{
+				Pre:  []dag.Hook{{Name: "transporter"}, {Name: "validator"}},
+				Post: []dag.Hook{{Name: "predator"}},
+				Fail: []dag.Hook{{Name: "alert"}},
+			}
+
+			assert.Equal(t, []string{"transporter", "validator", "predator", "alert"}, hookNames(orderHooks(hooks)))
+		})
+		t.Run("puts every hook after the hooks it depends on", func(t *testing.T) {
+			hooks := dag.Hooks{
+				Pre:  []dag.Hook{{Name: "transporter"}, {Name: "validator"}},
+				Post: []dag.Hook{{Name: "predator"}, {Name: "exporter"}},
+				Dependencies: []dag.HookDependency{
+					{Before: "validator", After: "transporter"},
+					{Before: "exporter", After: "predator"},
+					{Before: "transporter", After: "exporter"},
+				},
+			}
+
+			assert.Equal(t, []string{"validator", "transporter", "exporter", "predator"}, hookNames(orderHooks(hooks)))
+		})
+		t.Run("ignores the dependencies on hooks not in the job", func(t *testing.T) {
+			hooks := dag.Hooks{
+				Pre:          []dag.Hook{{Name: "transporter"}},
+				Dependencies: []dag.HookDependency{{Before: "missing", After: "transporter"}},
+			}
+
+			assert.Equal(t, []string{"transporter"}, hookNames(orderHooks(hooks)))
+		})
+	})
+	t.Run("filterHooks", func(t *testing.T) {
+		t.Run("returns the hooks of the type in the order of the ordered hooks", func(t *testing.T) {
+			ordered := []dag.Hook{{Name: "validator"}, {Name: "exporter"}, {Name: "transporter"}, {Name: "predator"}}
+			pre := []dag.Hook{{Name: "transporter"}, {Name: "validator"}}
+
+			assert.Equal(t, []string{"validator", "transporter"}, hookNames(filterHooks(ordered, pre)))
+		})
+		t.Run("returns nothing when there is no hook of the type", func(t *testing.T) {
+			assert.Empty(t, filterHooks([]dag.Hook{{Name: "transporter"}}, nil))
+		})
+	})
+	t.Run("shouldRunHook", func(t *testing.T) {
+		testCases := []struct {
+			triggerRule string
+			failed      bool
+			expected    bool
+		}{
+			{triggerRule: "", failed: false, expected: true},
+			{triggerRule: "", failed: true, expected: false},
+			{triggerRule: dag.TriggerRuleAllSuccess, failed: false, expected: true},
+			{triggerRule: dag.TriggerRuleAllSuccess, failed: true, expected: false},
+			{triggerRule: dag.TriggerRuleOneFailed, failed: false, expected: false},
+			{triggerRule: dag.TriggerRuleOneFailed, failed: true, expected: true},
+			{triggerRule: dag.TriggerRuleAllDone, failed: false, expected: true},
+			{triggerRule: dag.TriggerRuleAllDone, failed: true, expected: true},
+		}
+		for _, testCase := range testCases {
+			hook := dag.Hook{Name: "hook", TriggerRule: testCase.triggerRule}
+
+			assert.Equal(t, testCase.expected, shouldRunHook(hook, testCase.failed),
+				"trigger rule [%s] after failed [%t]", testCase.triggerRule, testCase.failed)
+		}
+	})
+	t.Run("containerEntrypointScript", func(t *testing.T) {
+		t.Run("exports the configs and secrets and skips the hook with run_if evaluated to false before the plugin script", func(t *testing.T) {
+			script := containerEntrypointScript("python3 main.py")
+
+			assert.Equal(t, "set -o allexport; source /data/in/.env; set +o allexport; cat /data/in/.env; "+
+				"set -o allexport; source /data/in/.secret; set +o allexport; "+
+				`if [ "$OPTIMUS_SKIP_HOOK" = "true" ]; then echo "skipping hook, run_if is false"; exit 0; fi; `+
+				"python3 main.py", script)
+		})
+	})
+	t.Run("containerArgs", func(t *testing.T) {
+		t.Run("mounts the data dir and runs the image with the entrypoint of the plugin", func(t *testing.T) {
+			local, err := readLocalJob(newClientConfig(t), "sample-namespace", "sample-job", nil)
+			assert.NoError(t, err)
+			local.spec.Labels = map[string]string{"team": " data ", "orchestrator": "optimus"}
+			run := &localRun{local: local}
+
+			args := run.containerArgs("/tmp/run/hook_transporter", "transporter:latest", plugin.Entrypoint{Shell: "/bin/sh", Script: "java -jar transporter.jar"})
+
+			assert.Equal(t, []string{
+				"run", "--rm",
+				"--volume", "/tmp/run/hook_transporter:/data",
+				"--env", "JOB_DIR=/data",
+				"--env", "JOB_NAME=sample-job",
+				"--env", "JOB_LABELS=orchestrator=optimus,team=data",
+				"--entrypoint", "/bin/sh",
+				"transporter:latest",
+				"-c", containerEntrypointScript("java -jar transporter.jar"),
+			}, args)
+		})
+	})
+	t.Run("readSecretsFile", func(t *testing.T) {
+		t.Run("returns nothing when the secrets file is not given", func(t *testing.T) {
+			secrets, err := readSecretsFile("")
+
+			assert.NoError(t, err)
+			assert.Nil(t, secrets)
+		})
+		t.Run("returns error when the secrets file can not be opened", func(t *testing.T) {
+			_, err := readSecretsFile(filepath.Join(t.TempDir(), "missing.env"))
+
+			assert.ErrorContains(t, err, "error opening secrets file")
+		})
+		t.Run("returns error when a line is not a name and value", func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), ".secrets.env")
+			assert.NoError(t, os.WriteFile(filePath, []byte("API_TOKEN=token\nDB_PASSWORD\n"), 0o600))
+
+			_, err := readSecretsFile(filePath)
+
+			assert.ErrorContains(t, err, "expected NAME=value")
+		})
+		t.Run("returns the values of the secrets, ignoring empty lines and comments", func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), ".secrets.env")
+			assert.NoError(t, os.WriteFile(filePath, []byte("# local secrets\n\n"+
+				"API_TOKEN = token\n"+
+				"DB_PASSWORD='pass=word'\n"+
+				`SALT="salt"`+"\n"), 0o600))
+
+			secrets, err := readSecretsFile(filePath)
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"API_TOKEN":   "token",
+				"DB_PASSWORD": "pass=word",
+				"SALT":        "salt",
+			}, secrets)
+		})
+	})
+	t.Run("localEventSink", func(t *testing.T) {
+		t.Run("writes the events as json lines", func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), runLocalEventsFileName)
+			scheduledAt := time.Date(2023, 1, 14, 2, 0, 0, 0, time.UTC)
+			sink, err := newLocalEventSink(filePath, "sample-job", scheduledAt)
+			assert.NoError(t, err)
+
+			exitCode := 1
+			sink.Send(scheduler.TaskFailEvent, "bq2bq", &exitCode)
+			sink.Send(scheduler.JobFailureEvent, "", nil)
+			assert.NoError(t, sink.Close())
+
+			events := readLocalEvents(t, filePath)
+			assert.Len(t, events, 2)
+			assert.Equal(t, scheduler.TaskFailEvent, events[0].Type)
+			assert.Equal(t, "sample-job", events[0].JobName)
+			assert.Equal(t, "bq2bq", events[0].Name)
+			assert.Equal(t, "2023-01-14T02:00:00Z", events[0].ScheduledAt)
+			assert.Equal(t, &exitCode, events[0].ExitCode)
+			assert.Equal(t, scheduler.JobFailureEvent, events[1].Type)
+			assert.Empty(t, events[1].Name)
+			assert.Nil(t, events[1].ExitCode)
+		})
+		t.Run("returns error when the events file can not be created", func(t *testing.T) {
+			_, err := newLocalEventSink(filepath.Join(t.TempDir(), "missing", runLocalEventsFileName), "sample-job", time.Now())
+
+			assert.ErrorContains(t, err, "failed to create events file")
+		})
+	})
+	t.Run("localRun", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 1, 14, 2, 0, 0, 0, time.UTC)
+
+		// newLocalRun runs the containers with a fake runtime, which exits with the code in the exit_code
+		// file of the data dir, so the outcome of every executor is set by the test
+		newLocalRun := func(t *testing.T) *localRun {
+			t.Helper()
+
+			jobDir := t.TempDir()
+			runtime := filepath.Join(t.TempDir(), "runtime")
+			assert.NoError(t, os.WriteFile(runtime, []byte("#!/bin/sh\n"+
+				`data_dir=$(echo "$4" | cut -d: -f1)`+"\n"+
+				`exit $(cat "$data_dir/exit_code" 2>/dev/null || echo 0)`+"\n"), 0o700)) //nolint:gosec
+			assert.NoError(t, os.MkdirAll(filepath.Join(jobDir, runLocalLogsDirectory), os.ModePerm))
+
+			local, err := readLocalJob(newClientConfig(t), "sample-namespace", "sample-job", nil)
+			assert.NoError(t, err)
+			events, err := newLocalEventSink(filepath.Join(jobDir, runLocalEventsFileName), "sample-job", scheduledAt)
+			assert.NoError(t, err)
+			t.Cleanup(func() { events.Close() })
+
+			command := &runLocalCommand{logger: log.NewNoop(), runtime: runtime}
+			engine := compiler.NewEngine()
+			return &localRun{
+				command:       command,
+				local:         local,
+				inputCompiler: service.NewJobInputCompiler(&localTenantService{details: local.details}, engine, &localAssetCompiler{engine: engine}, command.logger),
+				events:        events,
+				jobDir:        jobDir,
+				scheduledAt:   scheduledAt,
+			}
+		}
+		failWith := func(t *testing.T, run *localRun, executorType scheduler.ExecutorType, name, exitCode string) {
+			t.Helper()
+
+			dataDir := filepath.Join(run.jobDir, string(executorType)+"_"+name)
+			assert.NoError(t, os.MkdirAll(dataDir, os.ModePerm))
+			assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "exit_code"), []byte(exitCode), 0o600))
+		}
+		readInput := func(t *testing.T, run *localRun, executorType scheduler.ExecutorType, name, fileName string) string {
+			t.Helper()
+
+			content, err := os.ReadFile(filepath.Join(run.jobDir, string(executorType)+"_"+name, taskInputDirectory, fileName))
+			assert.NoError(t, err)
+			return string(content)
+		}
+		eventTypes := func(t *testing.T, run *localRun) []string {
+			t.Helper()
+
+			var types []string
+			for _, event := range readLocalEvents(t, filepath.Join(run.jobDir, runLocalEventsFileName)) {
+				types = append(types, string(event.Type)+":"+event.Name)
+			}
+			return types
+		}
+
+		t.Run("writes the compiled input of the executor into its data dir and succeeds", func(t *testing.T) {
+			run := newLocalRun(t)
+
+			succeeded := run.execute(scheduler.ExecutorTask, "bq2bq", "bq2bq:latest", plugin.Entrypoint{Shell: "/bin/sh"}, executorBehavior{})
+
+			assert.True(t, succeeded)
+			assert.Equal(t, "select * from sample_dataset.sample where dt = '2023-01-13'", readInput(t, run, scheduler.ExecutorTask, "bq2bq", "query.sql"))
+			assert.Contains(t, readInput(t, run, scheduler.ExecutorTask, "bq2bq", typeEnvFileName), "TABLE='sample'\n")
+			assert.Equal(t, "TOKEN='<secret:API_TOKEN>'\n", readInput(t, run, scheduler.ExecutorTask, "bq2bq", typeSecretFileName))
+			assert.Len(t, run.results, 1)
+			assert.Equal(t, 0, run.results[0].exitCode)
+			assert.NoError(t, run.results[0].err)
+			assert.Equal(t, []string{"task_start:bq2bq", "task_success:bq2bq"}, eventTypes(t, run))
+		})
+		t.Run("retries the failed executor and fails after the last retry", func(t *testing.T) {
+			run := newLocalRun(t)
+			failWith(t, run, scheduler.ExecutorHook, "transporter", "3")
+
+			succeeded := run.execute(scheduler.ExecutorHook, "transporter", "transporter:latest", plugin.Entrypoint{Shell: "/bin/sh"}, executorBehavior{retries: 1})
+
+			assert.False(t, succeeded)
+			assert.Len(t, run.results, 2)
+			assert.Equal(t, 3, run.results[0].exitCode)
+			assert.Equal(t, filepath.Join(run.jobDir, runLocalLogsDirectory, "hook_transporter.log"), run.results[0].logPath)
+			assert.Equal(t, filepath.Join(run.jobDir, runLocalLogsDirectory, "hook_transporter.1.log"), run.results[1].logPath)
+			assert.Equal(t, []string{
+				"hook_start:transporter", "hook_retry:transporter",
+				"hook_start:transporter", "hook_fail:transporter",
+			}, eventTypes(t, run))
+		})
+		t.Run("marks the hook to be skipped in its configs when run_if evaluates to false", func(t *testing.T) {
+			run := newLocalRun(t)
+			run.local.job.Hooks[0].RunIf = `{{ eq .DSTART "2023-01-01T00:00:00Z" }}`
+
+			succeeded := run.execute(scheduler.ExecutorHook, "transporter", "transporter:latest", plugin.Entrypoint{Shell: "/bin/sh"}, executorBehavior{})
+
+			assert.True(t, succeeded)
+			assert.Contains(t, readInput(t, run, scheduler.ExecutorHook, "transporter", typeEnvFileName), "OPTIMUS_SKIP_HOOK='true'\n")
+			assert.Contains(t, readInput(t, run, scheduler.ExecutorHook, "transporter", typeEnvFileName), "FILTER='dt >= '2023-01-13''\n")
+		})
+		t.Run("does not run the executor when its input can not be compiled", func(t *testing.T) {
+			run := newLocalRun(t)
+			run.local.job.Hooks[0].RunIf = `{{ .DSTART }}`
+
+			succeeded := run.execute(scheduler.ExecutorHook, "transporter", "transporter:latest", plugin.Entrypoint{Shell: "/bin/sh"}, executorBehavior{})
+
+			assert.False(t, succeeded)
+			assert.Len(t, run.results, 1)
+			assert.ErrorContains(t, run.results[0].err, "error compiling input")
+		})
+		t.Run("records the skipped executor", func(t *testing.T) {
+			run := newLocalRun(t)
+
+			run.skip(scheduler.ExecutorTask, "bq2bq")
+
+			assert.Equal(t, []localRunResult{{executorType: scheduler.ExecutorTask, name: "bq2bq", skipped: true}}, run.results)
+			assert.Empty(t, eventTypes(t, run))
+		})
+	})
+}
+
+func readLocalEvents(t *testing.T, filePath string) []localEvent {
+	t.Helper()
+
+	file, err := os.Open(filePath)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	var events []localEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var event localEvent
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,174 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/user"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const updateRunStateTimeout = time.Minute * 1
+
+type updateRunStateCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	scheduledAt string
+	endDate     string
+	state       string
+	changedBy   string
+	reason      string
+
+	projectName string
+	host        string
+}
+
+// NewUpdateRunStateCommand initializes command to manually set the state of job runs
+func NewUpdateRunStateCommand() *cobra.Command {
+	run := &updateRunStateCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update-run-state",
+		Short: "Manually mark job runs as success, failed or skipped",
+		Long: "Set the state of a job run, or of the runs scheduled in a range, both on the scheduler and optimus. " +
+			"Use it to let the downstream sensors continue after an incident, or to skip a run.",
+		Example: `optimus job update-run-state <job_name> --scheduled-at "2006-01-02T15:04:05Z" [--end-date "2006-01-05T15:04:05Z"] --state success --reason "upstream incident"`,
+		Args:    cobra.ExactArgs(1),
+		RunE:    run.RunE,
+		PreRunE: run.PreRunE,
+	}
+	run.injectFlags(cmd)
+	internal.MarkFlagsRequired(cmd, []string{"scheduled-at", "state", "reason"})
+	return cmd
+}
+
+func (u *updateRunStateCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&u.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVar(&u.scheduledAt, "scheduled-at", "", "Scheduled time of the job run, start of the range if end date is given")
+	cmd.Flags().StringVar(&u.endDate, "end-date", "", "Scheduled time of the last job run to be updated")
+	cmd.Flags().StringVar(&u.state, "state", "", "State to be set on the job runs (success / failed / skipped)")
+	cmd.Flags().StringVar(&u.reason, "reason", "", "Reason for changing the state of the job runs")
+	cmd.Flags().StringVar(&u.changedBy, "changed-by", "", "Who changed the state, defaults to the current user")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&u.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&u.host, "host", "", "Optimus service endpoint url")
+}
+
+func (u *updateRunStateCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(u.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if u.changedBy == "" {
+		currentUser, err := user.Current()
+		if err != nil {
+			return fmt.Errorf("unable to get current user, please provide --changed-by: %w", err)
+		}
+		u.changedBy = currentUser.Username
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if u.projectName == "" {
+		u.projectName = conf.Project.Name
+	}
+	if u.host == "" {
+		u.host = conf.Host
+	}
+	return nil
+}
+
+func (u *updateRunStateCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	req, err := u.createUpdateRunStateRequest(jobName)
+	if err != nil {
+		return err
+	}
+
+	conn, err := u.connection.Create(u.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	run := pb.NewJobRunServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), updateRunStateTimeout)
+	defer dialCancel()
+
+	resp, err := run.UpdateJobRunState(ctx, req)
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for job %s: %w", jobName, err)
+	}
+
+	for _, jobRun := range resp.GetJobRuns() {
+		u.logger.Info("%s", formatJobRun(jobRun))
+	}
+	u.logger.Info("\nUpdated %d jobRun instances.", len(resp.GetJobRuns()))
+	return nil
+}
+
+func (u *updateRunStateCommand) createUpdateRunStateRequest(jobName string) (*pb.UpdateJobRunStateRequest, error) {
+	start, err := time.Parse(time.RFC3339, u.scheduledAt)
+	if err != nil {
+		return nil, fmt.Errorf("scheduled-at %w", err)
+	}
+	end := start
+	if u.endDate != "" {
+		end, err = time.Parse(time.RFC3339, u.endDate)
+		if err != nil {
+			return nil, fmt.Errorf("end-date %w", err)
+		}
+		if end.Before(start) {
+			return nil, errors.New("end-date cannot be before scheduled-at")
+		}
+	}
+	return &pb.UpdateJobRunStateRequest{
+		ProjectName: u.projectName,
+		JobName:     jobName,
+		StartDate:   timestamppb.New(start),
+		EndDate:     timestamppb.New(end),
+		State:       u.state,
+		ChangedBy:   u.changedBy,
+		Reason:      u.reason,
+	}, nil
+}
+
+// formatJobRun shows the state of the job run, along with who changed it and why when it is changed manually
+func formatJobRun(jobRun *pb.JobRun) string {
+	line := fmt.Sprintf("%s - %s", jobRun.GetScheduledAt().AsTime(), jobRun.GetState())
+	if jobRun.GetStateChangedBy() == "" {
+		return line
+	}
+	line += " (changed by " + jobRun.GetStateChangedBy()
+	if jobRun.GetStateChangeReason() != "" {
+		line += ": " + jobRun.GetStateChangeReason()
+	}
+	return line + ")"
+}
@@ -0,0 +1,210 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const (
+	jobStatsTimeout      = time.Minute * 1
+	defaultJobStatsRange = time.Hour * 24 * 7
+)
+
+type statsCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	namespaceName string
+	startDate     string
+	endDate       string
+
+	projectName string
+	host        string
+}
+
+// NewStatsCommand initializes command to get the stats of job runs
+func NewStatsCommand() *cobra.Command {
+	stats := &statsCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Get the stats of job runs within a time range",
+		Long: "Get the success rate, retries, sla misses and p50/p95 duration of sensors, tasks and hooks of the job runs " +
+			"scheduled within a time range, along with the daily trend. Stats are calculated for every job in the namespace, " +
+			"or in the project, when job name is not given.",
+		Example: `optimus job stats [<job_name>] [--namespace <namespace_name>] [--start-date "2006-01-02T15:04:05Z" --end-date "2006-01-09T15:04:05Z"]`,
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    stats.RunE,
+		PreRunE: stats.PreRunE,
+	}
+	stats.injectFlags(cmd)
+	return cmd
+}
+
+func (s *statsCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&s.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&s.namespaceName, "namespace", "n", "", "Namespace of the jobs, all namespaces when not given")
+	cmd.Flags().StringVar(&s.startDate, "start-date", "", "Start of the range of scheduled time, defaults to 7 days before end date")
+	cmd.Flags().StringVar(&s.endDate, "end-date", "", "End of the range of scheduled time, defaults to now")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&s.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&s.host, "host", "", "Optimus service endpoint url")
+}
+
+func (s *statsCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(s.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if s.projectName == "" {
+		s.projectName = conf.Project.Name
+	}
+	if s.host == "" {
+		s.host = conf.Host
+	}
+	return nil
+}
+
+func (s *statsCommand) RunE(_ *cobra.Command, args []string) error {
+	var jobName string
+	if len(args) > 0 {
+		jobName = args[0]
+	}
+	req, err := s.createJobRunStatsRequest(jobName)
+	if err != nil {
+		return err
+	}
+
+	conn, err := s.connection.Create(s.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	run := pb.NewJobRunServiceClient(conn)
+
+	ctx, dialCancel := context.WithTimeout(context.Background(), jobStatsTimeout)
+	defer dialCancel()
+
+	resp, err := run.GetJobRunStats(ctx, req)
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("request failed for job run stats of project %s: %w", s.projectName, err)
+	}
+
+	s.logger.Info("Job run stats from %s to %s", req.GetStartDate().AsTime().Format(time.RFC3339), req.GetEndDate().AsTime().Format(time.RFC3339))
+	s.logger.Info("Total runs: %d, success: %d, failed: %d, success rate: %.2f%%",
+		resp.GetTotalRuns(), resp.GetSuccessRuns(), resp.GetFailedRuns(), resp.GetSuccessRate()*100)
+	s.logger.Info("Retries: %d, SLA misses: %d", resp.GetRetries(), resp.GetSlaMisses())
+	if len(resp.GetOperators()) > 0 {
+		s.logger.Info("\nOperators:\n%s", stringifyOperatorRunStats(resp.GetOperators()))
+	}
+	if len(resp.GetTrend()) > 0 {
+		s.logger.Info("Trend:\n%s", stringifyJobRunStatsTrend(resp.GetTrend()))
+	}
+	return nil
+}
+
+func (s *statsCommand) createJobRunStatsRequest(jobName string) (*pb.GetJobRunStatsRequest, error) {
+	end := time.Now().UTC()
+	if s.endDate != "" {
+		var err error
+		end, err = time.Parse(time.RFC3339, s.endDate)
+		if err != nil {
+			return nil, fmt.Errorf("end-date %w", err)
+		}
+	}
+	start := end.Add(-defaultJobStatsRange)
+	if s.startDate != "" {
+		var err error
+		start, err = time.Parse(time.RFC3339, s.startDate)
+		if err != nil {
+			return nil, fmt.Errorf("start-date %w", err)
+		}
+	}
+	return &pb.GetJobRunStatsRequest{
+		ProjectName:   s.projectName,
+		NamespaceName: s.namespaceName,
+		JobName:       jobName,
+		StartDate:     timestamppb.New(start),
+		EndDate:       timestamppb.New(end),
+	}, nil
+}
+
+func stringifyOperatorRunStats(operators []*pb.OperatorRunStats) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"operator",
+		"runs",
+		"retries",
+		"p50 duration",
+		"p95 duration",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, operator := range operators {
+		table.Append([]string{
+			operator.GetOperatorType(),
+			strconv.Itoa(int(operator.GetRuns())),
+			strconv.Itoa(int(operator.GetRetries())),
+			operator.GetP50Duration().AsDuration().Round(time.Second).String(),
+			operator.GetP95Duration().AsDuration().Round(time.Second).String(),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
+
+func stringifyJobRunStatsTrend(trend []*pb.JobRunStatsTrend) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"date",
+		"runs",
+		"success",
+		"sla misses",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, day := range trend {
+		table.Append([]string{
+			day.GetDate().AsTime().Format("2006-01-02"),
+			strconv.Itoa(int(day.GetTotalRuns())),
+			strconv.Itoa(int(day.GetSuccessRuns())),
+			strconv.Itoa(int(day.GetSlaMisses())),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
@@ -134,7 +134,8 @@ func (v *validateCommand) getCheckJobSpecificationsResponse(stream pb.JobSpecifi
 		}
 
 		if logStatus := resp.GetLogStatus(); logStatus != nil {
-			if v.verbose {
+			// warnings, like the windows not covered by the upstreams, are printed without verbose as well
+			if v.verbose || logStatus.GetLevel() == pb.Level_LEVEL_WARNING {
 				logger.PrintLogStatusVerbose(v.logger, logStatus)
 			} else {
 				logger.PrintLogStatus(v.logger, logStatus)
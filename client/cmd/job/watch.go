@@ -0,0 +1,253 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+// maxWatchedRuns is the number of most recently changed runs shown
+const maxWatchedRuns = 50
+
+type watchCommand struct {
+	logger         log.Logger
+	connection     *connection.Insecure
+	configFilePath string
+
+	namespaceName string
+	jobNames      []string
+	labels        map[string]string
+
+	projectName string
+	host        string
+}
+
+// NewWatchCommand initializes command to watch the state changes of job runs
+func NewWatchCommand() *cobra.Command {
+	watch := &watchCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch the job runs changing state in real time",
+		Long: "Watch the state changes of the job runs of the project as they happen, " +
+			"optionally only of a namespace, of the given jobs or of the jobs with the given labels.",
+		Example: `optimus job watch [--namespace <namespace_name>] [--jobs job1,job2] [--labels team=data]`,
+		Args:    cobra.NoArgs,
+		RunE:    watch.RunE,
+		PreRunE: watch.PreRunE,
+	}
+	watch.injectFlags(cmd)
+	return cmd
+}
+
+func (w *watchCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&w.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&w.namespaceName, "namespace", "n", "", "Namespace of the jobs, all namespaces when not given")
+	cmd.Flags().StringSliceVarP(&w.jobNames, "jobs", "J", nil, "Job names, all jobs when not given")
+	cmd.Flags().StringToStringVarP(&w.labels, "labels", "l", nil, "Labels the jobs should have, e.g. team=data")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&w.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&w.host, "host", "", "Optimus service endpoint url")
+}
+
+func (w *watchCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(w.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if w.projectName == "" {
+		w.projectName = conf.Project.Name
+	}
+	if w.host == "" {
+		w.host = conf.Host
+	}
+	return nil
+}
+
+func (w *watchCommand) RunE(_ *cobra.Command, _ []string) error {
+	conn, err := w.connection.Create(w.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	run := pb.NewJobRunServiceClient(conn)
+	stream, err := run.WatchJobRuns(ctx, &pb.WatchJobRunsRequest{
+		ProjectName:   w.projectName,
+		NamespaceName: w.namespaceName,
+		JobNames:      w.jobNames,
+		Labels:        w.labels,
+	})
+	if err != nil {
+		return fmt.Errorf("request failed to watch job runs of project %s: %w", w.projectName, err)
+	}
+
+	changes := make(chan tea.Msg)
+	go receiveJobRunChanges(ctx, stream, changes)
+
+	model := newWatchModel(w.projectName, changes)
+	if err := tea.NewProgram(model).Start(); err != nil {
+		return err
+	}
+	if model.err != nil {
+		return fmt.Errorf("watching job runs of project %s stopped: %w", w.projectName, model.err)
+	}
+	return nil
+}
+
+type jobRunChangeMsg struct {
+	change    *pb.WatchJobRunsResponse
+	changedAt time.Time
+}
+
+type watchErrMsg struct {
+	err error
+}
+
+func receiveJobRunChanges(ctx context.Context, stream pb.JobRunService_WatchJobRunsClient, changes chan<- tea.Msg) {
+	for {
+		change, err := stream.Recv()
+		var msg tea.Msg = jobRunChangeMsg{change: change, changedAt: time.Now()}
+		if err != nil {
+			msg = watchErrMsg{err: err}
+		}
+		select {
+		case changes <- msg:
+		case <-ctx.Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func waitForJobRunChange(changes <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-changes
+	}
+}
+
+type watchModel struct {
+	projectName string
+	changes     <-chan tea.Msg
+
+	// runs holds the latest change of every run, keyed by job and scheduled time
+	runs map[string]jobRunChangeMsg
+	err  error
+}
+
+func newWatchModel(projectName string, changes <-chan tea.Msg) *watchModel {
+	return &watchModel{
+		projectName: projectName,
+		changes:     changes,
+		runs:        map[string]jobRunChangeMsg{},
+	}
+}
+
+func (m *watchModel) Init() tea.Cmd {
+	return waitForJobRunChange(m.changes)
+}
+
+func (m *watchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case jobRunChangeMsg:
+		change := msg.change
+		key := fmt.Sprintf("%s/%s/%s", change.GetNamespaceName(), change.GetJobName(), change.GetScheduledAt().AsTime())
+		m.runs[key] = msg
+		m.evictOldestRuns()
+		return m, waitForJobRunChange(m.changes)
+	case watchErrMsg:
+		m.err = msg.err
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m *watchModel) evictOldestRuns() {
+	for len(m.runs) > maxWatchedRuns {
+		var oldestKey string
+		var oldest time.Time
+		for key, run := range m.runs {
+			if oldestKey == "" || run.changedAt.Before(oldest) {
+				oldestKey, oldest = key, run.changedAt
+			}
+		}
+		delete(m.runs, oldestKey)
+	}
+}
+
+func (m *watchModel) View() string {
+	var s strings.Builder
+	s.WriteString(fmt.Sprintf("Watching job runs of project %s, press 'q' or 'ctrl+c' to quit.\n\n", m.projectName))
+	if len(m.runs) == 0 {
+		s.WriteString("Waiting for job runs to change state...\n")
+		return s.String()
+	}
+
+	runs := make([]jobRunChangeMsg, 0, len(m.runs))
+	for _, run := range m.runs {
+		runs = append(runs, run)
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].changedAt.After(runs[j].changedAt) })
+
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetHeader([]string{"Changed At", "Namespace", "Job", "Scheduled At", "State", "Start Time", "End Time"})
+	for _, run := range runs {
+		change := run.change
+		table.Append([]string{
+			run.changedAt.Format(time.Kitchen),
+			change.GetNamespaceName(),
+			change.GetJobName(),
+			change.GetScheduledAt().AsTime().Format(time.RFC3339),
+			strings.ToUpper(change.GetState()),
+			formatWatchTime(change.GetStartTime().AsTime(), change.GetStartTime() != nil),
+			formatWatchTime(change.GetEndTime().AsTime(), change.GetEndTime() != nil),
+		})
+	}
+	table.Render()
+	s.WriteString(buff.String())
+	return s.String()
+}
+
+func formatWatchTime(t time.Time, isSet bool) string {
+	if !isSet {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
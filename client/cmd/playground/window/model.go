@@ -23,15 +23,19 @@ type model struct {
 	offsetInput textinput.Model
 
 	scheduledTime time.Time
+
+	// calendar is used by window version 3, default calendar is used when it is nil
+	calendar *models.Calendar
 }
 
-func newModel() *model {
+func newModel(calendar *models.Calendar) *model {
 	return &model{
 		currentCursor: pointToTruncateTo,
 		truncateTo:    truncateToDay,
 		sizeInput:     textinput.New(),
 		offsetInput:   textinput.New(),
 		scheduledTime: time.Now(),
+		calendar:      calendar,
 	}
 }
 
@@ -62,7 +66,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.handleIncrement()
 	case "shift+down":
 		m.handleDecrement()
-	case "M", "h", "-",
+	case "M", "h", "b", "-",
 		"1", "2", "3", "4", "5",
 		"6", "7", "8", "9", "0",
 		"backspace":
@@ -94,6 +98,7 @@ func (m *model) generateWindowResultView() string {
 	table.SetHeader([]string{"Version", "Start Time", "End Time"})
 	table.Append(m.generateWindowTableRowView(1))
 	table.Append(m.generateWindowTableRowView(2)) //nolint: gomnd
+	table.Append(m.generateWindowTableRowView(3)) //nolint: gomnd
 	table.Render()
 	return buff.String()
 }
@@ -115,6 +120,7 @@ press shift+down to decrement value
 		hint = `valid formats are:
 - nMmh: example 1M2h meaning 1 month 2 hours
 - mh: example 2h meaning 2 hours
+- kb: example -1b meaning 1 business day before, only for version 3
 
 n can be negative, while m can be negative only if nM does NOT exist
 `
@@ -122,6 +128,7 @@ n can be negative, while m can be negative only if nM does NOT exist
 		hint = `valid formats are:
 - nMmh: example 1M2h meaning 1 month 2 hours
 - mh: example 2h meaning 2 hours
+- kb: example 1b meaning 1 business day, only for version 3
 
 both n and m can NOT be negative
 `
@@ -176,6 +183,10 @@ func (m *model) generateWindowInputView() string {
 		"size",
 		m.generateValueWithCursorPointerView(pointToSize, m.sizeInput.Value()),
 	})
+	table.Append([]string{
+		"calendar",
+		m.generateCalendarView(),
+	})
 	table.Append([]string{
 		"job schedule",
 		m.generateSechduledTimeView(),
@@ -185,7 +196,7 @@ func (m *model) generateWindowInputView() string {
 }
 
 func (m *model) generateWindowTableRowView(version int) []string {
-	window, err := models.NewWindow(version, string(m.truncateTo), m.offsetInput.Value(), m.sizeInput.Value())
+	window, err := m.newWindow(version)
 	if err != nil {
 		return []string{fmt.Sprintf("%d", version), err.Error(), err.Error()}
 	}
@@ -206,6 +217,28 @@ func (m *model) generateWindowTableRowView(version int) []string {
 	return []string{fmt.Sprintf("%d", version), startTimeRow, endTimeRow}
 }
 
+func (m *model) newWindow(version int) (models.Window, error) {
+	if m.calendar == nil || version != 3 { //nolint: gomnd
+		return models.NewWindow(version, string(m.truncateTo), m.offsetInput.Value(), m.sizeInput.Value())
+	}
+	window, err := models.NewWindowWithCalendar(version, string(m.truncateTo), m.offsetInput.Value(), m.sizeInput.Value(), m.calendar.Name())
+	if err != nil {
+		return nil, err
+	}
+	return window.(models.CalendarWindow).WithCalendar(m.calendar)
+}
+
+func (m *model) generateCalendarView() string {
+	if m.calendar == nil {
+		return "default, monday to friday without holiday (version 3 only)"
+	}
+	businessDay := "business day"
+	if !m.calendar.IsBusinessDay(m.scheduledTime) {
+		businessDay = "not a business day"
+	}
+	return fmt.Sprintf("%s (version 3 only), scheduled time is %s", m.calendar.Name(), businessDay)
+}
+
 func (m *model) generateSechduledTimeView() string {
 	year := m.generateValueWithCursorPointerView(pointToYear, strconv.Itoa(m.scheduledTime.Year()))
 	month := m.generateValueWithCursorPointerView(pointToMonth, m.scheduledTime.Month().String())
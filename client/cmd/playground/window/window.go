@@ -1,15 +1,24 @@
 package window
 
 import (
+	"fmt"
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/raystack/salt/log"
 	"github.com/spf13/cobra"
 
+	"github.com/raystack/optimus/client/cmd/internal"
 	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/internal/models"
 )
 
 type command struct {
 	log log.Logger
+
+	configFilePath string
+	calendarName   string
 }
 
 // NewCommand initializes command for window playground
@@ -20,13 +29,39 @@ func NewCommand() *cobra.Command {
 		Short: "Play around with window configuration",
 		RunE:  window.RunE,
 	}
+	cmd.Flags().StringVarP(&window.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	cmd.Flags().StringVar(&window.calendarName, "calendar", "", "Calendar declared in project config, used by window version 3")
 	return cmd
 }
 
 func (j *command) RunE(_ *cobra.Command, _ []string) error {
+	calendar, err := j.loadCalendar()
+	if err != nil {
+		return err
+	}
+
 	j.log.Info("Hi, this is an interactive CLI to play around with window configuration.")
 	j.log.Info("Navigate around the available configurations input with arrow keys.")
 	j.log.Info("If you want to quit, just press 'q' or 'ctr+c' key.\n")
-	p := tea.NewProgram(newModel())
+	p := tea.NewProgram(newModel(calendar))
 	return p.Start()
 }
+
+func (j *command) loadCalendar() (*models.Calendar, error) {
+	if j.calendarName == "" {
+		return nil, nil //nolint: nilnil
+	}
+	conf, err := internal.LoadOptionalConfig(j.configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if conf == nil {
+		return nil, fmt.Errorf("client config is required to load calendar %s", j.calendarName)
+	}
+
+	projectConfig := make(map[string]string, len(conf.Project.Config))
+	for key, value := range conf.Project.Config {
+		projectConfig[strings.ToUpper(key)] = value
+	}
+	return models.CalendarFromConfig(j.calendarName, projectConfig)
+}
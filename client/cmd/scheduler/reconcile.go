@@ -0,0 +1,158 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/olekukonko/tablewriter"
+	"github.com/raystack/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/raystack/optimus/client/cmd/internal"
+	"github.com/raystack/optimus/client/cmd/internal/connection"
+	"github.com/raystack/optimus/client/cmd/internal/logger"
+	"github.com/raystack/optimus/client/cmd/internal/progressbar"
+	"github.com/raystack/optimus/config"
+	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
+)
+
+const (
+	reconcileTimeout = time.Minute * 15
+)
+
+type reconcileCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	namespaceName string
+	fix           bool
+
+	projectName string
+	host        string
+}
+
+// NewReconcileCommand initializes command to reconcile the jobs deployed on the scheduler with the server
+func NewReconcileCommand() *cobra.Command {
+	reconcile := &reconcileCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Detect and fix jobs on the scheduler drifted from the server",
+		Long: heredoc.Doc(`Compare the jobs deployed on the scheduler in every namespace directory with the jobs on the server.
+			Reports orphaned jobs which are deployed but do not exist in the namespace anymore, eg. after renames, namespace moves
+			or namespace deletions, missing jobs which are not deployed, and stale jobs which are deployed different from how they compile now.
+			Use --fix to delete the orphaned jobs and deploy the missing and stale jobs.`),
+		Example: "optimus scheduler reconcile [--namespace <namespace_name>] [--fix]",
+		RunE:    reconcile.RunE,
+		PreRunE: reconcile.PreRunE,
+	}
+	reconcile.injectFlags(cmd)
+	return cmd
+}
+
+func (r *reconcileCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&r.namespaceName, "namespace", "n", "", "Namespace to reconcile, all namespaces when not given")
+	cmd.Flags().BoolVar(&r.fix, "fix", false, "Delete the orphaned jobs and deploy the missing and stale jobs")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *reconcileCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		r.connection = connection.NewInsecure(r.logger)
+		return nil
+	}
+
+	r.connection = connection.New(r.logger, conf)
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	return nil
+}
+
+func (r *reconcileCommand) RunE(_ *cobra.Command, _ []string) error {
+	resp, err := r.sendReconcileRequest()
+	if err != nil {
+		return fmt.Errorf("reconcile request failed for project %s: %w", r.projectName, err)
+	}
+
+	if len(resp.GetDrifts()) == 0 {
+		r.logger.Info("No drift found, jobs on the scheduler are in sync")
+		return nil
+	}
+	r.logger.Info("Found %d drifted jobs:\n%s", len(resp.GetDrifts()), stringifyDrifts(resp.GetDrifts()))
+	if r.fix {
+		r.logger.Info("Drifted jobs are fixed, changes will be reflected in scheduler after a few minutes")
+	} else {
+		r.logger.Info("Use --fix to delete the orphaned jobs and deploy the missing and stale jobs")
+	}
+	return nil
+}
+
+func (r *reconcileCommand) sendReconcileRequest() (*pb.ReconcileSchedulerJobsResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	spinner := progressbar.NewProgressBar()
+	spinner.Start("please wait...")
+	defer spinner.Stop()
+
+	request := &pb.ReconcileSchedulerJobsRequest{
+		ProjectName: r.projectName,
+		Fix:         r.fix,
+	}
+	if r.namespaceName != "" {
+		request.NamespaceName = &r.namespaceName
+	}
+	jobRunServiceClient := pb.NewJobRunServiceClient(conn)
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancelFunc()
+
+	return jobRunServiceClient.ReconcileSchedulerJobs(ctx, request)
+}
+
+func stringifyDrifts(drifts []*pb.SchedulerJobDrift) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"namespace",
+		"job",
+		"drift",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, drift := range drifts {
+		table.Append([]string{
+			drift.GetNamespaceName(),
+			drift.GetJobName(),
+			drift.GetType(),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
@@ -16,6 +16,7 @@ func NewSchedulerCommand() *cobra.Command {
 
 	cmd.AddCommand(
 		UploadCommand(),
+		NewReconcileCommand(),
 	)
 	return cmd
 }
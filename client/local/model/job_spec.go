@@ -1,14 +1,21 @@
 package model
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/internal/utils"
 	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
 )
 
+const scheduleDateLayout = "2006-01-02"
+
 type JobSpec struct {
 	Version      int                 `yaml:"version,omitempty"`
 	Name         string              `yaml:"name"`
@@ -22,7 +29,11 @@ type JobSpec struct {
 	Hooks        []JobSpecHook       `yaml:"hooks"`
 	Dependencies []JobSpecDependency `yaml:"dependencies"`
 	Metadata     *JobSpecMetadata    `yaml:"metadata,omitempty"`
+	Matrix       []map[string]string `yaml:"matrix,omitempty"`
 	Path         string              `yaml:"-"`
+
+	// MatrixParameters are the parameters of the matrix the spec is expanded with, it is nil for the spec which is not expanded
+	MatrixParameters map[string]string `yaml:"-"`
 }
 
 type JobSpecSchedule struct {
@@ -59,17 +70,41 @@ type JobSpecTaskWindow struct {
 	Size       string `yaml:"size"`
 	Offset     string `yaml:"offset"`
 	TruncateTo string `yaml:"truncate_to"`
+	Calendar   string `yaml:"calendar,omitempty"`
 }
 
 type JobSpecHook struct {
-	Name   string            `yaml:"name"`
-	Config map[string]string `yaml:"config,omitempty"`
+	Name      string            `yaml:"name"`
+	Config    map[string]string `yaml:"config,omitempty"`
+	DependsOn []string          `yaml:"depends_on,omitempty"`
+	RunIf     string            `yaml:"run_if,omitempty"`
+	Retry     *JobSpecHookRetry `yaml:"retry,omitempty"`
+	Timeout   time.Duration     `yaml:"timeout,omitempty"`
+}
+
+type JobSpecHookRetry struct {
+	Count int           `yaml:"count,omitempty"`
+	Delay time.Duration `yaml:"delay,omitempty"`
 }
 
 type JobSpecDependency struct {
 	JobName string                 `yaml:"job,omitempty"`
 	Type    string                 `yaml:"type,omitempty"`
 	HTTP    *JobSpecDependencyHTTP `yaml:"http,omitempty"`
+
+	// RunOffset shifts the window of the job to get the runs of the dependency, eg. -24h for the runs of the previous day
+	RunOffset string `yaml:"run_offset,omitempty"`
+	// Window replaces the window of the job to get the runs of the dependency
+	Window *JobSpecDependencyWindow `yaml:"window,omitempty"`
+	// Optional dependency does not block the job when it is disabled or not done within MaxWait
+	Optional bool          `yaml:"optional,omitempty"`
+	MaxWait  time.Duration `yaml:"max_wait,omitempty"`
+}
+
+type JobSpecDependencyWindow struct {
+	Size       string `yaml:"size"`
+	Offset     string `yaml:"offset,omitempty"`
+	TruncateTo string `yaml:"truncate_to,omitempty"`
 }
 
 type JobSpecDependencyHTTP struct {
@@ -97,6 +132,9 @@ type JobSpecMetadataResourceConfig struct {
 type JobSpecMetadataAirflow struct {
 	Pool  string `yaml:"pool" json:"pool"`
 	Queue string `yaml:"queue" json:"queue"`
+	// SensorPool and TaskPool override the pool for the sensors and the task of the job
+	SensorPool string `yaml:"sensor_pool,omitempty" json:"sensor_pool,omitempty"`
+	TaskPool   string `yaml:"task_pool,omitempty" json:"task_pool,omitempty"`
 }
 
 func (j *JobSpec) ToProto() *pb.JobSpecification {
@@ -113,6 +151,7 @@ func (j *JobSpec) ToProto() *pb.JobSpecification {
 		WindowSize:       j.Task.Window.Size,
 		WindowOffset:     j.Task.Window.Offset,
 		WindowTruncateTo: j.Task.Window.TruncateTo,
+		WindowCalendar:   j.Task.Window.Calendar,
 		Dependencies:     j.getProtoJobDependencies(),
 		Assets:           j.Asset,
 		Hooks:            j.getProtoJobSpecHooks(),
@@ -138,8 +177,10 @@ func (j *JobSpec) getProtoJobMetadata() *pb.JobMetadata {
 	var airflow *pb.JobSpecMetadataAirflow
 	if j.Metadata.Airflow != nil {
 		airflow = &pb.JobSpecMetadataAirflow{
-			Pool:  j.Metadata.Airflow.Pool,
-			Queue: j.Metadata.Airflow.Queue,
+			Pool:       j.Metadata.Airflow.Pool,
+			Queue:      j.Metadata.Airflow.Queue,
+			SensorPool: j.Metadata.Airflow.SensorPool,
+			TaskPool:   j.Metadata.Airflow.TaskPool,
 		}
 	}
 	return &pb.JobMetadata{
@@ -201,8 +242,19 @@ func (j *JobSpec) getProtoJobSpecHooks() []*pb.JobSpecHook {
 			})
 		}
 		protoJobSpecHooks[i] = &pb.JobSpecHook{
-			Name:   hook.Name,
-			Config: protoJobConfigItems,
+			Name:      hook.Name,
+			Config:    protoJobConfigItems,
+			DependsOn: hook.DependsOn,
+			RunIf:     hook.RunIf,
+		}
+		if hook.Retry != nil {
+			protoJobSpecHooks[i].RetryCount = int32(hook.Retry.Count)
+			if hook.Retry.Delay != 0 {
+				protoJobSpecHooks[i].RetryDelay = durationpb.New(hook.Retry.Delay)
+			}
+		}
+		if hook.Timeout != 0 {
+			protoJobSpecHooks[i].Timeout = durationpb.New(hook.Timeout)
 		}
 	}
 	return protoJobSpecHooks
@@ -212,8 +264,20 @@ func (j *JobSpec) getProtoJobDependencies() []*pb.JobDependency {
 	protoJobDependencies := make([]*pb.JobDependency, len(j.Dependencies))
 	for i, dependency := range j.Dependencies {
 		jobSpecDependencyProto := pb.JobDependency{
-			Name: dependency.JobName,
-			Type: dependency.Type,
+			Name:      dependency.JobName,
+			Type:      dependency.Type,
+			RunOffset: dependency.RunOffset,
+			Optional:  dependency.Optional,
+		}
+		if dependency.MaxWait != 0 {
+			jobSpecDependencyProto.MaxWait = durationpb.New(dependency.MaxWait)
+		}
+		if dependency.Window != nil {
+			jobSpecDependencyProto.Window = &pb.JobDependencyWindow{
+				Size:       dependency.Window.Size,
+				Offset:     dependency.Window.Offset,
+				TruncateTo: dependency.Window.TruncateTo,
+			}
 		}
 		if dependency.HTTP != nil {
 			jobSpecDependencyProto.HttpDependency = &pb.HttpDependency{
@@ -239,6 +303,185 @@ func (j *JobSpec) getProtoJobConfigItems() []*pb.JobConfigItem {
 	return protoJobConfigItems
 }
 
+// ToJobWithDetails converts the spec into the job details used by the scheduler to compile the job,
+// job upstreams are not part of the spec and need to be resolved separately
+func (j *JobSpec) ToJobWithDetails(tnnt tenant.Tenant) (*scheduler.JobWithDetails, error) {
+	window, err := models.NewWindowWithCalendar(j.Version, j.Task.Window.TruncateTo, j.Task.Window.Offset, j.Task.Window.Size, j.Task.Window.Calendar)
+	if err != nil {
+		return nil, err
+	}
+	startDate, err := time.Parse(scheduleDateLayout, j.Schedule.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date of job %s: %w", j.Name, err)
+	}
+	schedule := &scheduler.Schedule{
+		DependsOnPast: j.Behavior.DependsOnPast,
+		StartDate:     startDate,
+		Interval:      j.Schedule.Interval,
+	}
+	if j.Schedule.EndDate != "" {
+		endDate, err := time.Parse(scheduleDateLayout, j.Schedule.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end date of job %s: %w", j.Name, err)
+		}
+		schedule.EndDate = &endDate
+	}
+
+	hooks := make([]*scheduler.Hook, len(j.Hooks))
+	for i, hook := range j.Hooks {
+		hooks[i] = hook.ToSchedulerHook()
+	}
+
+	return &scheduler.JobWithDetails{
+		Name: scheduler.JobName(j.Name),
+		Job: &scheduler.Job{
+			Name:   scheduler.JobName(j.Name),
+			Tenant: tnnt,
+			Task: &scheduler.Task{
+				Name:   j.Task.Name,
+				Config: j.Task.Config,
+			},
+			Hooks:  hooks,
+			Window: window,
+			Assets: j.Asset,
+		},
+		JobMetadata: &scheduler.JobMetadata{
+			Version:     j.Version,
+			Owner:       j.Owner,
+			Description: j.Description,
+			Labels:      j.Labels,
+		},
+		Schedule:      schedule,
+		Retry:         j.getSchedulerRetry(),
+		Alerts:        j.getSchedulerAlerts(),
+		RuntimeConfig: j.getSchedulerRuntimeConfig(),
+		Upstreams:     scheduler.Upstreams{HTTP: j.getSchedulerHTTPUpstreams()},
+	}, nil
+}
+
+// ToSchedulerHook converts the hook to the hook of the job run, with its run_if, retry and timeout
+func (h JobSpecHook) ToSchedulerHook() *scheduler.Hook {
+	hook := &scheduler.Hook{
+		Name:      h.Name,
+		Config:    h.Config,
+		DependsOn: h.DependsOn,
+		RunIf:     h.RunIf,
+		Timeout:   h.Timeout,
+	}
+	if h.Retry != nil {
+		hook.RetryCount = h.Retry.Count
+		hook.RetryDelay = h.Retry.Delay
+	}
+	return hook
+}
+
+func (j *JobSpec) getSchedulerRetry() scheduler.Retry {
+	if j.Behavior.Retry == nil {
+		return scheduler.Retry{}
+	}
+	var delay int32
+	if j.Behavior.Retry.Delay != 0 {
+		// keep the delay the same as it is stored by the server from the spec proto
+		delay = durationpb.New(j.Behavior.Retry.Delay).GetNanos()
+	}
+	return scheduler.Retry{
+		ExponentialBackoff: j.Behavior.Retry.ExponentialBackoff,
+		Count:              j.Behavior.Retry.Count,
+		Delay:              delay,
+	}
+}
+
+func (j *JobSpec) getSchedulerAlerts() []scheduler.Alert {
+	var alerts []scheduler.Alert
+	for _, notify := range j.Behavior.Notify {
+		alerts = append(alerts, scheduler.Alert{
+			On:       scheduler.JobEventCategory(notify.On),
+			Channels: notify.Channels,
+			Config:   notify.Config,
+		})
+	}
+	return alerts
+}
+
+func (j *JobSpec) getSchedulerRuntimeConfig() scheduler.RuntimeConfig {
+	var runtimeConfig scheduler.RuntimeConfig
+	if j.Metadata == nil {
+		return runtimeConfig
+	}
+	if j.Metadata.Resource != nil {
+		runtimeConfig.Resource = &scheduler.Resource{
+			Request: toSchedulerResourceConfig(j.Metadata.Resource.Request),
+			Limit:   toSchedulerResourceConfig(j.Metadata.Resource.Limit),
+		}
+	}
+	if j.Metadata.Airflow != nil {
+		runtimeConfig.Scheduler = map[string]string{
+			"pool":  j.Metadata.Airflow.Pool,
+			"queue": j.Metadata.Airflow.Queue,
+		}
+		if j.Metadata.Airflow.SensorPool != "" {
+			runtimeConfig.Scheduler["sensor_pool"] = j.Metadata.Airflow.SensorPool
+		}
+		if j.Metadata.Airflow.TaskPool != "" {
+			runtimeConfig.Scheduler["task_pool"] = j.Metadata.Airflow.TaskPool
+		}
+	}
+	return runtimeConfig
+}
+
+func toSchedulerResourceConfig(resourceConfig *JobSpecMetadataResourceConfig) *scheduler.ResourceConfig {
+	if resourceConfig == nil {
+		return nil
+	}
+	return &scheduler.ResourceConfig{
+		CPU:    resourceConfig.CPU,
+		Memory: resourceConfig.Memory,
+	}
+}
+
+func (j *JobSpec) getSchedulerHTTPUpstreams() []*scheduler.HTTPUpstreams {
+	var httpUpstreams []*scheduler.HTTPUpstreams
+	for _, dependency := range j.Dependencies {
+		if dependency.HTTP == nil {
+			continue
+		}
+		httpUpstreams = append(httpUpstreams, &scheduler.HTTPUpstreams{
+			Name:    dependency.HTTP.Name,
+			URL:     dependency.HTTP.URL,
+			Headers: dependency.HTTP.Headers,
+			Params:  dependency.HTTP.RequestParams,
+		})
+	}
+	return httpUpstreams
+}
+
+// ApplyDependencyRunConfigs sets the run offset, window and optionality of the job dependencies on the upstream jobs
+// they refer to, the dependency without project name refers to the upstream job in the given project
+func (j *JobSpec) ApplyDependencyRunConfigs(projectName string, upstreams []*scheduler.JobUpstream) {
+	for _, dependency := range j.Dependencies {
+		if dependency.RunOffset == "" && dependency.Window == nil && !dependency.Optional {
+			continue
+		}
+		dependencyFullName := dependency.JobName
+		if !strings.Contains(dependencyFullName, "/") {
+			dependencyFullName = projectName + "/" + dependencyFullName
+		}
+		for _, upstream := range upstreams {
+			if upstream.Tenant.ProjectName().String()+"/"+upstream.JobName != dependencyFullName {
+				continue
+			}
+			upstream.RunOffset = dependency.RunOffset
+			if dependency.Window != nil {
+				upstream.WindowSize = dependency.Window.Size
+				upstream.WindowOffset = dependency.Window.Offset
+				upstream.WindowTruncateTo = dependency.Window.TruncateTo
+			}
+			upstream.Optional = dependency.Optional
+			upstream.MaxWait = dependency.MaxWait
+		}
+	}
+}
+
 // TODO: there are some refactors required, however it will be addressed once we relook at the job spec inheritance
 func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 	j.Version = getValue(j.Version, anotherJobSpec.Version)
@@ -334,6 +577,7 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 	j.Task.Window.TruncateTo = getValue(j.Task.Window.TruncateTo, anotherJobSpec.Task.Window.TruncateTo)
 	j.Task.Window.Offset = getValue(j.Task.Window.Offset, anotherJobSpec.Task.Window.Offset)
 	j.Task.Window.Size = getValue(j.Task.Window.Size, anotherJobSpec.Task.Window.Size)
+	j.Task.Window.Calendar = getValue(j.Task.Window.Calendar, anotherJobSpec.Task.Window.Calendar)
 	if anotherJobSpec.Task.Config != nil {
 		if j.Task.Config == nil {
 			j.Task.Config = map[string]string{}
@@ -382,10 +626,7 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 	for _, ph := range anotherJobSpec.Hooks {
 		// copy non existing hooks
 		if _, ok := existingHooks[ph.Name]; !ok {
-			j.Hooks = append(j.Hooks, JobSpecHook{
-				Name:   ph.Name,
-				Config: ph.Config,
-			})
+			j.Hooks = append(j.Hooks, ph)
 		}
 	}
 
@@ -403,6 +644,8 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 		if airflow := metadata.Airflow; airflow != nil {
 			j.Metadata.Airflow.Pool = getValue(j.Metadata.Airflow.Pool, airflow.Pool)
 			j.Metadata.Airflow.Queue = getValue(j.Metadata.Airflow.Queue, airflow.Queue)
+			j.Metadata.Airflow.SensorPool = getValue(j.Metadata.Airflow.SensorPool, airflow.SensorPool)
+			j.Metadata.Airflow.TaskPool = getValue(j.Metadata.Airflow.TaskPool, airflow.TaskPool)
 		}
 	}
 }
@@ -436,6 +679,7 @@ func ToJobSpec(protoSpec *pb.JobSpecification) *JobSpec {
 				Size:       protoSpec.WindowSize,
 				Offset:     protoSpec.WindowOffset,
 				TruncateTo: protoSpec.WindowTruncateTo,
+				Calendar:   protoSpec.WindowCalendar,
 			},
 		},
 		Asset:        protoSpec.Assets,
@@ -474,8 +718,10 @@ func toJobSpecMetadata(protoMetadata *pb.JobMetadata) *JobSpecMetadata {
 		var metadataAirflowSpec *JobSpecMetadataAirflow
 		if protoMetadata.Airflow != nil {
 			metadataAirflowSpec = &JobSpecMetadataAirflow{
-				Pool:  protoMetadata.Airflow.Pool,
-				Queue: protoMetadata.Airflow.Queue,
+				Pool:       protoMetadata.Airflow.Pool,
+				Queue:      protoMetadata.Airflow.Queue,
+				SensorPool: protoMetadata.Airflow.SensorPool,
+				TaskPool:   protoMetadata.Airflow.TaskPool,
 			}
 		}
 		metadataSpec = &JobSpecMetadata{
@@ -499,9 +745,19 @@ func toJobSpecDependencies(protoDependencies []*pb.JobDependency) []JobSpecDepen
 			}
 		}
 		dependencySpec := JobSpecDependency{
-			JobName: dependency.Name,
-			Type:    dependency.Type,
-			HTTP:    httpDependency,
+			JobName:   dependency.Name,
+			Type:      dependency.Type,
+			HTTP:      httpDependency,
+			RunOffset: dependency.RunOffset,
+			Optional:  dependency.Optional,
+			MaxWait:   dependency.MaxWait.AsDuration(),
+		}
+		if dependency.Window != nil {
+			dependencySpec.Window = &JobSpecDependencyWindow{
+				Size:       dependency.Window.Size,
+				Offset:     dependency.Window.Offset,
+				TruncateTo: dependency.Window.TruncateTo,
+			}
 		}
 		dependencySpecs = append(dependencySpecs, dependencySpec)
 	}
@@ -512,8 +768,17 @@ func toJobSpecHooks(protoHooks []*pb.JobSpecHook) []JobSpecHook {
 	var hookSpecs []JobSpecHook
 	for _, protoHook := range protoHooks {
 		hookSpec := JobSpecHook{
-			Name:   protoHook.Name,
-			Config: configProtoToMap(protoHook.Config),
+			Name:      protoHook.Name,
+			Config:    configProtoToMap(protoHook.Config),
+			DependsOn: protoHook.DependsOn,
+			RunIf:     protoHook.RunIf,
+			Timeout:   protoHook.Timeout.AsDuration(),
+		}
+		if protoHook.RetryCount > 0 {
+			hookSpec.Retry = &JobSpecHookRetry{
+				Count: int(protoHook.RetryCount),
+				Delay: protoHook.RetryDelay.AsDuration(),
+			}
 		}
 		hookSpecs = append(hookSpecs, hookSpec)
 	}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// matrixVariablePattern matches the matrix parameter variable, eg. {{ .matrix.country }}
+var matrixVariablePattern = regexp.MustCompile(`\{\{\s*\.matrix\.(\w+)\s*\}\}`)
+
+// ExpandMatrix expands the spec declaring a matrix into one spec per matrix parameters, the parameters
+// replace the matrix variables in name, description, labels, task and hook config, dependencies and assets.
+// The expanded spec is named after the rendered name when it refers to the parameters, otherwise the
+// parameter values are appended to the name. The spec without matrix is returned as is.
+func (j *JobSpec) ExpandMatrix() ([]*JobSpec, error) {
+	if len(j.Matrix) == 0 {
+		return []*JobSpec{j}, nil
+	}
+
+	expandedSpecs := make([]*JobSpec, len(j.Matrix))
+	expandedNames := make(map[string]int)
+	for i, parameters := range j.Matrix {
+		if len(parameters) == 0 {
+			return nil, fmt.Errorf("matrix entry [%d] of job [%s] has no parameter", i, j.Name)
+		}
+		expandedSpec, err := j.expandWith(parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding matrix entry [%d] of job [%s]: %w", i, j.Name, err)
+		}
+		if idx, ok := expandedNames[expandedSpec.Name]; ok {
+			return nil, fmt.Errorf("matrix entries [%d] and [%d] of job [%s] are expanded into the same name [%s]", idx, i, j.Name, expandedSpec.Name)
+		}
+		expandedNames[expandedSpec.Name] = i
+		expandedSpecs[i] = expandedSpec
+	}
+	return expandedSpecs, nil
+}
+
+func (j *JobSpec) expandWith(parameters map[string]string) (*JobSpec, error) {
+	expandedSpec, err := j.copy()
+	if err != nil {
+		return nil, err
+	}
+	expandedSpec.Matrix = nil
+	expandedSpec.MatrixParameters = parameters
+
+	render := func(text string) (string, error) {
+		return renderMatrixVariables(text, parameters)
+	}
+	if matrixVariablePattern.MatchString(j.Name) {
+		if expandedSpec.Name, err = render(j.Name); err != nil {
+			return nil, err
+		}
+	} else {
+		expandedSpec.Name = j.Name + "-" + joinMatrixValues(parameters)
+	}
+	if expandedSpec.Description, err = render(j.Description); err != nil {
+		return nil, err
+	}
+	if err := renderMatrixValues(expandedSpec.Labels, parameters); err != nil {
+		return nil, fmt.Errorf("error rendering labels: %w", err)
+	}
+	if err := renderMatrixValues(expandedSpec.Task.Config, parameters); err != nil {
+		return nil, fmt.Errorf("error rendering task config: %w", err)
+	}
+	for _, hook := range expandedSpec.Hooks {
+		if err := renderMatrixValues(hook.Config, parameters); err != nil {
+			return nil, fmt.Errorf("error rendering config of hook [%s]: %w", hook.Name, err)
+		}
+	}
+	for i, dependency := range expandedSpec.Dependencies {
+		if expandedSpec.Dependencies[i].JobName, err = render(dependency.JobName); err != nil {
+			return nil, fmt.Errorf("error rendering dependency [%s]: %w", dependency.JobName, err)
+		}
+	}
+	if err := renderMatrixValues(expandedSpec.Asset, parameters); err != nil {
+		return nil, fmt.Errorf("error rendering assets: %w", err)
+	}
+	return expandedSpec, nil
+}
+
+// copy returns a deep copy of the spec, including the fields which are not part of the spec file
+func (j *JobSpec) copy() (*JobSpec, error) {
+	raw, err := yaml.Marshal(j)
+	if err != nil {
+		return nil, err
+	}
+	var copiedSpec JobSpec
+	if err := yaml.Unmarshal(raw, &copiedSpec); err != nil {
+		return nil, err
+	}
+	if j.Asset != nil {
+		copiedSpec.Asset = make(map[string]string, len(j.Asset))
+		for name, content := range j.Asset {
+			copiedSpec.Asset[name] = content
+		}
+	}
+	copiedSpec.Path = j.Path
+	return &copiedSpec, nil
+}
+
+func renderMatrixValues(values, parameters map[string]string) error {
+	for key, value := range values {
+		rendered, err := renderMatrixVariables(value, parameters)
+		if err != nil {
+			return fmt.Errorf("error rendering [%s]: %w", key, err)
+		}
+		values[key] = rendered
+	}
+	return nil
+}
+
+func renderMatrixVariables(text string, parameters map[string]string) (string, error) {
+	var unknownParameters []string
+	rendered := matrixVariablePattern.ReplaceAllStringFunc(text, func(variable string) string {
+		name := matrixVariablePattern.FindStringSubmatch(variable)[1]
+		value, ok := parameters[name]
+		if !ok {
+			unknownParameters = append(unknownParameters, name)
+			return variable
+		}
+		return value
+	})
+	if len(unknownParameters) > 0 {
+		return "", fmt.Errorf("matrix parameter [%s] is not declared", strings.Join(unknownParameters, ", "))
+	}
+	return rendered, nil
+}
+
+func joinMatrixValues(parameters map[string]string) string {
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = parameters[key]
+	}
+	return strings.Join(values, "-")
+}
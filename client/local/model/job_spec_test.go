@@ -8,6 +8,8 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/raystack/optimus/client/local/model"
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
 	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
 )
 
@@ -69,6 +71,102 @@ func (s *JobSpecTestSuite) TestToProto() {
 	})
 }
 
+func (s *JobSpecTestSuite) TestToJobWithDetails() {
+	tnnt, _ := tenant.NewTenant("project-1", "namespace-1")
+
+	s.Run("should return error when window is invalid", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Schedule.StartDate = "2022-09-30"
+		jobSpec.Task.Window.Size = "invalid"
+
+		actual, err := jobSpec.ToJobWithDetails(tnnt)
+
+		s.Assert().Nil(actual)
+		s.Assert().Error(err)
+	})
+
+	s.Run("should return error when start date is invalid", func() {
+		jobSpec := s.getCompleteJobSpec()
+
+		actual, err := jobSpec.ToJobWithDetails(tnnt)
+
+		s.Assert().Nil(actual)
+		s.Assert().ErrorContains(err, "invalid start date of job job_1")
+	})
+
+	s.Run("should return job details when job spec is complete", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Schedule.StartDate = "2022-09-30"
+		jobSpec.Schedule.EndDate = "2050-01-01"
+
+		actual, err := jobSpec.ToJobWithDetails(tnnt)
+		s.Require().NoError(err)
+
+		startDate := time.Date(2022, 9, 30, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+		s.Assert().Equal(scheduler.JobName("job_1"), actual.Name)
+		s.Assert().Equal(tnnt, actual.Job.Tenant)
+		s.Assert().Equal(&scheduler.Task{Name: "job_task_1", Config: map[string]string{"taskkey": "taskvalue"}}, actual.Job.Task)
+		s.Assert().Equal([]*scheduler.Hook{{
+			Name:       "hook_1",
+			Config:     map[string]string{"hookkey": "hookvalue"},
+			RunIf:      "always",
+			RetryCount: 2,
+			RetryDelay: 30 * time.Second,
+			Timeout:    10 * time.Minute,
+		}}, actual.Job.Hooks)
+		s.Assert().Equal("24h", actual.Job.Window.GetSize())
+		s.Assert().Equal(&scheduler.Schedule{DependsOnPast: true, StartDate: startDate, EndDate: &endDate, Interval: "12 10 * * *"}, actual.Schedule)
+		s.Assert().Equal(scheduler.Retry{ExponentialBackoff: true, Count: 10}, actual.Retry)
+		s.Assert().Equal([]scheduler.Alert{{
+			On:       scheduler.EventCategoryJobFailure,
+			Channels: []string{"slack://#optimus"},
+			Config:   map[string]string{"configkey": "configvalue"},
+		}}, actual.Alerts)
+		s.Assert().Equal(map[string]string{"pool": "poolA", "queue": "queueA"}, actual.RuntimeConfig.Scheduler)
+		s.Assert().Equal(&scheduler.ResourceConfig{CPU: "500m", Memory: "128Mi"}, actual.RuntimeConfig.Resource.Limit)
+		s.Assert().Equal([]*scheduler.HTTPUpstreams{{
+			Name:    "http_dep",
+			URL:     "http://optimus.dev/example",
+			Headers: map[string]string{"User-Agent": "*"},
+			Params:  map[string]string{"param1": "paramvalue"},
+		}}, actual.Upstreams.HTTP)
+		s.Assert().Empty(actual.Upstreams.UpstreamJobs)
+	})
+}
+
+func (s *JobSpecTestSuite) TestApplyDependencyRunConfigs() {
+	s.Run("should set run offset, window and optionality of the dependencies on the upstream jobs", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Dependencies = append(jobSpec.Dependencies,
+			model.JobSpecDependency{JobName: "other-project/job_name_3", RunOffset: "-1h"},
+			model.JobSpecDependency{JobName: "job_name_4", Optional: true, MaxWait: time.Hour})
+		tnnt, err := tenant.NewTenant("sample-project", "sample-namespace")
+		s.Require().NoError(err)
+		otherTnnt, err := tenant.NewTenant("other-project", "other-namespace")
+		s.Require().NoError(err)
+		upstreams := []*scheduler.JobUpstream{
+			{JobName: "job_name_2", Tenant: tnnt},
+			{JobName: "job_name_2", Tenant: otherTnnt},
+			{JobName: "job_name_3", Tenant: otherTnnt},
+			{JobName: "job_name_4", Tenant: tnnt},
+		}
+
+		jobSpec.ApplyDependencyRunConfigs("sample-project", upstreams)
+
+		s.Assert().Equal(&scheduler.JobUpstream{
+			JobName:          "job_name_2",
+			Tenant:           tnnt,
+			RunOffset:        "-24h",
+			WindowSize:       "4h",
+			WindowTruncateTo: "h",
+		}, upstreams[0])
+		s.Assert().Equal(&scheduler.JobUpstream{JobName: "job_name_2", Tenant: otherTnnt}, upstreams[1])
+		s.Assert().Equal(&scheduler.JobUpstream{JobName: "job_name_3", Tenant: otherTnnt, RunOffset: "-1h"}, upstreams[2])
+		s.Assert().Equal(&scheduler.JobUpstream{JobName: "job_name_4", Tenant: tnnt, Optional: true, MaxWait: time.Hour}, upstreams[3])
+	})
+}
+
 // TODO: this unit test needs refactoring, depending on its implementation
 func (s *JobSpecTestSuite) TestMergeFrom() {
 	s.Run("should add the current job spec with the incoming one", func() {
@@ -82,6 +180,72 @@ func (s *JobSpecTestSuite) TestMergeFrom() {
 	})
 }
 
+func (s *JobSpecTestSuite) TestExpandMatrix() {
+	s.Run("should return the job spec as is when it has no matrix", func() {
+		jobSpec := s.getCompleteJobSpec()
+
+		actual, err := jobSpec.ExpandMatrix()
+
+		s.Assert().NoError(err)
+		s.Assert().Equal([]*model.JobSpec{&jobSpec}, actual)
+	})
+	s.Run("should expand job spec per matrix entry rendering the matrix variables", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Name = "sales-{{ .matrix.country }}"
+		jobSpec.Description = "sales of {{.matrix.country}}"
+		jobSpec.Task.Config = map[string]string{"TABLE": "sales_{{ .matrix.country }}", "DATE": "{{.DSTART}}"}
+		jobSpec.Dependencies = []model.JobSpecDependency{{JobName: "ingest-{{ .matrix.country }}"}}
+		jobSpec.Asset = map[string]string{"query.sql": "SELECT * FROM sales_{{ .matrix.country }}"}
+		jobSpec.Matrix = []map[string]string{{"country": "id"}, {"country": "sg"}}
+
+		actual, err := jobSpec.ExpandMatrix()
+
+		s.Assert().NoError(err)
+		s.Require().Len(actual, 2)
+		s.Assert().Equal("sales-id", actual[0].Name)
+		s.Assert().Equal("sales of id", actual[0].Description)
+		s.Assert().Equal(map[string]string{"TABLE": "sales_id", "DATE": "{{.DSTART}}"}, actual[0].Task.Config)
+		s.Assert().Equal("ingest-id", actual[0].Dependencies[0].JobName)
+		s.Assert().Equal(map[string]string{"query.sql": "SELECT * FROM sales_id"}, actual[0].Asset)
+		s.Assert().Equal(map[string]string{"country": "id"}, actual[0].MatrixParameters)
+		s.Assert().Nil(actual[0].Matrix)
+		s.Assert().Equal(jobSpec.Behavior, actual[0].Behavior)
+		s.Assert().Equal("sales-sg", actual[1].Name)
+		s.Assert().Equal(map[string]string{"query.sql": "SELECT * FROM sales_sg"}, actual[1].Asset)
+		s.Assert().Equal("sales_{{ .matrix.country }}", jobSpec.Task.Config["TABLE"])
+	})
+	s.Run("should append the parameter values to the name when the name has no matrix variable", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Name = "sales"
+		jobSpec.Matrix = []map[string]string{{"tenant": "retail", "country": "id"}}
+
+		actual, err := jobSpec.ExpandMatrix()
+
+		s.Assert().NoError(err)
+		s.Assert().Equal("sales-id-retail", actual[0].Name)
+	})
+	s.Run("should return error when matrix variable is not declared", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Task.Config = map[string]string{"TABLE": "sales_{{ .matrix.tenant }}"}
+		jobSpec.Matrix = []map[string]string{{"country": "id"}}
+
+		actual, err := jobSpec.ExpandMatrix()
+
+		s.Assert().ErrorContains(err, "matrix parameter [tenant] is not declared")
+		s.Assert().Nil(actual)
+	})
+	s.Run("should return error when matrix entries are expanded into the same name", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Name = "sales-{{ .matrix.country }}"
+		jobSpec.Matrix = []map[string]string{{"country": "id", "tenant": "a"}, {"country": "id", "tenant": "b"}}
+
+		actual, err := jobSpec.ExpandMatrix()
+
+		s.Assert().ErrorContains(err, "are expanded into the same name [sales-id]")
+		s.Assert().Nil(actual)
+	})
+}
+
 func (*JobSpecTestSuite) getCompleteJobSpec() model.JobSpec {
 	return model.JobSpec{
 		Version:     1,
@@ -143,8 +307,13 @@ func (*JobSpecTestSuite) getCompleteJobSpec() model.JobSpec {
 				},
 			},
 			{
-				JobName: "job_name_2",
-				Type:    "intra",
+				JobName:   "job_name_2",
+				Type:      "intra",
+				RunOffset: "-24h",
+				Window: &model.JobSpecDependencyWindow{
+					Size:       "4h",
+					TruncateTo: "h",
+				},
 			},
 		},
 		Hooks: []model.JobSpecHook{
@@ -153,6 +322,9 @@ func (*JobSpecTestSuite) getCompleteJobSpec() model.JobSpec {
 				Config: map[string]string{
 					"hookkey": "hookvalue",
 				},
+				RunIf:   "always",
+				Retry:   &model.JobSpecHookRetry{Count: 2, Delay: 30 * time.Second},
+				Timeout: 10 * time.Minute,
 			},
 		},
 		Metadata: &model.JobSpecMetadata{
@@ -225,6 +397,10 @@ func (*JobSpecTestSuite) getCompleteJobSpecProto() *pb.JobSpecification {
 						Value: "hookvalue",
 					},
 				},
+				RunIf:      "always",
+				RetryCount: 2,
+				RetryDelay: durationpb.New(30 * time.Second),
+				Timeout:    durationpb.New(10 * time.Minute),
 			},
 		},
 		Dependencies: []*pb.JobDependency{
@@ -243,8 +419,13 @@ func (*JobSpecTestSuite) getCompleteJobSpecProto() *pb.JobSpecification {
 				},
 			},
 			{
-				Name: "job_name_2",
-				Type: "intra",
+				Name:      "job_name_2",
+				Type:      "intra",
+				RunOffset: "-24h",
+				Window: &pb.JobDependencyWindow{
+					Size:       "4h",
+					TruncateTo: "h",
+				},
 			},
 		},
 		Metadata: &pb.JobMetadata{
@@ -61,8 +61,8 @@ func (j jobSpecReadWriter) ReadAll(rootDirPath string) ([]*model.JobSpec, error)
 	if err != nil {
 		return nil, fmt.Errorf("error discovering spec dir paths under [%s]: %w", rootDirPath, err)
 	}
-	jobSpecs := make([]*model.JobSpec, len(dirPaths))
-	for i, dirPath := range dirPaths {
+	var jobSpecs []*model.JobSpec
+	for _, dirPath := range dirPaths {
 		jobSpec, err := j.readJobSpec(dirPath)
 		if err != nil {
 			return nil, fmt.Errorf("error reading job spec under [%s]: %w", dirPath, err)
@@ -70,7 +70,11 @@ func (j jobSpecReadWriter) ReadAll(rootDirPath string) ([]*model.JobSpec, error)
 		if j.withParentReading {
 			j.mergeJobSpecWithParents(jobSpec, dirPath, jobSpecParentsMappedByDirPath)
 		}
-		jobSpecs[i] = jobSpec
+		expandedJobSpecs, err := jobSpec.ExpandMatrix()
+		if err != nil {
+			return nil, fmt.Errorf("error expanding job spec under [%s]: %w", dirPath, err)
+		}
+		jobSpecs = append(jobSpecs, expandedJobSpecs...)
 	}
 	return jobSpecs, nil
 }
@@ -97,6 +101,9 @@ func (j jobSpecReadWriter) Write(dirPath string, spec *model.JobSpec) error {
 	if spec == nil {
 		return errors.New("job spec is nil")
 	}
+	if spec.MatrixParameters != nil {
+		return fmt.Errorf("job spec [%s] is expanded from the matrix under [%s], the matrix spec should be written instead", spec.Name, spec.Path)
+	}
 
 	specFilePath := filepath.Join(dirPath, j.referenceSpecFileName)
 	if err := internal.WriteSpec(j.specFS, specFilePath, spec); err != nil {
@@ -133,6 +133,59 @@ func (j *JobSpecReadWriterTestSuite) TestReadAll() {
 		j.Assert().NoError(err)
 		j.Assert().Len(jobSpecs, 1)
 	})
+
+	j.Run("return job specs expanded from the matrix of the job spec", func() {
+		specFS := afero.NewMemMapFs()
+		err := j.writeTo(specFS, "root/ns1/jobs/sales/job.yaml", `version: 2
+name: sales-{{ .matrix.country }}
+owner: optimus@optimus.dev
+schedule:
+  start_date: "2022-03-22"
+  interval: 0 22 * * *
+task:
+  name: bq2bq
+  config:
+    TABLE: sales_{{ .matrix.country }}
+  window:
+    size: 24h
+    offset: "0"
+    truncate_to: d
+matrix:
+  - country: id
+  - country: sg`)
+		j.Require().NoError(err)
+		err = j.writeTo(specFS, "root/ns1/jobs/sales/assets/query.sql", `SELECT * FROM sales WHERE country = '{{ .matrix.country }}' AND created_at < '{{.DEND}}'`)
+		j.Require().NoError(err)
+
+		jobSpecReadWriter := specio.NewTestJobSpecReadWriter(specFS)
+
+		jobSpecs, err := jobSpecReadWriter.ReadAll("root")
+
+		j.Assert().NoError(err)
+		j.Require().Len(jobSpecs, 2)
+		j.Assert().Equal("sales-id", jobSpecs[0].Name)
+		j.Assert().Equal("sales_id", jobSpecs[0].Task.Config["TABLE"])
+		j.Assert().Equal(`SELECT * FROM sales WHERE country = 'id' AND created_at < '{{.DEND}}'`, jobSpecs[0].Asset["query.sql"])
+		j.Assert().Equal("sales-sg", jobSpecs[1].Name)
+		j.Assert().Equal("sales_sg", jobSpecs[1].Task.Config["TABLE"])
+		j.Assert().Equal("root/ns1/jobs/sales", jobSpecs[1].Path)
+	})
+
+	j.Run("return nil and error when matrix of the job spec cannot be expanded", func() {
+		specFS := afero.NewMemMapFs()
+		err := j.writeTo(specFS, "root/ns1/jobs/sales/job.yaml", `version: 2
+name: sales-{{ .matrix.tenant }}
+matrix:
+  - country: id`)
+		j.Require().NoError(err)
+
+		jobSpecReadWriter := specio.NewTestJobSpecReadWriter(specFS)
+
+		jobSpecs, err := jobSpecReadWriter.ReadAll("root")
+
+		j.Assert().ErrorContains(err, "matrix parameter [tenant] is not declared")
+		j.Assert().Nil(jobSpecs)
+	})
 }
 
 func (j *JobSpecReadWriterTestSuite) TestReadByName() {
@@ -227,6 +280,21 @@ func (j *JobSpecReadWriterTestSuite) TestWrite() {
 		j.Assert().Error(err)
 	})
 
+	j.Run("return error if job spec is expanded from matrix", func() {
+		specFS := afero.NewMemMapFs()
+		jobSpecReadWriter := specio.NewTestJobSpecReadWriter(specFS)
+
+		filePath := "root/ns1/jobs/sales"
+		jobSpec := &model.JobSpec{Version: 1, Name: "sales-id", MatrixParameters: map[string]string{"country": "id"}}
+
+		err := jobSpecReadWriter.Write(filePath, jobSpec)
+
+		j.Assert().ErrorContains(err, "is expanded from the matrix")
+		exists, err := afero.Exists(specFS, filepath.Join(filePath, "job.yaml"))
+		j.Require().NoError(err)
+		j.Assert().False(exists)
+	})
+
 	j.Run("return error if job file path is restricted to write", func() {
 		specFS := afero.NewMemMapFs()
 		err := specFS.MkdirAll("root/ns1/jobs", os.ModeDir)
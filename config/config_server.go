@@ -28,7 +28,23 @@ type DBConfig struct {
 }
 
 type SchedulerConfig struct {
-	Name string `mapstructure:"name" default:"airflow"`
+	Name                     string                 `mapstructure:"name" default:"airflow"`
+	PriorityResolver         PriorityResolverConfig `mapstructure:"priority_resolver"`
+	DriftCheckInterval       time.Duration          `mapstructure:"drift_check_interval"`                     // interval to report jobs drifted from the scheduler, disabled when empty
+	SLADeadlineCheckInterval time.Duration          `mapstructure:"sla_deadline_check_interval" default:"5m"` // interval to check unfinished job runs against their sla deadline
+	GapDetection             GapDetectionConfig     `mapstructure:"gap_detection"`
+	JobEnableCheckInterval   time.Duration          `mapstructure:"job_enable_check_interval" default:"1m"` // interval to enable back the jobs disabled until a time
+}
+
+type GapDetectionConfig struct {
+	Interval    time.Duration `mapstructure:"interval"`                  // interval to check job runs for gaps, disabled when empty
+	Lookback    time.Duration `mapstructure:"lookback" default:"168h"`   // job runs history checked for gaps
+	GracePeriod time.Duration `mapstructure:"grace_period" default:"3h"` // time given to a scheduled run to succeed before it is missing
+}
+
+type PriorityResolverConfig struct {
+	Type             string        `mapstructure:"type" default:"simple"`            // simple or critical_path
+	DurationLookback time.Duration `mapstructure:"duration_lookback" default:"720h"` // job runs history used for job durations by critical_path
 }
 
 type TelemetryConfig struct {
@@ -270,6 +270,12 @@ func (s *ConfigTestSuite) initExpectedServerConfig() {
 
 	s.expectedServerConfig.Scheduler = config.SchedulerConfig{}
 	s.expectedServerConfig.Scheduler.Name = "airflow2"
+	s.expectedServerConfig.Scheduler.PriorityResolver.Type = "simple"
+	s.expectedServerConfig.Scheduler.PriorityResolver.DurationLookback = time.Hour * 24 * 30
+	s.expectedServerConfig.Scheduler.SLADeadlineCheckInterval = time.Minute * 5
+	s.expectedServerConfig.Scheduler.GapDetection.Lookback = time.Hour * 24 * 7
+	s.expectedServerConfig.Scheduler.GapDetection.GracePeriod = time.Hour * 3
+	s.expectedServerConfig.Scheduler.JobEnableCheckInterval = time.Minute
 
 	s.expectedServerConfig.Telemetry = config.TelemetryConfig{}
 	s.expectedServerConfig.Telemetry.ProfileAddr = ":9110"
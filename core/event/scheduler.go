@@ -105,7 +105,22 @@ func toOptimusChangeEvent(j *scheduler.JobRun, e Event, eventType pbInt.OptimusC
 				ScheduledAt: timestamppb.New(j.ScheduledAt),
 				JobRunId:    j.ID.String(),
 				StartTime:   timestamppb.New(j.StartTime),
+
+				SkippedUpstreams: toSkippedUpstreamsPayload(j.SkippedUpstreams),
 			},
 		},
 	}
 }
+
+func toSkippedUpstreamsPayload(skippedUpstreams []*scheduler.SkippedUpstream) []*pbInt.SkippedUpstream {
+	payload := make([]*pbInt.SkippedUpstream, len(skippedUpstreams))
+	for i, skipped := range skippedUpstreams {
+		payload[i] = &pbInt.SkippedUpstream{
+			ProjectName:   skipped.Tenant.ProjectName().String(),
+			NamespaceName: skipped.Tenant.NamespaceName().String(),
+			JobName:       skipped.JobName.String(),
+			Reason:        skipped.Reason,
+		}
+	}
+	return payload
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/raystack/optimus/core/job"
 	"github.com/raystack/optimus/core/job/service/filter"
 	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/compiler"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/internal/telemetry"
@@ -42,18 +43,25 @@ func NewJobHandler(jobService JobService, logger log.Logger) *JobHandler {
 }
 
 type JobService interface {
-	Add(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec) error
-	Update(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec) error
+	Add(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, change *job.SpecChange) error
+	Update(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, change *job.SpecChange) error
 	SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error
-	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error
+	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error
+	GetDisabledJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName string) ([]*job.DisabledJob, error)
 	ChangeNamespace(ctx context.Context, jobSourceTenant, jobNewTenant tenant.Tenant, jobName job.Name) error
 	Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool) (affectedDownstream []job.FullName, err error)
 	Get(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name) (jobSpec *job.Job, err error)
 	GetTaskInfo(ctx context.Context, task job.Task) (*plugin.Info, error)
 	GetByFilter(ctx context.Context, filters ...filter.FilterOpt) (jobSpecs []*job.Job, err error)
-	ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) error
+	ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, jobNamesWithInvalidSpec []job.Name, change *job.SpecChange, logWriter writer.LogWriter) error
 	Refresh(ctx context.Context, projectName tenant.ProjectName, namespaceNames, jobNames []string, logWriter writer.LogWriter) error
 	Validate(ctx context.Context, jobTenant tenant.Tenant, jobSpecs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) error
+	Plan(ctx context.Context, jobTenant tenant.Tenant, jobSpecs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) (*job.Plan, error)
+	GetSpecHistory(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error)
+	Rollback(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, version int, changedBy string) error
+	GetCalendar(ctx context.Context, projectName tenant.ProjectName, name string) (*models.Calendar, error)
+	RegisterTemplateLibrary(ctx context.Context, library *job.TemplateLibrary) (*job.TemplateLibrary, []job.FullName, error)
+	GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName, name string) (job.TemplateLibraries, error)
 
 	GetJobBasicInfo(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, spec *job.Spec) (*job.Job, writer.BufferedLogger)
 	GetUpstreamsToInspect(ctx context.Context, subjectJob *job.Job, localJob bool) ([]*job.Upstream, error)
@@ -83,7 +91,8 @@ func (jh *JobHandler) AddJobSpecifications(ctx context.Context, jobSpecRequest *
 		return nil, me.ToErr()
 	}
 
-	if err = jh.jobService.Add(ctx, jobTenant, jobSpecs); err != nil {
+	change := job.NewSpecChange(jobSpecRequest.GetChangedBy(), job.ChangeSourceAPI)
+	if err = jh.jobService.Add(ctx, jobTenant, jobSpecs, change); err != nil {
 		jh.l.Error("failure found when adding job specifications: %s", err)
 		me.Append(err)
 	}
@@ -193,7 +202,8 @@ func (jh *JobHandler) UpdateJobSpecifications(ctx context.Context, jobSpecReques
 		return nil, me.ToErr()
 	}
 
-	if err = jh.jobService.Update(ctx, jobTenant, jobSpecs); err != nil {
+	change := job.NewSpecChange(jobSpecRequest.GetChangedBy(), job.ChangeSourceAPI)
+	if err = jh.jobService.Update(ctx, jobTenant, jobSpecs, change); err != nil {
 		jh.l.Error(fmt.Sprintf("%s: %s", "failed to update job specifications", err.Error()))
 		me.Append(err)
 	}
@@ -235,6 +245,118 @@ func (jh *JobHandler) GetJobSpecification(ctx context.Context, req *pb.GetJobSpe
 	}, nil
 }
 
+func (jh *JobHandler) GetJobSpecificationHistory(ctx context.Context, req *pb.GetJobSpecificationHistoryRequest) (*pb.GetJobSpecificationHistoryResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		jh.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job specification history")
+	}
+	jobName, err := job.NameFrom(req.GetJobName())
+	if err != nil {
+		jh.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job specification history")
+	}
+
+	specVersions, err := jh.jobService.GetSpecHistory(ctx, projectName, jobName)
+	if err != nil {
+		jh.l.Error("error getting history of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get job specification history of "+jobName.String())
+	}
+
+	versions := make([]*pb.JobSpecificationVersion, len(specVersions))
+	for i, specVersion := range specVersions {
+		versions[i] = &pb.JobSpecificationVersion{
+			Version:       int32(specVersion.Version),
+			Spec:          ToJobProto(specVersion.Job),
+			NamespaceName: specVersion.Job.Tenant().NamespaceName().String(),
+			ChangedBy:     specVersion.ChangedBy,
+			Source:        specVersion.Source.String(),
+			CreatedAt:     timestamppb.New(specVersion.CreatedAt),
+		}
+	}
+	return &pb.GetJobSpecificationHistoryResponse{Versions: versions}, nil
+}
+
+func (jh *JobHandler) RegisterTemplateLibrary(ctx context.Context, req *pb.RegisterTemplateLibraryRequest) (*pb.RegisterTemplateLibraryResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		jh.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to register template library")
+	}
+	library, err := job.NewTemplateLibrary(projectName, req.GetNamespaceName(), req.GetName(), req.GetContent())
+	if err != nil {
+		jh.l.Error("error adapting template library [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to register template library")
+	}
+
+	savedLibrary, affectedJobs, err := jh.jobService.RegisterTemplateLibrary(ctx, library)
+	if err != nil {
+		jh.l.Error("error registering template library [%s]: %s", library.Name, err)
+		return nil, errors.GRPCErr(err, "unable to register template library "+library.Name)
+	}
+
+	affectedJobNames := make([]string, len(affectedJobs))
+	for i, affectedJob := range affectedJobs {
+		affectedJobNames[i] = affectedJob.String()
+	}
+	return &pb.RegisterTemplateLibraryResponse{
+		Library:      toTemplateLibraryProto(savedLibrary),
+		AffectedJobs: affectedJobNames,
+	}, nil
+}
+
+func (jh *JobHandler) GetTemplateLibraries(ctx context.Context, req *pb.GetTemplateLibrariesRequest) (*pb.GetTemplateLibrariesResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		jh.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get template libraries")
+	}
+
+	libraries, err := jh.jobService.GetTemplateLibraries(ctx, projectName, req.GetNamespaceName(), req.GetName())
+	if err != nil {
+		jh.l.Error("error getting template libraries of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to get template libraries of "+projectName.String())
+	}
+
+	librariesProto := make([]*pb.TemplateLibrary, len(libraries))
+	for i, library := range libraries {
+		librariesProto[i] = toTemplateLibraryProto(library)
+	}
+	return &pb.GetTemplateLibrariesResponse{Libraries: librariesProto}, nil
+}
+
+func (*JobHandler) GetTemplateFunctions(_ context.Context, _ *pb.GetTemplateFunctionsRequest) (*pb.GetTemplateFunctionsResponse, error) {
+	functions := compiler.TemplateFunctions()
+	functionsProto := make([]*pb.TemplateFunction, len(functions))
+	for i, function := range functions {
+		functionsProto[i] = toTemplateFunctionProto(function)
+	}
+	return &pb.GetTemplateFunctionsResponse{Functions: functionsProto}, nil
+}
+
+func (jh *JobHandler) RollbackJobSpecification(ctx context.Context, req *pb.RollbackJobSpecificationRequest) (*pb.RollbackJobSpecificationResponse, error) {
+	jobTenant, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		jh.l.Error("invalid tenant information request project [%s] namespace [%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to rollback job specification")
+	}
+	jobName, err := job.NameFrom(req.GetJobName())
+	if err != nil {
+		jh.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to rollback job specification")
+	}
+	if req.GetVersion() <= 0 {
+		jh.l.Error("invalid version [%d] to rollback job [%s]", req.GetVersion(), jobName)
+		return nil, errors.InvalidArgument(job.EntityJob, "version to rollback to should be positive")
+	}
+
+	if err := jh.jobService.Rollback(ctx, jobTenant, jobName, int(req.GetVersion()), req.GetChangedBy()); err != nil {
+		jh.l.Error("error rolling back job [%s] to version [%d]: %s", jobName, req.GetVersion(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("unable to rollback job %s to version %d", jobName, req.GetVersion()))
+	}
+	return &pb.RollbackJobSpecificationResponse{}, nil
+}
+
 func (jh *JobHandler) GetJobSpecifications(ctx context.Context, req *pb.GetJobSpecificationsRequest) (*pb.GetJobSpecificationsResponse, error) {
 	jobSpecs, merr := jh.jobService.GetByFilter(ctx,
 		filter.WithString(filter.ResourceDestination, req.GetResourceDestination()),
@@ -275,7 +397,7 @@ func (jh *JobHandler) ListJobSpecification(ctx context.Context, req *pb.ListJobS
 	}, merr
 }
 
-func (jh *JobHandler) GetWindow(_ context.Context, req *pb.GetWindowRequest) (*pb.GetWindowResponse, error) {
+func (jh *JobHandler) GetWindow(ctx context.Context, req *pb.GetWindowRequest) (*pb.GetWindowResponse, error) {
 	// TODO: the default version to be deprecated & made mandatory in future releases
 	version := 1
 	if err := req.GetScheduledAt().CheckValid(); err != nil {
@@ -286,7 +408,7 @@ func (jh *JobHandler) GetWindow(_ context.Context, req *pb.GetWindowRequest) (*p
 	if req.Version != 0 {
 		version = int(req.Version)
 	}
-	window, err := models.NewWindow(version, req.GetTruncateTo(), req.GetOffset(), req.GetSize())
+	window, err := models.NewWindowWithCalendar(version, req.GetTruncateTo(), req.GetOffset(), req.GetSize(), req.GetCalendar())
 	if err != nil {
 		jh.l.Error("error initializing window with version [%d]: %s", req.Version, err)
 		return nil, err
@@ -295,6 +417,12 @@ func (jh *JobHandler) GetWindow(_ context.Context, req *pb.GetWindowRequest) (*p
 		jh.l.Error("error validating window: %s", err)
 		return nil, err
 	}
+	if req.GetCalendar() != "" {
+		window, err = jh.withCalendar(ctx, req.GetProjectName(), window)
+		if err != nil {
+			return nil, errors.GRPCErr(err, "unable to get calendar "+req.GetCalendar())
+		}
+	}
 
 	me := errors.NewMultiError("get window errors")
 
@@ -314,6 +442,26 @@ func (jh *JobHandler) GetWindow(_ context.Context, req *pb.GetWindowRequest) (*p
 	}, nil
 }
 
+func (jh *JobHandler) withCalendar(ctx context.Context, projectName string, window models.Window) (models.Window, error) {
+	calendarName := models.CalendarOf(window)
+	name, err := tenant.ProjectNameFrom(projectName)
+	if err != nil {
+		jh.l.Error("project of calendar [%s] is invalid: %s", calendarName, err)
+		return nil, err
+	}
+	calendar, err := jh.jobService.GetCalendar(ctx, name, calendarName)
+	if err != nil {
+		jh.l.Error("error getting calendar [%s] of project [%s]: %s", calendarName, projectName, err)
+		return nil, err
+	}
+	calendarWindow, err := window.(models.CalendarWindow).WithCalendar(calendar)
+	if err != nil {
+		jh.l.Error("error binding calendar [%s] to window: %s", calendarName, err)
+		return nil, errors.InvalidArgument(job.EntityJob, err.Error())
+	}
+	return calendarWindow, nil
+}
+
 func (jh *JobHandler) ReplaceAllJobSpecifications(stream pb.JobSpecificationService_ReplaceAllJobSpecificationsServer) error {
 	responseWriter := writer.NewReplaceAllJobSpecificationsResponseWriter(stream)
 	var errNamespaces []string
@@ -354,7 +502,19 @@ func (jh *JobHandler) ReplaceAllJobSpecifications(stream pb.JobSpecificationServ
 			errMessages = append(errMessages, errMsg)
 		}
 
-		if err := jh.jobService.ReplaceAll(stream.Context(), jobTenant, jobSpecs, jobNamesWithInvalidSpec, responseWriter); err != nil {
+		if request.GetPlan() {
+			if err := jh.planJobSpecifications(stream, jobTenant, jobSpecs, jobNamesWithInvalidSpec, responseWriter); err != nil {
+				errMsg := fmt.Sprintf("[%s] plan job specifications failure: %s", request.NamespaceName, err.Error())
+				jh.l.Error(errMsg)
+				responseWriter.Write(writer.LogLevelError, errMsg)
+				errNamespaces = append(errNamespaces, request.NamespaceName)
+				errMessages = append(errMessages, errMsg)
+			}
+			continue
+		}
+
+		change := job.NewSpecChange(request.GetChangedBy(), job.ChangeSourceReplaceAll)
+		if err := jh.jobService.ReplaceAll(stream.Context(), jobTenant, jobSpecs, jobNamesWithInvalidSpec, change, responseWriter); err != nil {
 			errMsg := fmt.Sprintf("[%s] replace all job specifications failure: %s", request.NamespaceName, err.Error())
 			jh.l.Error(errMsg)
 			responseWriter.Write(writer.LogLevelError, errMsg)
@@ -379,6 +539,20 @@ func (jh *JobHandler) ReplaceAllJobSpecifications(stream pb.JobSpecificationServ
 	return nil
 }
 
+// planJobSpecifications sends the plan of the namespace, even when it is partial because of errors
+func (jh *JobHandler) planJobSpecifications(stream pb.JobSpecificationService_ReplaceAllJobSpecificationsServer, jobTenant tenant.Tenant,
+	jobSpecs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter,
+) error {
+	plan, err := jh.jobService.Plan(stream.Context(), jobTenant, jobSpecs, jobNamesWithInvalidSpec, logWriter)
+	if plan == nil {
+		return err
+	}
+	if sendErr := stream.Send(&pb.ReplaceAllJobSpecificationsResponse{Plan: toPlanProto(plan)}); sendErr != nil {
+		return sendErr
+	}
+	return err
+}
+
 func (jh *JobHandler) RefreshJobs(request *pb.RefreshJobsRequest, stream pb.JobSpecificationService_RefreshJobsServer) error {
 	startTime := time.Now()
 	defer func() {
@@ -506,6 +680,26 @@ func (jh *JobHandler) UpdateJobsState(ctx context.Context, req *pb.UpdateJobsSta
 		jh.l.Error("empty remark for changing %d jobs state of %s:%s to %s", len(req.GetJobNames()), jobState, jobTenant.ProjectName(), jobTenant.NamespaceName())
 		return nil, errors.InvalidArgument(job.EntityJob, "can not update job state without a valid remark")
 	}
+
+	var enableAt *time.Time
+	if req.GetEnableAt() != nil {
+		if err := req.GetEnableAt().CheckValid(); err != nil {
+			jh.l.Error("invalid enable time: %s", err)
+			return nil, errors.InvalidArgument(job.EntityJob, "invalid enable_at")
+		}
+		enableTime := req.GetEnableAt().AsTime()
+		if !enableTime.After(time.Now()) {
+			jh.l.Error("enable time [%s] is not in the future", enableTime)
+			return nil, errors.InvalidArgument(job.EntityJob, "enable time should be in the future")
+		}
+		enableAt = &enableTime
+	}
+
+	change, err := job.NewStateChange(jobState, remark, req.GetChangedBy(), enableAt)
+	if err != nil {
+		jh.l.Error("error adapting job state change: %s", err)
+		return nil, err
+	}
 	var jobNames []job.Name
 	for _, name := range req.GetJobNames() {
 		jobName, err := job.NameFrom(name)
@@ -516,7 +710,7 @@ func (jh *JobHandler) UpdateJobsState(ctx context.Context, req *pb.UpdateJobsSta
 		jobNames = append(jobNames, jobName)
 	}
 
-	err = jh.jobService.UpdateState(ctx, jobTenant, jobNames, jobState, remark)
+	err = jh.jobService.UpdateState(ctx, jobTenant, jobNames, change)
 	if err != nil {
 		jh.l.Error("error updating job state", err.Error())
 		return nil, err
@@ -525,6 +719,36 @@ func (jh *JobHandler) UpdateJobsState(ctx context.Context, req *pb.UpdateJobsSta
 	return &pb.UpdateJobsStateResponse{}, nil
 }
 
+func (jh *JobHandler) GetDisabledJobs(ctx context.Context, req *pb.GetDisabledJobsRequest) (*pb.GetDisabledJobsResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		jh.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get disabled jobs")
+	}
+
+	disabledJobs, err := jh.jobService.GetDisabledJobs(ctx, projectName, req.GetNamespaceName())
+	if err != nil {
+		jh.l.Error("error getting disabled jobs of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to get disabled jobs of project "+projectName.String())
+	}
+
+	disabledJobProtos := make([]*pb.DisabledJob, len(disabledJobs))
+	for i, disabledJob := range disabledJobs {
+		disabledJobProto := &pb.DisabledJob{
+			JobName:       disabledJob.Name.String(),
+			NamespaceName: disabledJob.Tenant.NamespaceName().String(),
+			Remark:        disabledJob.Remark,
+			DisabledBy:    disabledJob.DisabledBy,
+			DisabledAt:    timestamppb.New(disabledJob.DisabledAt),
+		}
+		if disabledJob.EnableAt != nil {
+			disabledJobProto.EnableAt = timestamppb.New(*disabledJob.EnableAt)
+		}
+		disabledJobProtos[i] = disabledJobProto
+	}
+	return &pb.GetDisabledJobsResponse{Jobs: disabledJobProtos}, nil
+}
+
 func (jh *JobHandler) SyncJobsState(ctx context.Context, req *pb.SyncJobsStateRequest) (*pb.SyncJobsStateResponse, error) {
 	jobTenant, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/internal/compiler"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/internal/utils"
@@ -26,6 +28,7 @@ func ToJobProto(jobEntity *job.Job) *pb.JobSpecification {
 		WindowSize:       jobEntity.Spec().Window().GetSize(),
 		WindowOffset:     jobEntity.Spec().Window().GetOffset(),
 		WindowTruncateTo: jobEntity.Spec().Window().GetTruncateTo(),
+		WindowCalendar:   models.CalendarOf(jobEntity.Spec().Window()),
 		Dependencies:     fromSpecUpstreams(jobEntity.Spec().UpstreamSpec()),
 		Assets:           fromAsset(jobEntity.Spec().Asset()),
 		Hooks:            fromHooks(jobEntity.Spec().Hooks()),
@@ -105,7 +108,7 @@ func fromJobProto(js *pb.JobSpecification) (*job.Spec, error) {
 		return nil, err
 	}
 
-	window, err := models.NewWindow(int(js.Version), js.WindowTruncateTo, js.WindowOffset, js.WindowSize)
+	window, err := models.NewWindowWithCalendar(int(js.Version), js.WindowTruncateTo, js.WindowOffset, js.WindowSize, js.WindowCalendar)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +223,12 @@ func toHooks(hooksProto []*pb.JobSpecHook) ([]*job.Hook, error) {
 		if err != nil {
 			return nil, err
 		}
-		hookSpec, err := job.NewHook(hookProto.Name, hookConfig)
+		hookSpec, err := job.NewHookBuilder(hookProto.Name, hookConfig).
+			WithDependsOn(hookProto.DependsOn).
+			WithRunIf(hookProto.RunIf).
+			WithRetry(int(hookProto.RetryCount), hookProto.RetryDelay.AsDuration()).
+			WithTimeout(hookProto.Timeout.AsDuration()).
+			Build()
 		if err != nil {
 			return nil, err
 		}
@@ -232,10 +240,20 @@ func toHooks(hooksProto []*pb.JobSpecHook) ([]*job.Hook, error) {
 func fromHooks(hooks []*job.Hook) []*pb.JobSpecHook {
 	var hooksProto []*pb.JobSpecHook
 	for _, hook := range hooks {
-		hooksProto = append(hooksProto, &pb.JobSpecHook{
-			Name:   hook.Name(),
-			Config: fromConfig(hook.Config()),
-		})
+		hookProto := &pb.JobSpecHook{
+			Name:       hook.Name(),
+			Config:     fromConfig(hook.Config()),
+			DependsOn:  hook.DependsOn(),
+			RunIf:      hook.RunIf(),
+			RetryCount: int32(hook.RetryCount()),
+		}
+		if hook.RetryDelay() > 0 {
+			hookProto.RetryDelay = durationpb.New(hook.RetryDelay())
+		}
+		if hook.Timeout() > 0 {
+			hookProto.Timeout = durationpb.New(hook.Timeout())
+		}
+		hooksProto = append(hooksProto, hookProto)
 	}
 	return hooksProto
 }
@@ -280,10 +298,18 @@ func fromAlerts(jobAlerts []*job.AlertSpec) []*pb.JobSpecification_Behavior_Noti
 func toSpecUpstreams(upstreamProtos []*pb.JobDependency) (*job.UpstreamSpec, error) {
 	var upstreamNames []job.SpecUpstreamName
 	var httpUpstreams []*job.SpecHTTPUpstream
+	runConfigs := make(map[job.SpecUpstreamName]*job.UpstreamRunConfig)
 	for _, upstream := range upstreamProtos {
 		upstreamName := job.SpecUpstreamNameFrom(upstream.Name)
 		if upstream.HttpDependency == nil {
 			upstreamNames = append(upstreamNames, upstreamName)
+			runConfig, err := toUpstreamRunConfig(upstream)
+			if err != nil {
+				return nil, err
+			}
+			if runConfig != nil {
+				runConfigs[upstreamName] = runConfig
+			}
 			continue
 		}
 		httpUpstreamProto := upstream.HttpDependency
@@ -296,20 +322,60 @@ func toSpecUpstreams(upstreamProtos []*pb.JobDependency) (*job.UpstreamSpec, err
 		}
 		httpUpstreams = append(httpUpstreams, httpUpstream)
 	}
-	upstream, err := job.NewSpecUpstreamBuilder().WithUpstreamNames(upstreamNames).WithSpecHTTPUpstream(httpUpstreams).Build()
+	upstream, err := job.NewSpecUpstreamBuilder().
+		WithUpstreamNames(upstreamNames).
+		WithSpecHTTPUpstream(httpUpstreams).
+		WithRunConfigs(runConfigs).
+		Build()
 	if err != nil {
 		return nil, err
 	}
 	return upstream, nil
 }
 
+func toUpstreamRunConfig(upstreamProto *pb.JobDependency) (*job.UpstreamRunConfig, error) {
+	if upstreamProto.RunOffset == "" && upstreamProto.Window == nil && !upstreamProto.Optional && upstreamProto.MaxWait == nil {
+		return nil, nil
+	}
+	window := upstreamProto.Window
+	if window == nil {
+		window = &pb.JobDependencyWindow{}
+	}
+	runConfig, err := job.NewUpstreamRunConfig(upstreamProto.RunOffset, window.Size, window.Offset, window.TruncateTo)
+	if err != nil {
+		return nil, err
+	}
+	if !upstreamProto.Optional {
+		if upstreamProto.MaxWait != nil {
+			return nil, errors.InvalidArgument(job.EntityJob, "max wait for upstream is only allowed when the upstream is optional")
+		}
+		return runConfig, nil
+	}
+	return runConfig.WithOptional(upstreamProto.MaxWait.AsDuration())
+}
+
 func fromSpecUpstreams(upstreams *job.UpstreamSpec) []*pb.JobDependency {
 	if upstreams == nil {
 		return nil
 	}
 	var dependencies []*pb.JobDependency
 	for _, upstreamName := range upstreams.UpstreamNames() {
-		dependencies = append(dependencies, &pb.JobDependency{Name: upstreamName.String()}) // TODO: upstream type?
+		dependency := &pb.JobDependency{Name: upstreamName.String()} // TODO: upstream type?
+		if runConfig := upstreams.RunConfigs()[upstreamName]; runConfig != nil {
+			dependency.RunOffset = runConfig.RunOffset()
+			if runConfig.HasWindow() {
+				dependency.Window = &pb.JobDependencyWindow{
+					Size:       runConfig.WindowSize(),
+					Offset:     runConfig.WindowOffset(),
+					TruncateTo: runConfig.WindowTruncateTo(),
+				}
+			}
+			dependency.Optional = runConfig.IsOptional()
+			if runConfig.MaxWait() > 0 {
+				dependency.MaxWait = durationpb.New(runConfig.MaxWait())
+			}
+		}
+		dependencies = append(dependencies, dependency)
 	}
 	for _, httpUpstream := range upstreams.HTTPUpstreams() {
 		dependencies = append(dependencies, &pb.JobDependency{
@@ -341,6 +407,12 @@ func toMetadata(jobMetadata *pb.JobMetadata) (*job.Metadata, error) {
 			"pool":  metadataSchedulerProto.Pool,
 			"queue": metadataSchedulerProto.Queue,
 		}
+		if metadataSchedulerProto.SensorPool != "" {
+			schedulerMetadata["sensor_pool"] = metadataSchedulerProto.SensorPool
+		}
+		if metadataSchedulerProto.TaskPool != "" {
+			schedulerMetadata["task_pool"] = metadataSchedulerProto.TaskPool
+		}
 		metadataBuilder = metadataBuilder.WithScheduler(schedulerMetadata)
 	}
 	metadata, err := metadataBuilder.Build()
@@ -380,6 +452,8 @@ func fromMetadata(metadata *job.Metadata) *pb.JobMetadata {
 		if _, ok := scheduler["queue"]; ok {
 			metadataSchedulerProto.Queue = metadata.Scheduler()["queue"]
 		}
+		metadataSchedulerProto.SensorPool = scheduler["sensor_pool"]
+		metadataSchedulerProto.TaskPool = scheduler["task_pool"]
 	}
 	return &pb.JobMetadata{
 		Resource: metadataResourceProto,
@@ -482,3 +556,51 @@ func toDownstreamProtos(downstreamJobs []*job.Downstream) []*pb.JobInspectRespon
 	}
 	return downstreamProtos
 }
+
+func toPlanProto(plan *job.Plan) *pb.JobSpecificationsPlan {
+	jobPlanProtos := make([]*pb.JobSpecificationPlan, len(plan.Jobs))
+	for i, jobPlan := range plan.Jobs {
+		diffProtos := make([]*pb.JobSpecificationFieldDiff, len(jobPlan.Diffs))
+		for j, diff := range jobPlan.Diffs {
+			diffProtos[j] = &pb.JobSpecificationFieldDiff{
+				Field:    diff.Field,
+				OldValue: diff.OldValue,
+				NewValue: diff.NewValue,
+			}
+		}
+		brokenDownstreams := make([]string, len(jobPlan.BrokenDownstreams))
+		for j, downstream := range jobPlan.BrokenDownstreams {
+			brokenDownstreams[j] = downstream.String()
+		}
+		jobPlanProtos[i] = &pb.JobSpecificationPlan{
+			JobName:             jobPlan.Name.String(),
+			Action:              jobPlan.Action.String(),
+			Diffs:               diffProtos,
+			UnresolvedUpstreams: jobPlan.UnresolvedUpstreams,
+			BrokenDownstreams:   brokenDownstreams,
+		}
+	}
+	return &pb.JobSpecificationsPlan{
+		NamespaceName: plan.Tenant.NamespaceName().String(),
+		Jobs:          jobPlanProtos,
+	}
+}
+
+func toTemplateLibraryProto(library *job.TemplateLibrary) *pb.TemplateLibrary {
+	return &pb.TemplateLibrary{
+		Name:          library.Name,
+		NamespaceName: library.NamespaceName,
+		Version:       int32(library.Version),
+		Content:       library.Content,
+		CreatedAt:     timestamppb.New(library.CreatedAt),
+	}
+}
+
+func toTemplateFunctionProto(function compiler.TemplateFunction) *pb.TemplateFunction {
+	return &pb.TemplateFunction{
+		Name:        function.Name,
+		Signature:   function.Signature,
+		Description: function.Description,
+		Category:    function.Category,
+	}
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/raystack/optimus/core/job"
 	"github.com/raystack/optimus/core/job/handler/v1beta1"
 	"github.com/raystack/optimus/core/job/service/filter"
 	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/compiler"
 	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/internal/writer"
 	pb "github.com/raystack/optimus/protos/raystack/optimus/core/v1beta1"
@@ -97,9 +99,10 @@ func TestNewJobHandler(t *testing.T) {
 				ProjectName:   project.Name().String(),
 				NamespaceName: namespace.Name().String(),
 				Specs:         jobProtos,
+				ChangedBy:     "optimus@example.io",
 			}
 
-			jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(nil)
+			jobService.On("Add", ctx, sampleTenant, mock.Anything, job.NewSpecChange("optimus@example.io", job.ChangeSourceAPI)).Return(nil)
 
 			resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -134,7 +137,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobProtos,
 			}
 
-			jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(nil)
+			jobService.On("Add", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 			resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -192,7 +195,7 @@ func TestNewJobHandler(t *testing.T) {
 					Specs:         jobSpecProtos,
 				}
 
-				jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(nil)
+				jobService.On("Add", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 				resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 				assert.Nil(t, err)
@@ -235,7 +238,7 @@ func TestNewJobHandler(t *testing.T) {
 					Specs:         jobSpecProtos,
 				}
 
-				jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(nil)
+				jobService.On("Add", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 				resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 				assert.Nil(t, err)
@@ -278,7 +281,7 @@ func TestNewJobHandler(t *testing.T) {
 					Specs:         jobSpecProtos,
 				}
 
-				jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(nil)
+				jobService.On("Add", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 				resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 				assert.Nil(t, err)
@@ -329,7 +332,7 @@ func TestNewJobHandler(t *testing.T) {
 					Specs:         jobSpecProtos,
 				}
 
-				jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(nil)
+				jobService.On("Add", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 				resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 				assert.Nil(t, err)
@@ -400,7 +403,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobSpecProtos,
 			}
 
-			jobService.On("Add", ctx, sampleTenant, mock.Anything).Return(errors.New("internal error"))
+			jobService.On("Add", ctx, sampleTenant, mock.Anything, mock.Anything).Return(errors.New("internal error"))
 
 			resp, err := jobHandler.AddJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -432,7 +435,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobProtos,
 			}
 
-			jobService.On("Update", ctx, sampleTenant, mock.Anything).Return(nil)
+			jobService.On("Update", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 			resp, err := jobHandler.UpdateJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -467,7 +470,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobProtos,
 			}
 
-			jobService.On("Update", ctx, sampleTenant, mock.Anything).Return(nil)
+			jobService.On("Update", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 			resp, err := jobHandler.UpdateJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -524,7 +527,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobSpecProtos,
 			}
 
-			jobService.On("Update", ctx, sampleTenant, mock.Anything).Return(nil)
+			jobService.On("Update", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 			resp, err := jobHandler.UpdateJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -554,7 +557,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobSpecProtos,
 			}
 
-			jobService.On("Update", ctx, sampleTenant, mock.Anything).Return(errors.New("internal error"))
+			jobService.On("Update", ctx, sampleTenant, mock.Anything, mock.Anything).Return(errors.New("internal error"))
 
 			resp, err := jobHandler.UpdateJobSpecifications(ctx, &request)
 			assert.ErrorContains(t, err, "no jobs to be processed")
@@ -596,7 +599,7 @@ func TestNewJobHandler(t *testing.T) {
 				Specs:         jobSpecProtos,
 			}
 
-			jobService.On("Update", ctx, sampleTenant, mock.Anything).Return(errors.New("internal error"))
+			jobService.On("Update", ctx, sampleTenant, mock.Anything, mock.Anything).Return(errors.New("internal error"))
 
 			resp, err := jobHandler.UpdateJobSpecifications(ctx, &request)
 			assert.Nil(t, err)
@@ -741,6 +744,98 @@ func TestNewJobHandler(t *testing.T) {
 			_, err := jobHandler.UpdateJobsState(ctx, request)
 			assert.ErrorContains(t, err, "can not update job state without a valid remark")
 		})
+		t.Run("fail if enable time is set when enabling", func(t *testing.T) {
+			request := &pb.UpdateJobsStateRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String()},
+				State:         pb.JobState_JOB_STATE_ENABLED,
+				Remark:        updateRemark,
+				EnableAt:      timestamppb.New(time.Now().Add(time.Hour)),
+			}
+
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			_, err := jobHandler.UpdateJobsState(ctx, request)
+			assert.ErrorContains(t, err, "enable time can only be set when disabling jobs")
+		})
+		t.Run("fail if enable time is not in the future", func(t *testing.T) {
+			request := &pb.UpdateJobsStateRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String()},
+				State:         pb.JobState_JOB_STATE_DISABLED,
+				Remark:        updateRemark,
+				EnableAt:      timestamppb.New(time.Now().Add(-time.Hour)),
+			}
+
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			_, err := jobHandler.UpdateJobsState(ctx, request)
+			assert.ErrorContains(t, err, "enable time should be in the future")
+		})
+		t.Run("disables jobs until the enable time", func(t *testing.T) {
+			enableAt := time.Now().Add(time.Hour * 24).UTC()
+			request := &pb.UpdateJobsStateRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String()},
+				State:         pb.JobState_JOB_STATE_DISABLED,
+				Remark:        updateRemark,
+				ChangedBy:     "optimus@example.io",
+				EnableAt:      timestamppb.New(enableAt),
+			}
+			change, _ := job.NewStateChange(job.DISABLED, updateRemark, "optimus@example.io", &enableAt)
+
+			jobService := new(JobService)
+			jobService.On("UpdateState", ctx, sampleTenant, []job.Name{jobAName}, change).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.UpdateJobsState(ctx, request)
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("GetDisabledJobs", func(t *testing.T) {
+		t.Run("fail if project name is empty", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.GetDisabledJobs(ctx, &pb.GetDisabledJobsRequest{})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "project name is empty")
+		})
+		t.Run("fail if unable to get disabled jobs", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("GetDisabledJobs", ctx, project.Name(), "").Return(nil, errors.New("some error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetDisabledJobs(ctx, &pb.GetDisabledJobsRequest{ProjectName: project.Name().String()})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "unable to get disabled jobs of project test-proj")
+		})
+		t.Run("returns the disabled jobs", func(t *testing.T) {
+			disabledAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			enableAt := disabledAt.Add(time.Hour * 24)
+			jobService := new(JobService)
+			jobService.On("GetDisabledJobs", ctx, project.Name(), namespace.Name().String()).Return([]*job.DisabledJob{
+				{Name: "job-A", Tenant: sampleTenant, Remark: "maintenance", DisabledBy: "optimus@example.io", DisabledAt: disabledAt, EnableAt: &enableAt},
+				{Name: "job-B", Tenant: sampleTenant, Remark: "deprecated", DisabledAt: disabledAt},
+			}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetDisabledJobs(ctx, &pb.GetDisabledJobsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetJobs(), 2)
+			assert.Equal(t, "job-A", resp.GetJobs()[0].GetJobName())
+			assert.Equal(t, namespace.Name().String(), resp.GetJobs()[0].GetNamespaceName())
+			assert.Equal(t, "maintenance", resp.GetJobs()[0].GetRemark())
+			assert.Equal(t, "optimus@example.io", resp.GetJobs()[0].GetDisabledBy())
+			assert.Equal(t, disabledAt, resp.GetJobs()[0].GetDisabledAt().AsTime())
+			assert.Equal(t, enableAt, resp.GetJobs()[0].GetEnableAt().AsTime())
+			assert.Nil(t, resp.GetJobs()[1].GetEnableAt())
+		})
 	})
 	t.Run("DeleteJobSpecification", func(t *testing.T) {
 		t.Run("deletes job successfully", func(t *testing.T) {
@@ -846,7 +941,7 @@ func TestNewJobHandler(t *testing.T) {
 		})
 		t.Run("returns error if version is not valid", func(t *testing.T) {
 			req := &pb.GetWindowRequest{
-				Version:     3,
+				Version:     4,
 				ScheduledAt: timestamppb.New(time.Date(2022, 11, 18, 13, 0, 0, 0, time.UTC)),
 			}
 			jobHandler := v1beta1.NewJobHandler(nil, log)
@@ -881,6 +976,62 @@ func TestNewJobHandler(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotNil(t, resp)
 		})
+		t.Run("returns dstart and dend skipping non business days of the project calendar", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			calendar, _ := models.CalendarFrom("exchange", "weekdays=mon-fri;holidays=2022-11-18")
+			jobService.On("GetCalendar", ctx, project.Name(), "exchange").Return(calendar, nil)
+
+			req := &pb.GetWindowRequest{
+				Version:     3,
+				ScheduledAt: timestamppb.New(time.Date(2022, 11, 21, 2, 0, 0, 0, time.UTC)),
+				Size:        "1b",
+				Offset:      "0",
+				TruncateTo:  "d",
+				ProjectName: project.Name().String(),
+				Calendar:    "exchange",
+			}
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+
+			resp, err := jobHandler.GetWindow(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2022, 11, 17, 0, 0, 0, 0, time.UTC), resp.GetStart().AsTime())
+			assert.Equal(t, time.Date(2022, 11, 21, 0, 0, 0, 0, time.UTC), resp.GetEnd().AsTime())
+		})
+		t.Run("returns error if calendar is not declared in the project", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			jobService.On("GetCalendar", ctx, project.Name(), "exchange").Return(nil, errors.New("calendar [exchange] is not declared"))
+
+			req := &pb.GetWindowRequest{
+				Version:     3,
+				ScheduledAt: timestamppb.New(time.Date(2022, 11, 21, 2, 0, 0, 0, time.UTC)),
+				Size:        "1b",
+				ProjectName: project.Name().String(),
+				Calendar:    "exchange",
+			}
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+
+			resp, err := jobHandler.GetWindow(ctx, req)
+			assert.ErrorContains(t, err, "calendar [exchange] is not declared")
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if calendar is provided for window version other than 3", func(t *testing.T) {
+			req := &pb.GetWindowRequest{
+				Version:     2,
+				ScheduledAt: timestamppb.New(time.Date(2022, 11, 21, 2, 0, 0, 0, time.UTC)),
+				Size:        "24h",
+				ProjectName: project.Name().String(),
+				Calendar:    "exchange",
+			}
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+
+			resp, err := jobHandler.GetWindow(ctx, req)
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
 		t.Run("should default to version 1 if not provided", func(t *testing.T) {
 			req := &pb.GetWindowRequest{
 				ScheduledAt: timestamppb.New(time.Date(2022, 11, 18, 13, 0, 0, 0, time.UTC)),
@@ -932,6 +1083,7 @@ func TestNewJobHandler(t *testing.T) {
 				ProjectName:   project.Name().String(),
 				NamespaceName: namespace.Name().String(),
 				Jobs:          jobProtos,
+				ChangedBy:     "optimus@example.io",
 			}
 
 			stream := new(ReplaceAllJobSpecificationsServer)
@@ -939,13 +1091,106 @@ func TestNewJobHandler(t *testing.T) {
 			stream.On("Recv").Return(request, nil).Once()
 			stream.On("Recv").Return(nil, io.EOF).Once()
 
-			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(nil)
+			change := job.NewSpecChange("optimus@example.io", job.ChangeSourceReplaceAll)
+			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, change, mock.Anything).Return(nil)
 
 			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil).Twice()
 
 			err := jobHandler.ReplaceAllJobSpecifications(stream)
 			assert.Nil(t, err)
 		})
+		t.Run("sends the plan of a tenant without replacing the job specifications when the request is a plan", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+
+			jobProtos := []*pb.JobSpecification{
+				{
+					Version:          int32(jobVersion),
+					Name:             "job-A",
+					Owner:            sampleOwner,
+					StartDate:        jobSchedule.StartDate().String(),
+					EndDate:          jobSchedule.EndDate().String(),
+					Interval:         jobSchedule.Interval(),
+					TaskName:         jobTask.Name().String(),
+					WindowSize:       jobWindow.GetSize(),
+					WindowOffset:     jobWindow.GetOffset(),
+					WindowTruncateTo: jobWindow.GetTruncateTo(),
+				},
+			}
+			request := &pb.ReplaceAllJobSpecificationsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Jobs:          jobProtos,
+				Plan:          true,
+			}
+
+			stream := new(ReplaceAllJobSpecificationsServer)
+			stream.On("Context").Return(ctx)
+			stream.On("Recv").Return(request, nil).Once()
+			stream.On("Recv").Return(nil, io.EOF).Once()
+
+			plan := job.NewPlan(sampleTenant)
+			plan.Add(&job.JobPlan{
+				Name:   "job-A",
+				Action: job.PlanActionUpdate,
+				Diffs:  []*job.FieldDiff{{Field: "owner", OldValue: "old-owner", NewValue: sampleOwner}},
+			})
+			plan.Add(&job.JobPlan{
+				Name:              "job-B",
+				Action:            job.PlanActionDelete,
+				BrokenDownstreams: []job.FullName{"test-proj/job-C"},
+			})
+			jobService.On("Plan", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(plan, nil)
+
+			stream.On("Send", &pb.ReplaceAllJobSpecificationsResponse{Plan: &pb.JobSpecificationsPlan{
+				NamespaceName: namespace.Name().String(),
+				Jobs: []*pb.JobSpecificationPlan{
+					{
+						JobName:           "job-A",
+						Action:            "update",
+						Diffs:             []*pb.JobSpecificationFieldDiff{{Field: "owner", OldValue: "old-owner", NewValue: sampleOwner}},
+						BrokenDownstreams: []string{},
+					},
+					{
+						JobName:           "job-B",
+						Action:            "delete",
+						Diffs:             []*pb.JobSpecificationFieldDiff{},
+						BrokenDownstreams: []string{"test-proj/job-C"},
+					},
+				},
+			}}).Return(nil).Once()
+			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil).Once()
+			defer stream.AssertExpectations(t)
+
+			err := jobHandler.ReplaceAllJobSpecifications(stream)
+			assert.Nil(t, err)
+		})
+		t.Run("returns error when unable to plan the job specifications", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+
+			request := &pb.ReplaceAllJobSpecificationsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Plan:          true,
+			}
+
+			stream := new(ReplaceAllJobSpecificationsServer)
+			stream.On("Context").Return(ctx)
+			stream.On("Recv").Return(request, nil).Once()
+			stream.On("Recv").Return(nil, io.EOF).Once()
+
+			jobService.On("Plan", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(nil, errors.New("internal error"))
+
+			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil)
+
+			err := jobHandler.ReplaceAllJobSpecifications(stream)
+			assert.ErrorContains(t, err, "error when replacing job specifications: [test-ns]")
+		})
 		t.Run("replaces all job specifications given multiple tenant", func(t *testing.T) {
 			jobService := new(JobService)
 
@@ -984,8 +1229,8 @@ func TestNewJobHandler(t *testing.T) {
 			stream.On("Recv").Return(request2, nil).Once()
 			stream.On("Recv").Return(nil, io.EOF).Once()
 
-			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(nil)
-			jobService.On("ReplaceAll", ctx, otherTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(nil)
+			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything, mock.Anything).Return(nil)
+			jobService.On("ReplaceAll", ctx, otherTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything, mock.Anything).Return(nil)
 
 			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil).Twice()
 
@@ -1027,7 +1272,7 @@ func TestNewJobHandler(t *testing.T) {
 			stream.On("Recv").Return(request, nil).Once()
 			stream.On("Recv").Return(nil, io.EOF).Once()
 
-			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, []job.Name{"job-A"}, mock.Anything).Return(nil)
+			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, []job.Name{"job-A"}, mock.Anything, mock.Anything).Return(nil)
 
 			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil).Times(3)
 
@@ -1078,7 +1323,7 @@ func TestNewJobHandler(t *testing.T) {
 			stream.On("Recv").Return(request2, nil).Once()
 			stream.On("Recv").Return(nil, io.EOF).Once()
 
-			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(nil)
+			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything, mock.Anything).Return(nil)
 
 			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil).Times(4)
 
@@ -1127,7 +1372,7 @@ func TestNewJobHandler(t *testing.T) {
 			stream.On("Recv").Return(request, nil).Once()
 			stream.On("Recv").Return(nil, io.EOF).Once()
 
-			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything).Return(errors.New("internal error"))
+			jobService.On("ReplaceAll", ctx, sampleTenant, mock.Anything, jobNamesWithInvalidSpec, mock.Anything, mock.Anything).Return(errors.New("internal error"))
 
 			stream.On("Send", mock.AnythingOfType("*optimus.ReplaceAllJobSpecificationsResponse")).Return(nil).Times(3)
 
@@ -1256,6 +1501,193 @@ func TestNewJobHandler(t *testing.T) {
 			assert.NotNil(t, resp)
 		})
 	})
+	t.Run("RegisterTemplateLibrary", func(t *testing.T) {
+		t.Run("return error when library is invalid", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.RegisterTemplateLibrary(ctx, &pb.RegisterTemplateLibraryRequest{
+				ProjectName: project.Name().String(),
+				Name:        "dates",
+			})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "content of template library [dates] is empty")
+		})
+		t.Run("return error when unable to register the library", func(t *testing.T) {
+			library, _ := job.NewTemplateLibrary(project.Name(), namespace.Name().String(), "dates", `{{ .DSTART }}`)
+
+			jobService := new(JobService)
+			jobService.On("RegisterTemplateLibrary", ctx, library).Return(nil, nil, errors.New("some error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RegisterTemplateLibrary(ctx, &pb.RegisterTemplateLibraryRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Name:          "dates",
+				Content:       `{{ .DSTART }}`,
+			})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "unable to register template library dates")
+		})
+		t.Run("returns the registered library and the affected jobs", func(t *testing.T) {
+			library, _ := job.NewTemplateLibrary(project.Name(), "", "dates", `{{ .DSTART }}`)
+			savedLibrary := *library
+			savedLibrary.Version = 3
+
+			jobService := new(JobService)
+			jobService.On("RegisterTemplateLibrary", ctx, library).Return(&savedLibrary, []job.FullName{"test-proj/job-A"}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RegisterTemplateLibrary(ctx, &pb.RegisterTemplateLibraryRequest{
+				ProjectName: project.Name().String(),
+				Name:        "dates",
+				Content:     `{{ .DSTART }}`,
+			})
+			assert.NoError(t, err)
+			assert.EqualValues(t, 3, resp.GetLibrary().GetVersion())
+			assert.Equal(t, []string{"test-proj/job-A"}, resp.GetAffectedJobs())
+		})
+	})
+	t.Run("GetTemplateLibraries", func(t *testing.T) {
+		t.Run("return error when project name is empty", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.GetTemplateLibraries(ctx, &pb.GetTemplateLibrariesRequest{})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "project name is empty")
+		})
+		t.Run("returns the libraries", func(t *testing.T) {
+			library, _ := job.NewTemplateLibrary(project.Name(), namespace.Name().String(), "dates", `{{ .DSTART }}`)
+
+			jobService := new(JobService)
+			jobService.On("GetTemplateLibraries", ctx, project.Name(), namespace.Name().String(), "").Return(job.TemplateLibraries{library}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetTemplateLibraries(ctx, &pb.GetTemplateLibrariesRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetLibraries(), 1)
+			assert.Equal(t, "dates", resp.GetLibraries()[0].GetName())
+			assert.Equal(t, namespace.Name().String(), resp.GetLibraries()[0].GetNamespaceName())
+		})
+	})
+	t.Run("GetTemplateFunctions", func(t *testing.T) {
+		t.Run("returns the documented template functions", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.GetTemplateFunctions(ctx, &pb.GetTemplateFunctionsRequest{})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetFunctions(), len(compiler.TemplateFunctions()))
+
+			var partitions *pb.TemplateFunction
+			for _, function := range resp.GetFunctions() {
+				if function.GetName() == "partitions" {
+					partitions = function
+				}
+			}
+			assert.NotNil(t, partitions)
+			assert.Equal(t, compiler.FunctionCategoryTime, partitions.GetCategory())
+			assert.NotEmpty(t, partitions.GetSignature())
+		})
+	})
+	t.Run("GetJobSpecificationHistory", func(t *testing.T) {
+		t.Run("return error when project name is empty", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.GetJobSpecificationHistory(ctx, &pb.GetJobSpecificationHistoryRequest{JobName: "job-A"})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "project name is empty")
+		})
+		t.Run("return error when unable to get the history", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("GetSpecHistory", ctx, project.Name(), job.Name("job-A")).Return(nil, errors.New("some error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobSpecificationHistory(ctx, &pb.GetJobSpecificationHistoryRequest{
+				ProjectName: project.Name().String(),
+				JobName:     "job-A",
+			})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "unable to get job specification history of job-A")
+		})
+		t.Run("returns the versions of the job", func(t *testing.T) {
+			createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", sampleOwner, jobSchedule, jobWindow, jobTask).Build()
+			jobA := job.NewJob(sampleTenant, specA, "table-A", nil)
+
+			jobService := new(JobService)
+			jobService.On("GetSpecHistory", ctx, project.Name(), job.Name("job-A")).Return([]*job.SpecVersion{
+				{Version: 2, Job: jobA, ChangedBy: "optimus@example.io", Source: job.ChangeSourceRollback, CreatedAt: createdAt.Add(time.Hour)},
+				{Version: 1, Job: jobA, ChangedBy: "optimus@example.io", Source: job.ChangeSourceAPI, CreatedAt: createdAt},
+			}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobSpecificationHistory(ctx, &pb.GetJobSpecificationHistoryRequest{
+				ProjectName: project.Name().String(),
+				JobName:     "job-A",
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetVersions(), 2)
+			assert.EqualValues(t, 2, resp.GetVersions()[0].GetVersion())
+			assert.Equal(t, "rollback", resp.GetVersions()[0].GetSource())
+			assert.Equal(t, namespace.Name().String(), resp.GetVersions()[1].GetNamespaceName())
+			assert.Equal(t, "optimus@example.io", resp.GetVersions()[1].GetChangedBy())
+			assert.Equal(t, "job-A", resp.GetVersions()[1].GetSpec().GetName())
+			assert.Equal(t, createdAt, resp.GetVersions()[1].GetCreatedAt().AsTime())
+		})
+	})
+	t.Run("RollbackJobSpecification", func(t *testing.T) {
+		t.Run("return error when tenant is invalid", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.RollbackJobSpecification(ctx, &pb.RollbackJobSpecificationRequest{JobName: "job-A", Version: 1})
+			assert.Nil(t, resp)
+			assert.Error(t, err)
+		})
+		t.Run("return error when version is not positive", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.RollbackJobSpecification(ctx, &pb.RollbackJobSpecificationRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       "job-A",
+			})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "version to rollback to should be positive")
+		})
+		t.Run("return error when unable to rollback", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("Rollback", ctx, sampleTenant, job.Name("job-A"), 1, "optimus@example.io").Return(errors.New("some error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RollbackJobSpecification(ctx, &pb.RollbackJobSpecificationRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       "job-A",
+				Version:       1,
+				ChangedBy:     "optimus@example.io",
+			})
+			assert.Nil(t, resp)
+			assert.ErrorContains(t, err, "unable to rollback job job-A to version 1")
+		})
+		t.Run("rolls back the job", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("Rollback", ctx, sampleTenant, job.Name("job-A"), 1, "optimus@example.io").Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RollbackJobSpecification(ctx, &pb.RollbackJobSpecificationRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       "job-A",
+				Version:       1,
+				ChangedBy:     "optimus@example.io",
+			})
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	})
 	t.Run("GetJobSpecifications", func(t *testing.T) {
 		t.Run("return error when service get by filter is failed", func(t *testing.T) {
 			jobService := new(JobService)
@@ -1562,11 +1994,17 @@ func TestNewJobHandler(t *testing.T) {
 			jobService := new(JobService)
 
 			httpUpstream, _ := job.NewSpecHTTPUpstreamBuilder("sample-upstream", "sample-url").Build()
+			runConfig, _ := job.NewUpstreamRunConfig("-24h", "4h", "", "h")
+			runConfig, _ = runConfig.WithOptional(time.Hour)
 			upstreamSpec, _ := job.NewSpecUpstreamBuilder().
 				WithSpecHTTPUpstream([]*job.SpecHTTPUpstream{httpUpstream}).
-				WithUpstreamNames([]job.SpecUpstreamName{"job-B"}).Build()
+				WithUpstreamNames([]job.SpecUpstreamName{"job-B"}).
+				WithRunConfigs(map[job.SpecUpstreamName]*job.UpstreamRunConfig{"job-B": runConfig}).Build()
 
-			hook1, _ := job.NewHook("hook-1", jobConfig)
+			hook1, _ := job.NewHookBuilder("hook-1", jobConfig).
+				WithRunIf(job.HookRunIfAlways).
+				WithRetry(1, time.Minute).
+				Build()
 
 			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", sampleOwner, jobSchedule, jobWindow, jobTask).
 				WithSpecUpstream(upstreamSpec).
@@ -1594,12 +2032,12 @@ func TestNewJobHandler(t *testing.T) {
 			jobService.On("GetDownstream", ctx, jobA, true).Return(jobADownstream, nil)
 
 			jobDependenciesWithHTTPProto := []*pb.JobDependency{
-				{Name: "job-B"},
+				{Name: "job-B", RunOffset: "-24h", Window: &pb.JobDependencyWindow{Size: "4h", TruncateTo: "h"}, Optional: true, MaxWait: durationpb.New(time.Hour)},
 				{HttpDependency: &pb.HttpDependency{Name: "sample-upstream", Url: "sample-url"}},
 			}
 
 			jobHooksProto := []*pb.JobSpecHook{
-				{Name: "hook-1", Config: configs},
+				{Name: "hook-1", Config: configs, RunIf: job.HookRunIfAlways, RetryCount: 1, RetryDelay: durationpb.New(time.Minute)},
 			}
 
 			jobSpecProto := &pb.JobSpecification{
@@ -1951,13 +2389,13 @@ type JobService struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: ctx, jobTenant, jobs
-func (_m *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec) error {
-	ret := _m.Called(ctx, jobTenant, jobs)
+// Add provides a mock function with given fields: ctx, jobTenant, jobs, change
+func (_m *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, change *job.SpecChange) error {
+	ret := _m.Called(ctx, jobTenant, jobs, change)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, []*job.Spec) error); ok {
-		r0 = rf(ctx, jobTenant, jobs)
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, []*job.Spec, *job.SpecChange) error); ok {
+		r0 = rf(ctx, jobTenant, jobs, change)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1994,12 +2432,88 @@ func (_m *JobService) ChangeNamespace(ctx context.Context, jobTenant, jobNewTena
 	return ret.Error(0)
 }
 
-// UpdateState provides a mock function with given fields: ctx, jobTenant, jobNames, jobState, remark
-func (_m *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error {
-	ret := _m.Called(ctx, jobTenant, jobNames, jobState, remark)
+// UpdateState provides a mock function with given fields: ctx, jobTenant, jobNames, change
+func (_m *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error {
+	ret := _m.Called(ctx, jobTenant, jobNames, change)
+	return ret.Error(0)
+}
+
+// GetDisabledJobs provides a mock function with given fields: ctx, projectName, namespaceName
+func (_m *JobService) GetDisabledJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName string) ([]*job.DisabledJob, error) {
+	ret := _m.Called(ctx, projectName, namespaceName)
+
+	var r0 []*job.DisabledJob
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.DisabledJob)
+	}
+	return r0, ret.Error(1)
+}
+
+// Plan provides a mock function with given fields: ctx, jobTenant, jobSpecs, jobNamesWithInvalidSpec, logWriter
+func (_m *JobService) Plan(ctx context.Context, jobTenant tenant.Tenant, jobSpecs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) (*job.Plan, error) {
+	ret := _m.Called(ctx, jobTenant, jobSpecs, jobNamesWithInvalidSpec, logWriter)
+
+	var r0 *job.Plan
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*job.Plan)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetSpecHistory provides a mock function with given fields: ctx, projectName, jobName
+func (_m *JobService) GetSpecHistory(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.SpecVersion
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.SpecVersion)
+	}
+	return r0, ret.Error(1)
+}
+
+// Rollback provides a mock function with given fields: ctx, jobTenant, jobName, version, changedBy
+func (_m *JobService) Rollback(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, version int, changedBy string) error {
+	ret := _m.Called(ctx, jobTenant, jobName, version, changedBy)
 	return ret.Error(0)
 }
 
+// GetCalendar provides a mock function with given fields: ctx, projectName, name
+func (_m *JobService) GetCalendar(ctx context.Context, projectName tenant.ProjectName, name string) (*models.Calendar, error) {
+	ret := _m.Called(ctx, projectName, name)
+
+	var r0 *models.Calendar
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*models.Calendar)
+	}
+	return r0, ret.Error(1)
+}
+
+// RegisterTemplateLibrary provides a mock function with given fields: ctx, library
+func (_m *JobService) RegisterTemplateLibrary(ctx context.Context, library *job.TemplateLibrary) (*job.TemplateLibrary, []job.FullName, error) {
+	ret := _m.Called(ctx, library)
+
+	var r0 *job.TemplateLibrary
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*job.TemplateLibrary)
+	}
+	var r1 []job.FullName
+	if ret.Get(1) != nil {
+		r1 = ret.Get(1).([]job.FullName)
+	}
+	return r0, r1, ret.Error(2)
+}
+
+// GetTemplateLibraries provides a mock function with given fields: ctx, projectName, namespaceName, name
+func (_m *JobService) GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName, name string) (job.TemplateLibraries, error) {
+	ret := _m.Called(ctx, projectName, namespaceName, name)
+
+	var r0 job.TemplateLibraries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(job.TemplateLibraries)
+	}
+	return r0, ret.Error(1)
+}
+
 // UpdateState provides a mock function with given fields: ctx, jobTenant, disabledJobNames, enabledJobNames
 func (_m *JobService) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	ret := _m.Called(ctx, jobTenant, disabledJobNames, enabledJobNames)
@@ -2165,13 +2679,13 @@ func (_m *JobService) Refresh(ctx context.Context, projectName tenant.ProjectNam
 	return r0
 }
 
-// ReplaceAll provides a mock function with given fields: ctx, jobTenant, jobs, jobNamesWithInvalidSpec, logWriter
-func (_m *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) error {
-	ret := _m.Called(ctx, jobTenant, jobs, jobNamesWithInvalidSpec, logWriter)
+// ReplaceAll provides a mock function with given fields: ctx, jobTenant, jobs, jobNamesWithInvalidSpec, change, logWriter
+func (_m *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, jobNamesWithInvalidSpec []job.Name, change *job.SpecChange, logWriter writer.LogWriter) error {
+	ret := _m.Called(ctx, jobTenant, jobs, jobNamesWithInvalidSpec, change, logWriter)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, []*job.Spec, []job.Name, writer.LogWriter) error); ok {
-		r0 = rf(ctx, jobTenant, jobs, jobNamesWithInvalidSpec, logWriter)
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, []*job.Spec, []job.Name, *job.SpecChange, writer.LogWriter) error); ok {
+		r0 = rf(ctx, jobTenant, jobs, jobNamesWithInvalidSpec, change, logWriter)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2179,13 +2693,13 @@ func (_m *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, j
 	return r0
 }
 
-// Update provides a mock function with given fields: ctx, jobTenant, jobs
-func (_m *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec) error {
-	ret := _m.Called(ctx, jobTenant, jobs)
+// Update provides a mock function with given fields: ctx, jobTenant, jobs, change
+func (_m *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Spec, change *job.SpecChange) error {
+	ret := _m.Called(ctx, jobTenant, jobs, change)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, []*job.Spec) error); ok {
-		r0 = rf(ctx, jobTenant, jobs)
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, []*job.Spec, *job.SpecChange) error); ok {
+		r0 = rf(ctx, jobTenant, jobs, change)
 	} else {
 		r0 = ret.Error(0)
 	}
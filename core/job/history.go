@@ -0,0 +1,40 @@
+package job
+
+import "time"
+
+// ChangeSource is where a change of job specs came from
+type ChangeSource string
+
+const (
+	ChangeSourceAPI        ChangeSource = "api"
+	ChangeSourceReplaceAll ChangeSource = "replace-all"
+	ChangeSourceRefresh    ChangeSource = "refresh"
+	ChangeSourceRollback   ChangeSource = "rollback"
+)
+
+func (c ChangeSource) String() string {
+	return string(c)
+}
+
+// SpecChange is who changed the job specs and through what, recorded along every version of the specs
+type SpecChange struct {
+	ChangedBy string
+	Source    ChangeSource
+}
+
+func NewSpecChange(changedBy string, source ChangeSource) *SpecChange {
+	return &SpecChange{
+		ChangedBy: changedBy,
+		Source:    source,
+	}
+}
+
+// SpecVersion is a snapshot of a job written on every add or update of its spec. Version is the
+// sequence of the snapshot for the job, not to be confused with the version of the spec format.
+type SpecVersion struct {
+	Version   int
+	Job       *Job
+	ChangedBy string
+	Source    ChangeSource
+	CreatedAt time.Time
+}
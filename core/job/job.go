@@ -97,7 +97,11 @@ func (j *Job) getStaticUpstreamsToResolve() ([]*Upstream, error) {
 			projectUpstreamName = j.ProjectName()
 		}
 
-		unresolvedStaticUpstreams = append(unresolvedStaticUpstreams, NewUpstreamUnresolvedStatic(jobUpstreamName, projectUpstreamName))
+		unresolvedStaticUpstream := NewUpstreamUnresolvedStatic(jobUpstreamName, projectUpstreamName)
+		if runConfig := j.upstreamRunConfig(upstreamName); runConfig != nil {
+			unresolvedStaticUpstream = unresolvedStaticUpstream.WithRunConfig(runConfig)
+		}
+		unresolvedStaticUpstreams = append(unresolvedStaticUpstreams, unresolvedStaticUpstream)
 	}
 	return unresolvedStaticUpstreams, me.ToErr()
 }
@@ -123,6 +127,13 @@ func (j *Job) StaticUpstreamNames() []SpecUpstreamName {
 	return j.spec.upstreamSpec.UpstreamNames()
 }
 
+func (j *Job) upstreamRunConfig(upstreamName SpecUpstreamName) *UpstreamRunConfig {
+	if j.spec.upstreamSpec == nil {
+		return nil
+	}
+	return j.spec.upstreamSpec.runConfigs[upstreamName]
+}
+
 func (j *Job) ProjectName() tenant.ProjectName {
 	return j.Tenant().ProjectName()
 }
@@ -251,7 +262,7 @@ func (w WithUpstreams) MergeWithResolvedUpstreams(resolvedUpstreamsBySubjectJobM
 		var mergedUpstream []*Upstream
 		for _, unresolvedUpstream := range jobWithUnresolvedUpstream.Upstreams() {
 			if resolvedUpstream, ok := resolvedUpstreamMapByFullName[unresolvedUpstream.FullName()]; ok {
-				mergedUpstream = append(mergedUpstream, resolvedUpstream)
+				mergedUpstream = append(mergedUpstream, resolvedUpstream.WithRunConfig(unresolvedUpstream.RunConfig()))
 				continue
 			}
 			if resolvedUpstream, ok := resolvedUpstreamMapByDestination[unresolvedUpstream.Resource().String()]; ok {
@@ -279,6 +290,8 @@ type Upstream struct {
 	state UpstreamState
 
 	external bool
+
+	runConfig *UpstreamRunConfig
 }
 
 func NewUpstreamResolved(name Name, host string, resource ResourceURN, jobTenant tenant.Tenant, upstreamType UpstreamType, taskName TaskName, external bool) *Upstream {
@@ -343,6 +356,21 @@ func (u *Upstream) FullName() string {
 	return u.projectName.String() + "/" + u.name.String()
 }
 
+// RunConfig returns the runs of the upstream to wait for, nil if the runs within the window of the job are waited
+func (u *Upstream) RunConfig() *UpstreamRunConfig {
+	return u.runConfig
+}
+
+// WithRunConfig returns a copy of the upstream with the run config, or the upstream itself if there is none
+func (u *Upstream) WithRunConfig(runConfig *UpstreamRunConfig) *Upstream {
+	if runConfig == nil {
+		return u
+	}
+	upstream := *u
+	upstream.runConfig = runConfig
+	return &upstream
+}
+
 type UpstreamType string
 
 func (d UpstreamType) String() string {
@@ -376,6 +376,24 @@ func TestEntityJob(t *testing.T) {
 			assert.ElementsMatch(t, expected[0].Upstreams(), result[0].Upstreams())
 			assert.ElementsMatch(t, expected[1].Upstreams(), result[1].Upstreams())
 		})
+		t.Run("MergeWithResolvedUpstreams should keep run config of static upstreams", func(t *testing.T) {
+			runConfig, _ := job.NewUpstreamRunConfig("-24h", "", "", "")
+			upstreamDUnresolvedStatic := job.NewUpstreamUnresolvedStatic("job-D", project.Name()).WithRunConfig(runConfig)
+			upstreamDResolvedStatic := job.NewUpstreamResolved("job-D", "host-sample", "project.dataset.sample-d", sampleTenant, job.UpstreamTypeStatic, "bq2bq", false)
+
+			resolvedUpstreamMap := map[job.Name][]*job.Upstream{
+				"job-A": {upstreamDResolvedStatic},
+			}
+			jobsWithUnresolvedUpstream := []*job.WithUpstream{
+				job.NewWithUpstream(jobA, []*job.Upstream{upstreamDUnresolvedStatic}),
+			}
+
+			result := job.WithUpstreams(jobsWithUnresolvedUpstream).MergeWithResolvedUpstreams(resolvedUpstreamMap)
+			assert.Len(t, result[0].Upstreams(), 1)
+			assert.Equal(t, job.UpstreamStateResolved, result[0].Upstreams()[0].State())
+			assert.Equal(t, runConfig, result[0].Upstreams()[0].RunConfig())
+			assert.Nil(t, upstreamDResolvedStatic.RunConfig())
+		})
 	})
 
 	t.Run("Downstream", func(t *testing.T) {
@@ -433,6 +451,22 @@ func TestEntityJob(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Len(t, jobWithUpstreams.Upstreams(), 2)
 		})
+		t.Run("should get unresolved static upstream with its run config", func(t *testing.T) {
+			jobTaskPython := job.NewTask("python", jobTaskConfig)
+			runConfig, _ := job.NewUpstreamRunConfig("", "4h", "", "h")
+			upstreamsSpecA, _ := job.NewSpecUpstreamBuilder().
+				WithUpstreamNames([]job.SpecUpstreamName{"job-C"}).
+				WithRunConfigs(map[job.SpecUpstreamName]*job.UpstreamRunConfig{"job-C": runConfig}).
+				Build()
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTaskPython).WithSpecUpstream(upstreamsSpecA).Build()
+			jobA := job.NewJob(sampleTenant, specA, jobADestination, nil)
+
+			jobWithUpstreams, err := jobA.GetJobWithUnresolvedUpstream()
+			assert.NoError(t, err)
+			assert.Len(t, jobWithUpstreams.Upstreams(), 1)
+			assert.Equal(t, "test-proj/job-C", jobWithUpstreams.Upstreams()[0].FullName())
+			assert.Equal(t, runConfig, jobWithUpstreams.Upstreams()[0].RunConfig())
+		})
 	})
 
 	t.Run("GetJobsWithUnresolvedUpstreams", func(t *testing.T) {
@@ -0,0 +1,190 @@
+package job
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
+)
+
+type PlanAction string
+
+const (
+	PlanActionAdd    PlanAction = "add"
+	PlanActionUpdate PlanAction = "update"
+	PlanActionDelete PlanAction = "delete"
+)
+
+func (p PlanAction) String() string {
+	return string(p)
+}
+
+// FieldDiff is a change of a single field of a job spec, a field which is added or removed has an empty old or new value
+type FieldDiff struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+// JobPlan is what replace-all is going to do with a job, along with the static upstreams the job is not
+// able to resolve and the downstreams which lose the job as their upstream
+type JobPlan struct {
+	Name                Name
+	Action              PlanAction
+	Diffs               []*FieldDiff
+	UnresolvedUpstreams []string
+	BrokenDownstreams   []FullName
+}
+
+// Plan is what replace-all is going to do with the jobs of a tenant, worked out without saving anything
+type Plan struct {
+	Tenant tenant.Tenant
+	Jobs   []*JobPlan
+}
+
+func NewPlan(tnnt tenant.Tenant) *Plan {
+	return &Plan{Tenant: tnnt}
+}
+
+func (p *Plan) Add(jobPlan *JobPlan) {
+	p.Jobs = append(p.Jobs, jobPlan)
+}
+
+// Sort orders the jobs of the plan by action, then by name, to keep the plan stable across runs
+func (p *Plan) Sort() {
+	actionOrder := map[PlanAction]int{PlanActionAdd: 0, PlanActionUpdate: 1, PlanActionDelete: 2} // nolint:gomnd
+	sort.Slice(p.Jobs, func(i, j int) bool {
+		if p.Jobs[i].Action != p.Jobs[j].Action {
+			return actionOrder[p.Jobs[i].Action] < actionOrder[p.Jobs[j].Action]
+		}
+		return p.Jobs[i].Name < p.Jobs[j].Name
+	})
+}
+
+// DiffSpecs returns the changed fields between two specs, sorted by field. A nil spec has no field, which makes
+// every field of the other spec reported as added or removed.
+func DiffSpecs(oldSpec, newSpec *Spec) []*FieldDiff {
+	oldFields := oldSpec.fields()
+	newFields := newSpec.fields()
+
+	var diffs []*FieldDiff
+	for field, oldValue := range oldFields {
+		if newValue := newFields[field]; newValue != oldValue {
+			diffs = append(diffs, &FieldDiff{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	for field, newValue := range newFields {
+		if _, ok := oldFields[field]; !ok && newValue != "" {
+			diffs = append(diffs, &FieldDiff{Field: field, NewValue: newValue})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Field < diffs[j].Field
+	})
+	return diffs
+}
+
+// fields flattens the spec into field paths and their values, as they are written in the job yaml
+func (s *Spec) fields() map[string]string {
+	fields := map[string]string{}
+	if s == nil {
+		return fields
+	}
+	put := func(field, value string) {
+		if value != "" {
+			fields[field] = value
+		}
+	}
+	putMap := func(prefix string, m map[string]string) {
+		for k, v := range m {
+			put(prefix+"."+k, v)
+		}
+	}
+
+	put("version", strconv.Itoa(s.version))
+	put("name", s.name.String())
+	put("owner", s.owner)
+	put("description", s.description)
+
+	if s.schedule != nil {
+		put("schedule.start_date", s.schedule.startDate.String())
+		put("schedule.end_date", s.schedule.endDate.String())
+		put("schedule.interval", s.schedule.interval)
+		put("behavior.depends_on_past", strconv.FormatBool(s.schedule.dependsOnPast))
+		if s.schedule.retry != nil {
+			put("behavior.retry.count", strconv.Itoa(s.schedule.retry.count))
+			put("behavior.retry.delay", strconv.Itoa(int(s.schedule.retry.delay)))
+			put("behavior.retry.exponential_backoff", strconv.FormatBool(s.schedule.retry.exponentialBackoff))
+		}
+	}
+	if s.window != nil {
+		put("task.window.version", strconv.Itoa(s.window.GetVersion()))
+		put("task.window.size", s.window.GetSize())
+		put("task.window.offset", s.window.GetOffset())
+		put("task.window.truncate_to", s.window.GetTruncateTo())
+		put("task.window.calendar", models.CalendarOf(s.window))
+	}
+
+	put("task.name", s.task.name.String())
+	putMap("task.config", s.task.config)
+	putMap("labels", s.labels)
+	putMap("asset", s.asset)
+
+	for _, hook := range s.hooks {
+		fields["hooks."+hook.name] = "enabled"
+		putMap("hooks."+hook.name+".config", hook.config)
+	}
+	for i, alert := range s.alertSpecs {
+		prefix := fmt.Sprintf("behavior.notify.%d", i)
+		put(prefix+".on", alert.on)
+		put(prefix+".channels", strings.Join(alert.channels, ","))
+		putMap(prefix+".config", alert.config)
+	}
+
+	if s.upstreamSpec != nil {
+		upstreamNames := make([]string, len(s.upstreamSpec.upstreamNames))
+		for i, upstreamName := range s.upstreamSpec.upstreamNames {
+			upstreamNames[i] = upstreamName.String()
+		}
+		sort.Strings(upstreamNames)
+		put("dependencies.job", strings.Join(upstreamNames, ","))
+		for upstreamName, runConfig := range s.upstreamSpec.runConfigs {
+			if runConfig == nil {
+				continue
+			}
+			prefix := "dependencies.job." + upstreamName.String()
+			put(prefix+".run_offset", runConfig.runOffset)
+			put(prefix+".window.size", runConfig.windowSize)
+			put(prefix+".window.offset", runConfig.windowOffset)
+			put(prefix+".window.truncate_to", runConfig.windowTruncateTo)
+			if runConfig.optional {
+				put(prefix+".optional", strconv.FormatBool(runConfig.optional))
+			}
+			if runConfig.maxWait > 0 {
+				put(prefix+".max_wait", runConfig.maxWait.String())
+			}
+		}
+		for _, httpUpstream := range s.upstreamSpec.httpUpstreams {
+			prefix := "dependencies.http." + httpUpstream.name
+			put(prefix+".url", httpUpstream.url)
+			putMap(prefix+".headers", httpUpstream.headers)
+			putMap(prefix+".params", httpUpstream.params)
+		}
+	}
+
+	if s.metadata != nil {
+		if s.metadata.resource != nil {
+			for kind, config := range map[string]*MetadataResourceConfig{"request": s.metadata.resource.request, "limit": s.metadata.resource.limit} {
+				if config != nil {
+					put("metadata.resource."+kind+".cpu", config.cpu)
+					put("metadata.resource."+kind+".memory", config.memory)
+				}
+			}
+		}
+		putMap("metadata.airflow", s.metadata.scheduler)
+	}
+	return fields
+}
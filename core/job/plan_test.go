@@ -0,0 +1,77 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestPlan(t *testing.T) {
+	startDate, _ := job.ScheduleDateFrom("2022-10-01")
+	jobSchedule, _ := job.NewScheduleBuilder(startDate).WithInterval("0 2 * * *").Build()
+	jobWindow, _ := models.NewWindow(1, "d", "24h", "24h")
+	jobTaskConfig, _ := job.ConfigFrom(map[string]string{"table": "table_a"})
+	jobTask := job.NewTask("bq2bq", jobTaskConfig)
+
+	t.Run("DiffSpecs", func(t *testing.T) {
+		t.Run("returns no diff for equal specs", func(t *testing.T) {
+			spec, _ := job.NewSpecBuilder(1, "job-A", "owner", jobSchedule, jobWindow, jobTask).Build()
+			otherSpec, _ := job.NewSpecBuilder(1, "job-A", "owner", jobSchedule, jobWindow, jobTask).Build()
+
+			assert.Empty(t, job.DiffSpecs(spec, otherSpec))
+		})
+		t.Run("returns every field as added when there is no old spec", func(t *testing.T) {
+			spec, _ := job.NewSpecBuilder(1, "job-A", "owner", jobSchedule, jobWindow, jobTask).Build()
+
+			diffs := job.DiffSpecs(nil, spec)
+			assert.Contains(t, diffs, &job.FieldDiff{Field: "owner", NewValue: "owner"})
+			assert.Contains(t, diffs, &job.FieldDiff{Field: "task.config.table", NewValue: "table_a"})
+			for _, diff := range diffs {
+				assert.Empty(t, diff.OldValue)
+			}
+		})
+		t.Run("returns the changed, added and removed fields sorted by field", func(t *testing.T) {
+			oldLabels := map[string]string{"team": "data", "tier": "1"}
+			oldSpec, _ := job.NewSpecBuilder(1, "job-A", "owner", jobSchedule, jobWindow, jobTask).WithLabels(oldLabels).Build()
+
+			newTaskConfig, _ := job.ConfigFrom(map[string]string{"table": "table_b"})
+			newSchedule, _ := job.NewScheduleBuilder(startDate).WithInterval("0 3 * * *").Build()
+			newLabels := map[string]string{"team": "data", "cost": "low"}
+			newSpec, _ := job.NewSpecBuilder(1, "job-A", "owner", newSchedule, jobWindow, job.NewTask("bq2bq", newTaskConfig)).
+				WithLabels(newLabels).
+				WithDescription("sample job").
+				Build()
+
+			diffs := job.DiffSpecs(oldSpec, newSpec)
+			assert.Equal(t, []*job.FieldDiff{
+				{Field: "description", NewValue: "sample job"},
+				{Field: "labels.cost", NewValue: "low"},
+				{Field: "labels.tier", OldValue: "1"},
+				{Field: "schedule.interval", OldValue: "0 2 * * *", NewValue: "0 3 * * *"},
+				{Field: "task.config.table", OldValue: "table_a", NewValue: "table_b"},
+			}, diffs)
+		})
+	})
+	t.Run("Sort", func(t *testing.T) {
+		t.Run("orders jobs by action then by name", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant("test-proj", "test-ns")
+			plan := job.NewPlan(tnnt)
+			plan.Add(&job.JobPlan{Name: "job-C", Action: job.PlanActionDelete})
+			plan.Add(&job.JobPlan{Name: "job-B", Action: job.PlanActionAdd})
+			plan.Add(&job.JobPlan{Name: "job-D", Action: job.PlanActionUpdate})
+			plan.Add(&job.JobPlan{Name: "job-A", Action: job.PlanActionAdd})
+
+			plan.Sort()
+
+			var names []job.Name
+			for _, jobPlan := range plan.Jobs {
+				names = append(names, jobPlan.Name)
+			}
+			assert.Equal(t, []job.Name{"job-A", "job-B", "job-D", "job-C"}, names)
+		})
+	})
+}
@@ -53,7 +53,9 @@ func (e *extUpstreamResolver) Resolve(ctx context.Context, jobWithUpstream *job.
 			me.Append(err)
 			continue
 		}
-		mergedUpstreams = append(mergedUpstreams, externalUpstream...)
+		for _, upstream := range externalUpstream {
+			mergedUpstreams = append(mergedUpstreams, upstream.WithRunConfig(unresolvedUpstream.RunConfig()))
+		}
 		resolvedExternally = true
 	}
 	if len(me.Errors) > 0 {
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/robfig/cron/v3"
+
+	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+const jobEnablerChangedBy = "optimus"
+
+type JobsToEnableGetter interface {
+	GetJobsToEnable(ctx context.Context, until time.Time) ([]*job.DisabledJob, error)
+}
+
+type JobStateUpdater interface {
+	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error
+}
+
+// JobEnabler periodically enables back the jobs which were disabled until a time, through the state updater
+// so the scheduler is updated and the job state change events are raised as on a manual change
+type JobEnabler struct {
+	l log.Logger
+
+	jobRepo      JobsToEnableGetter
+	stateUpdater JobStateUpdater
+
+	schedule *cron.Cron
+	interval time.Duration
+}
+
+func NewJobEnabler(l log.Logger, jobRepo JobsToEnableGetter, stateUpdater JobStateUpdater, interval time.Duration) *JobEnabler {
+	return &JobEnabler{
+		l:            l,
+		jobRepo:      jobRepo,
+		stateUpdater: stateUpdater,
+		interval:     interval,
+		schedule: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
+
+func (e *JobEnabler) Initialize() {
+	if e.interval <= 0 {
+		return
+	}
+	e.schedule.Schedule(cron.Every(e.interval), cron.FuncJob(func() {
+		e.Check(context.Background(), time.Now())
+	}))
+	e.schedule.Start()
+}
+
+func (e *JobEnabler) Close() {
+	if e.interval > 0 {
+		e.schedule.Stop()
+	}
+}
+
+// Check enables back the jobs whose enable time has passed as of the given time
+func (e *JobEnabler) Check(ctx context.Context, now time.Time) {
+	disabledJobs, err := e.jobRepo.GetJobsToEnable(ctx, now)
+	if err != nil {
+		e.l.Error("error getting jobs to enable: %s", err)
+		return
+	}
+
+	for _, disabledJob := range disabledJobs {
+		remark := "enabled back as it was disabled until " + disabledJob.EnableAt.UTC().Format(time.RFC3339)
+		change, err := job.NewStateChange(job.ENABLED, remark, jobEnablerChangedBy, nil)
+		if err != nil {
+			e.l.Error("error creating state change to enable job [%s]: %s", disabledJob.Name, err)
+			continue
+		}
+		if err := e.stateUpdater.UpdateState(ctx, disabledJob.Tenant, []job.Name{disabledJob.Name}, change); err != nil {
+			e.l.Error("error enabling job [%s] of project [%s]: %s", disabledJob.Name, disabledJob.Tenant.ProjectName(), err)
+			continue
+		}
+		e.l.Info("enabled job [%s] of project [%s] disabled until [%s]", disabledJob.Name, disabledJob.Tenant.ProjectName(), disabledJob.EnableAt)
+	}
+}
@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/core/job/service"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestJobEnabler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("test-proj", "test-ns")
+	now := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	enableAt := now.Add(-time.Minute)
+
+	disabledJobA := &job.DisabledJob{Name: "job-A", Tenant: tnnt, Remark: "maintenance", EnableAt: &enableAt}
+	disabledJobB := &job.DisabledJob{Name: "job-B", Tenant: tnnt, Remark: "maintenance", EnableAt: &enableAt}
+	enableChange, _ := job.NewStateChange(job.ENABLED, "enabled back as it was disabled until 2023-01-01T23:59:00Z", "optimus", nil)
+
+	t.Run("Check", func(t *testing.T) {
+		t.Run("does nothing when unable to get jobs to enable", func(t *testing.T) {
+			jobRepo := new(mockJobsToEnableGetter)
+			jobRepo.On("GetJobsToEnable", ctx, now).Return(nil, fmt.Errorf("some error"))
+			defer jobRepo.AssertExpectations(t)
+
+			stateUpdater := new(mockJobStateUpdater)
+			defer stateUpdater.AssertExpectations(t)
+
+			enabler := service.NewJobEnabler(logger, jobRepo, stateUpdater, 0)
+			enabler.Check(ctx, now)
+		})
+		t.Run("enables every job whose enable time has passed", func(t *testing.T) {
+			jobRepo := new(mockJobsToEnableGetter)
+			jobRepo.On("GetJobsToEnable", ctx, now).Return([]*job.DisabledJob{disabledJobA, disabledJobB}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			stateUpdater := new(mockJobStateUpdater)
+			stateUpdater.On("UpdateState", ctx, tnnt, []job.Name{"job-A"}, enableChange).Return(fmt.Errorf("some error"))
+			stateUpdater.On("UpdateState", ctx, tnnt, []job.Name{"job-B"}, enableChange).Return(nil)
+			defer stateUpdater.AssertExpectations(t)
+
+			enabler := service.NewJobEnabler(logger, jobRepo, stateUpdater, 0)
+			enabler.Check(ctx, now)
+		})
+	})
+}
+
+type mockJobsToEnableGetter struct {
+	mock.Mock
+}
+
+func (m *mockJobsToEnableGetter) GetJobsToEnable(ctx context.Context, until time.Time) ([]*job.DisabledJob, error) {
+	args := m.Called(ctx, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*job.DisabledJob), args.Error(1)
+}
+
+type mockJobStateUpdater struct {
+	mock.Mock
+}
+
+func (m *mockJobStateUpdater) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error {
+	args := m.Called(ctx, jobTenant, jobNames, change)
+	return args.Error(0)
+}
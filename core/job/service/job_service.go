@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/kushsharma/parallel"
 	"github.com/raystack/salt/log"
@@ -16,6 +17,7 @@ import (
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/lib/tree"
+	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/internal/telemetry"
 	"github.com/raystack/optimus/internal/writer"
 	"github.com/raystack/optimus/sdk/plugin"
@@ -71,6 +73,7 @@ type PluginService interface {
 
 type TenantDetailsGetter interface {
 	GetDetails(ctx context.Context, jobTenant tenant.Tenant) (*tenant.WithDetails, error)
+	GetProject(ctx context.Context, name tenant.ProjectName) (*tenant.Project, error)
 }
 
 type JobDeploymentService interface {
@@ -79,8 +82,8 @@ type JobDeploymentService interface {
 
 type JobRepository interface {
 	// TODO: remove `savedJobs` since the method's main purpose is to add, not to get
-	Add(context.Context, []*job.Job) (addedJobs []*job.Job, err error)
-	Update(context.Context, []*job.Job) (updatedJobs []*job.Job, err error)
+	Add(context.Context, []*job.Job, *job.SpecChange) (addedJobs []*job.Job, err error)
+	Update(context.Context, []*job.Job, *job.SpecChange) (updatedJobs []*job.Job, err error)
 	Delete(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, cleanHistory bool) error
 
 	ChangeJobNamespace(ctx context.Context, jobName job.Name, tenant, newTenant tenant.Tenant) error
@@ -90,7 +93,15 @@ type JobRepository interface {
 	GetAllByTenant(ctx context.Context, jobTenant tenant.Tenant) ([]*job.Job, error)
 	GetAllByProjectName(ctx context.Context, projectName tenant.ProjectName) ([]*job.Job, error)
 	SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error
-	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error
+	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error
+	GetDisabledJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName string) ([]*job.DisabledJob, error)
+
+	GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error)
+	GetSpecVersion(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, version int) (*job.SpecVersion, error)
+
+	SaveTemplateLibrary(ctx context.Context, library *job.TemplateLibrary) (*job.TemplateLibrary, error)
+	GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName string) (job.TemplateLibraries, error)
+	GetTemplateLibraryVersions(ctx context.Context, projectName tenant.ProjectName, namespaceName, name string) (job.TemplateLibraries, error)
 }
 
 type UpstreamRepository interface {
@@ -118,7 +129,7 @@ type Scheduler interface {
 	UpdateJobState(ctx context.Context, tnnt tenant.Tenant, jobName []job.Name, state string) error
 }
 
-func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) error {
+func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec, change *job.SpecChange) error {
 	logWriter := writer.NewLogWriter(j.logger)
 	me := errors.NewMultiError("add specs errors")
 
@@ -128,10 +139,10 @@ func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*
 		return err
 	}
 
-	jobs, err := j.generateJobs(ctx, tenantWithDetails, specs, logWriter)
+	jobs, err := j.generateJobs(ctx, tenantWithDetails, specs, true, logWriter)
 	me.Append(err)
 
-	addedJobs, err := j.jobRepo.Add(ctx, jobs)
+	addedJobs, err := j.jobRepo.Add(ctx, jobs, change)
 	me.Append(err)
 
 	jobsWithUpstreams, err := j.upstreamResolver.BulkResolve(ctx, jobTenant.ProjectName(), addedJobs, logWriter)
@@ -156,7 +167,7 @@ func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*
 	return me.ToErr()
 }
 
-func (j *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) error {
+func (j *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec, change *job.SpecChange) error {
 	logWriter := writer.NewLogWriter(j.logger)
 	me := errors.NewMultiError("update specs errors")
 
@@ -166,10 +177,10 @@ func (j *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, specs
 		return err
 	}
 
-	jobs, err := j.generateJobs(ctx, tenantWithDetails, specs, logWriter)
+	jobs, err := j.generateJobs(ctx, tenantWithDetails, specs, true, logWriter)
 	me.Append(err)
 
-	updatedJobs, err := j.jobRepo.Update(ctx, jobs)
+	updatedJobs, err := j.jobRepo.Update(ctx, jobs, change)
 	me.Append(err)
 
 	jobsWithUpstreams, err := j.upstreamResolver.BulkResolve(ctx, jobTenant.ProjectName(), updatedJobs, logWriter)
@@ -194,18 +205,94 @@ func (j *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, specs
 	return me.ToErr()
 }
 
-func (j *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error {
-	err := j.scheduler.UpdateJobState(ctx, jobTenant, jobNames, jobState.String())
+// GetSpecHistory returns every version of the job spec, latest first
+func (j *JobService) GetSpecHistory(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	return j.jobRepo.GetSpecVersions(ctx, projectName, jobName)
+}
+
+// GetCalendar returns the calendar declared in the project config
+func (j *JobService) GetCalendar(ctx context.Context, projectName tenant.ProjectName, name string) (*models.Calendar, error) {
+	project, err := j.tenantDetailsGetter.GetProject(ctx, projectName)
+	if err != nil {
+		j.logger.Error("error getting project [%s]: %s", projectName, err)
+		return nil, err
+	}
+	calendar, err := models.CalendarFromConfig(name, project.GetConfigs())
+	if err != nil {
+		j.logger.Error("error getting calendar [%s] of project [%s]: %s", name, projectName, err)
+		return nil, errors.InvalidArgument(job.EntityJob, err.Error())
+	}
+	return calendar, nil
+}
+
+// RegisterTemplateLibrary saves the library as its next version, and returns the jobs with assets referring to it
+func (j *JobService) RegisterTemplateLibrary(ctx context.Context, library *job.TemplateLibrary) (*job.TemplateLibrary, []job.FullName, error) {
+	if library.NamespaceName != "" {
+		libraryTenant, err := tenant.NewTenant(library.ProjectName.String(), library.NamespaceName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := j.tenantDetailsGetter.GetDetails(ctx, libraryTenant); err != nil {
+			j.logger.Error("error getting tenant details of template library [%s]: %s", library.Name, err)
+			return nil, nil, err
+		}
+	} else if _, err := j.tenantDetailsGetter.GetProject(ctx, library.ProjectName); err != nil {
+		j.logger.Error("error getting project [%s]: %s", library.ProjectName, err)
+		return nil, nil, err
+	}
+
+	savedLibrary, err := j.jobRepo.SaveTemplateLibrary(ctx, library)
 	if err != nil {
+		j.logger.Error("error saving template library [%s]: %s", library.Name, err)
+		return nil, nil, err
+	}
+
+	jobs, err := j.jobRepo.GetAllByProjectName(ctx, library.ProjectName)
+	if err != nil {
+		j.logger.Error("error getting jobs of project [%s]: %s", library.ProjectName, err)
+		return nil, nil, err
+	}
+	var affectedJobs []job.FullName
+	for _, jobWithLibrary := range jobs {
+		if savedLibrary.IsUsedBy(jobWithLibrary) {
+			affectedJobs = append(affectedJobs, job.FullNameFrom(jobWithLibrary.ProjectName(), jobWithLibrary.Spec().Name()))
+		}
+	}
+	return savedLibrary, affectedJobs, nil
+}
+
+// GetTemplateLibraries returns every version of the library when name is provided, otherwise the latest
+// version of the libraries of the project along with the ones of the namespace
+func (j *JobService) GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName, name string) (job.TemplateLibraries, error) {
+	if name != "" {
+		return j.jobRepo.GetTemplateLibraryVersions(ctx, projectName, namespaceName, name)
+	}
+	return j.jobRepo.GetTemplateLibraries(ctx, projectName, namespaceName)
+}
+
+// Rollback re-applies the spec of the given version of the job as an update
+func (j *JobService) Rollback(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, version int, changedBy string) error {
+	specVersion, err := j.jobRepo.GetSpecVersion(ctx, jobTenant.ProjectName(), jobName, version)
+	if err != nil {
+		j.logger.Error("error getting version [%d] of job [%s]: %s", version, jobName, err)
 		return err
 	}
 
-	if err := j.jobRepo.UpdateState(ctx, jobTenant, jobNames, jobState, remark); err != nil {
+	return j.Update(ctx, jobTenant, []*job.Spec{specVersion.Job.Spec()}, job.NewSpecChange(changedBy, job.ChangeSourceRollback))
+}
+
+func (j *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error {
+	err := j.scheduler.UpdateJobState(ctx, jobTenant, jobNames, change.State.String())
+	if err != nil {
+		return err
+	}
+
+	if err := j.jobRepo.UpdateState(ctx, jobTenant, jobNames, change); err != nil {
 		return err
 	}
 
 	var metricName string
-	switch jobState {
+	switch change.State {
 	case job.ENABLED:
 		metricName = job.MetricJobEventEnabled
 	case job.DISABLED:
@@ -214,11 +301,15 @@ func (j *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, j
 
 	raiseJobEventMetric(jobTenant, metricName, len(jobNames))
 	for _, jobName := range jobNames {
-		j.raiseStateChangeEvent(jobTenant, jobName, jobState)
+		j.raiseStateChangeEvent(jobTenant, jobName, change.State)
 	}
 	return nil
 }
 
+func (j *JobService) GetDisabledJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName string) ([]*job.DisabledJob, error) {
+	return j.jobRepo.GetDisabledJobs(ctx, projectName, namespaceName)
+}
+
 func (j *JobService) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	return j.jobRepo.SyncState(ctx, jobTenant, disabledJobNames, enabledJobNames)
 }
@@ -405,7 +496,7 @@ func (j *JobService) GetByFilter(ctx context.Context, filters ...filter.FilterOp
 	return nil, fmt.Errorf("no filter matched")
 }
 
-func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) error {
+func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec, jobNamesWithInvalidSpec []job.Name, change *job.SpecChange, logWriter writer.LogWriter) error {
 	me := errors.NewMultiError("replace all specs errors")
 
 	existingJobs, err := j.jobRepo.GetAllByTenant(ctx, jobTenant)
@@ -422,11 +513,11 @@ func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, sp
 		return me.ToErr()
 	}
 
-	addedJobs, err := j.bulkAdd(ctx, tenantWithDetails, toAdd, logWriter)
+	addedJobs, err := j.bulkAdd(ctx, tenantWithDetails, toAdd, change, logWriter)
 	me.Append(err)
 	failedToAdd := len(toAdd) - len(addedJobs)
 
-	updatedJobs, err := j.bulkUpdate(ctx, tenantWithDetails, toUpdate, logWriter)
+	updatedJobs, err := j.bulkUpdate(ctx, tenantWithDetails, toUpdate, change, logWriter)
 	me.Append(err)
 	failedToUpdate := len(toUpdate) - len(updatedJobs)
 
@@ -491,7 +582,7 @@ func (j *JobService) Refresh(ctx context.Context, projectName tenant.ProjectName
 		}
 
 		specs := job.Jobs(jobs).GetSpecs()
-		updatedJobs, err := j.bulkUpdate(ctx, tenantWithDetails, specs, logWriter)
+		updatedJobs, err := j.bulkUpdate(ctx, tenantWithDetails, specs, job.NewSpecChange("", job.ChangeSourceRefresh), logWriter)
 		me.Append(err)
 
 		j.logger.Debug("resolving upstreams for [%d] jobs of project [%s] namespace [%s]", len(updatedJobs), projectName, namespaceName)
@@ -563,12 +654,158 @@ func (j *JobService) Validate(ctx context.Context, jobTenant tenant.Tenant, jobS
 	logWriter.Write(writer.LogLevelInfo, fmt.Sprintf("[%s] found %d new, %d modified, and %d deleted job specs", jobTenant.NamespaceName().String(), len(toAdd), len(toUpdate), len(toDelete)))
 	me.Append(err)
 
-	incomingJobs, err := j.generateJobs(ctx, tenantWithDetails, append(toAdd, toUpdate...), logWriter)
+	incomingJobs, err := j.generateJobs(ctx, tenantWithDetails, append(toAdd, toUpdate...), true, logWriter)
 	me.Append(err)
 
 	err = j.validateDeleteJobs(ctx, jobTenant, toDelete, logWriter)
 	me.Append(err)
 
+	err = j.validateCyclicDependencies(incomingJobs, existingJobs, unmodifiedSpecs)
+	me.Append(err)
+
+	// the windows are compared once the jobs are valid, as their upstreams are resolved to do so
+	if me.ToErr() == nil {
+		err = j.validateWindowCoverage(ctx, jobTenant, incomingJobs, logWriter)
+		me.Append(err)
+	}
+
+	return me.ToErr()
+}
+
+// Plan works out what ReplaceAll would do with the specs without saving anything: the jobs to add, update and delete,
+// the changed fields of their specs, the static upstreams they are not able to resolve and the downstreams they break
+func (j *JobService) Plan(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) (*job.Plan, error) {
+	me := errors.NewMultiError("plan specs errors")
+
+	tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
+	if err != nil {
+		j.logger.Error("error getting tenant details: %s", err)
+		return nil, err
+	}
+
+	err = job.Specs(specs).Validate()
+	me.Append(err)
+	validatedSpecs := job.Specs(specs).GetValid()
+
+	existingJobs, err := j.jobRepo.GetAllByTenant(ctx, jobTenant)
+	me.Append(err)
+
+	toAdd, toUpdate, toDelete, unmodifiedSpecs, err := j.differentiateSpecs(existingJobs, validatedSpecs, jobNamesWithInvalidSpec)
+	logWriter.Write(writer.LogLevelInfo, fmt.Sprintf("[%s] plan: %d to add, %d to update, and %d to delete", jobTenant.NamespaceName().String(), len(toAdd), len(toUpdate), len(toDelete)))
+	me.Append(err)
+
+	incomingJobs, err := j.generateJobs(ctx, tenantWithDetails, append(toAdd, toUpdate...), true, logWriter)
+	me.Append(err)
+
+	err = j.validateCyclicDependencies(incomingJobs, existingJobs, unmodifiedSpecs)
+	me.Append(err)
+
+	unresolvedUpstreams, err := j.getUnresolvedStaticUpstreams(ctx, jobTenant, incomingJobs, validatedSpecs)
+	me.Append(err)
+
+	// destinations which are still produced by a job in the tenant once the plan is applied
+	producedDestinations := map[job.ResourceURN]bool{}
+	for _, incomingJob := range incomingJobs {
+		producedDestinations[incomingJob.Destination()] = true
+	}
+	existingJobMap := job.Jobs(existingJobs).GetNameAndJobMap()
+	for _, unmodifiedSpec := range unmodifiedSpecs {
+		if existingJob, ok := existingJobMap[unmodifiedSpec.Name()]; ok {
+			producedDestinations[existingJob.Destination()] = true
+		}
+	}
+
+	plan := job.NewPlan(jobTenant)
+	for _, incomingJob := range incomingJobs {
+		jobPlan := &job.JobPlan{
+			Name:                incomingJob.Spec().Name(),
+			Action:              job.PlanActionAdd,
+			UnresolvedUpstreams: unresolvedUpstreams[incomingJob.Spec().Name()],
+		}
+		existingJob, ok := existingJobMap[incomingJob.Spec().Name()]
+		if !ok {
+			jobPlan.Diffs = job.DiffSpecs(nil, incomingJob.Spec())
+			plan.Add(jobPlan)
+			continue
+		}
+
+		jobPlan.Action = job.PlanActionUpdate
+		jobPlan.Diffs = job.DiffSpecs(existingJob.Spec(), incomingJob.Spec())
+		if existingJob.Destination() != incomingJob.Destination() && !producedDestinations[existingJob.Destination()] {
+			jobPlan.BrokenDownstreams, err = j.getDownstreamsOfDestination(ctx, jobTenant.ProjectName(), existingJob)
+			me.Append(err)
+		}
+		plan.Add(jobPlan)
+	}
+
+	toDeleteMap := job.Specs(toDelete).ToFullNameAndSpecMap(jobTenant.ProjectName())
+	for _, specToDelete := range toDelete {
+		downstreams, err := j.downstreamRepo.GetDownstreamByJobName(ctx, jobTenant.ProjectName(), specToDelete.Name())
+		if err != nil {
+			j.logger.Error("error getting downstreams for job [%s]: %s", specToDelete.Name(), err)
+			me.Append(err)
+		}
+		notDeleted, _ := isJobSafeToDelete(toDeleteMap, job.DownstreamList(downstreams).GetDownstreamFullNames())
+		plan.Add(&job.JobPlan{
+			Name:              specToDelete.Name(),
+			Action:            job.PlanActionDelete,
+			BrokenDownstreams: notDeleted,
+		})
+	}
+	plan.Sort()
+
+	return plan, me.ToErr()
+}
+
+// getUnresolvedStaticUpstreams resolves the upstreams of the jobs without saving them, and returns the static upstreams
+// which are not found per job. Upstreams to jobs in the incoming specs are taken as resolved, as they are saved together.
+func (j *JobService) getUnresolvedStaticUpstreams(ctx context.Context, jobTenant tenant.Tenant, jobs []*job.Job, incomingSpecs []*job.Spec) (map[job.Name][]string, error) {
+	unresolvedUpstreams := map[job.Name][]string{}
+	if len(jobs) == 0 {
+		return unresolvedUpstreams, nil
+	}
+
+	// unresolved static upstreams are reported in the plan, the logs of the resolution are not needed
+	jobsWithUpstreams, err := j.upstreamResolver.BulkResolve(ctx, jobTenant.ProjectName(), jobs, &writer.BufferedLogger{})
+	if jobsWithUpstreams == nil && err != nil {
+		j.logger.Error("error resolving upstreams of project [%s] namespace [%s]: %s", jobTenant.ProjectName(), jobTenant.NamespaceName(), err)
+		return unresolvedUpstreams, err
+	}
+
+	incomingSpecsMap := job.Specs(incomingSpecs).ToNameAndSpecMap()
+	for _, jobWithUpstreams := range jobsWithUpstreams {
+		for _, upstream := range jobWithUpstreams.GetUnresolvedUpstreams() {
+			if upstream.Type() != job.UpstreamTypeStatic {
+				continue
+			}
+			if _, ok := incomingSpecsMap[upstream.Name()]; ok && upstream.ProjectName() == jobTenant.ProjectName() {
+				continue
+			}
+			unresolvedUpstreams[jobWithUpstreams.Name()] = append(unresolvedUpstreams[jobWithUpstreams.Name()], upstream.FullName())
+		}
+	}
+	return unresolvedUpstreams, nil
+}
+
+func (j *JobService) getDownstreamsOfDestination(ctx context.Context, projectName tenant.ProjectName, subjectJob *job.Job) ([]job.FullName, error) {
+	downstreams, err := j.downstreamRepo.GetDownstreamByDestination(ctx, projectName, subjectJob.Destination())
+	if err != nil {
+		j.logger.Error("error getting downstreams of destination [%s]: %s", subjectJob.Destination(), err)
+		return nil, err
+	}
+
+	var downstreamFullNames []job.FullName
+	for _, downstream := range downstreams {
+		if downstream.Name() == subjectJob.Spec().Name() && downstream.ProjectName() == projectName {
+			continue
+		}
+		downstreamFullNames = append(downstreamFullNames, downstream.FullName())
+	}
+	return downstreamFullNames, nil
+}
+
+// validateCyclicDependencies checks the jobs to save along with the rest of the jobs of the tenant do not depend on each other in a cycle
+func (j *JobService) validateCyclicDependencies(incomingJobs, existingJobs []*job.Job, unmodifiedSpecs []*job.Spec) error {
 	// NOTE: only check cyclic deps across internal upstreams (sources), need further discussion to check cyclic deps for external upstream
 	// assumption, all job specs from input are also the job within same project
 	jobsToValidateMap := getAllJobsToValidateMap(incomingJobs, existingJobs, unmodifiedSpecs)
@@ -576,14 +813,67 @@ func (j *JobService) Validate(ctx context.Context, jobTenant tenant.Tenant, jobS
 	for _, jobEntity := range jobsToValidateMap {
 		if _, err := j.validateCyclic(jobEntity.Job().Spec().Name(), jobsToValidateMap, identifierToJobsMap); err != nil {
 			j.logger.Error("error when executing cyclic validation on [%s]: %s", jobEntity.Job().Spec().Name(), err)
-			me.Append(err)
-			break
+			return err
 		}
 	}
+	return nil
+}
 
+// validateWindowCoverage warns about the jobs whose input window is not fully produced by the runs of their resolved
+// upstreams, by simulating their schedule times. Upstreams of other servers are not checked, their windows are unknown.
+func (j *JobService) validateWindowCoverage(ctx context.Context, jobTenant tenant.Tenant, incomingJobs []*job.Job, logWriter writer.LogWriter) error {
+	if len(incomingJobs) == 0 {
+		return nil
+	}
+
+	// unresolved upstreams are not compared, the logs of the resolution are not needed
+	jobsWithUpstreams, err := j.upstreamResolver.BulkResolve(ctx, jobTenant.ProjectName(), incomingJobs, &writer.BufferedLogger{})
+	if jobsWithUpstreams == nil && err != nil {
+		j.logger.Error("error resolving upstreams of project [%s] namespace [%s]: %s", jobTenant.ProjectName(), jobTenant.NamespaceName(), err)
+		return err
+	}
+
+	me := errors.NewMultiError("validate window coverage errors")
+	incomingJobsMap := job.Jobs(incomingJobs).GetNameAndJobMap()
+	for _, jobWithUpstreams := range jobsWithUpstreams {
+		for _, upstream := range jobWithUpstreams.GetResolvedUpstreams() {
+			if upstream.External() {
+				continue
+			}
+			upstreamJob, ok := incomingJobsMap[upstream.Name()]
+			if !ok || upstream.ProjectName() != jobTenant.ProjectName() {
+				upstreamJob, err = j.jobRepo.GetByJobName(ctx, upstream.ProjectName(), upstream.Name())
+				if err != nil {
+					j.logger.Error("error getting upstream job [%s]: %s", upstream.FullName(), err)
+					me.Append(err)
+					continue
+				}
+			}
+
+			gaps, err := job.CheckWindowCoverage(jobWithUpstreams.Job().Spec(), upstreamJob.Spec(), upstream.RunConfig())
+			if err != nil {
+				logWriter.Write(writer.LogLevelWarning, fmt.Sprintf("[%s] unable to check window coverage of job %s by upstream %s: %s", jobTenant.NamespaceName().String(), jobWithUpstreams.Name().String(), upstream.FullName(), err.Error()))
+				continue
+			}
+			if len(gaps) == 0 {
+				continue
+			}
+			logWriter.Write(writer.LogLevelWarning, fmt.Sprintf("[%s] input window of job %s is not fully produced by upstream %s: %s", jobTenant.NamespaceName().String(), jobWithUpstreams.Name().String(), upstream.FullName(), windowCoverageGapsToString(gaps)))
+		}
+	}
 	return me.ToErr()
 }
 
+func windowCoverageGapsToString(gaps []*job.WindowCoverageGap) string {
+	scheduledAt := map[time.Time]bool{}
+	for _, gap := range gaps {
+		scheduledAt[gap.ScheduledAt] = true
+	}
+	firstGap := gaps[0]
+	return fmt.Sprintf("%d of the simulated runs miss data, eg. [%s, %s) for the run scheduled at %s", len(scheduledAt),
+		firstGap.StartTime.Format(time.RFC3339), firstGap.EndTime.Format(time.RFC3339), firstGap.ScheduledAt.Format(time.RFC3339))
+}
+
 func (j *JobService) validateDeleteJobs(ctx context.Context, jobTenant tenant.Tenant, toDelete []*job.Spec, logWriter writer.LogWriter) error {
 	me := errors.NewMultiError("delete job specs check errors")
 	toDeleteMap := job.Specs(toDelete).ToFullNameAndSpecMap(jobTenant.ProjectName())
@@ -717,10 +1007,10 @@ func (j *JobService) resolveAndSaveUpstreams(ctx context.Context, jobTenant tena
 	return me.ToErr()
 }
 
-func (j *JobService) bulkAdd(ctx context.Context, tenantWithDetails *tenant.WithDetails, specsToAdd []*job.Spec, logWriter writer.LogWriter) ([]*job.Job, error) {
+func (j *JobService) bulkAdd(ctx context.Context, tenantWithDetails *tenant.WithDetails, specsToAdd []*job.Spec, change *job.SpecChange, logWriter writer.LogWriter) ([]*job.Job, error) {
 	me := errors.NewMultiError("bulk add specs errors")
 
-	jobsToAdd, err := j.generateJobs(ctx, tenantWithDetails, specsToAdd, logWriter)
+	jobsToAdd, err := j.generateJobs(ctx, tenantWithDetails, specsToAdd, true, logWriter)
 	me.Append(err)
 
 	if len(jobsToAdd) == 0 {
@@ -729,7 +1019,7 @@ func (j *JobService) bulkAdd(ctx context.Context, tenantWithDetails *tenant.With
 	}
 
 	// TODO: consider do add inside parallel
-	addedJobs, err := j.jobRepo.Add(ctx, jobsToAdd)
+	addedJobs, err := j.jobRepo.Add(ctx, jobsToAdd, change)
 	if err != nil {
 		j.logger.Error("error adding jobs for namespace [%s]: %s", tenantWithDetails.Namespace().Name(), err)
 		logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] add jobs failure found: %s", tenantWithDetails.Namespace().Name().String(), err.Error()))
@@ -747,10 +1037,12 @@ func (j *JobService) bulkAdd(ctx context.Context, tenantWithDetails *tenant.With
 	return addedJobs, me.ToErr()
 }
 
-func (j *JobService) bulkUpdate(ctx context.Context, tenantWithDetails *tenant.WithDetails, specsToUpdate []*job.Spec, logWriter writer.LogWriter) ([]*job.Job, error) {
+func (j *JobService) bulkUpdate(ctx context.Context, tenantWithDetails *tenant.WithDetails, specsToUpdate []*job.Spec, change *job.SpecChange, logWriter writer.LogWriter) ([]*job.Job, error) {
 	me := errors.NewMultiError("bulk update specs errors")
 
-	jobsToUpdate, err := j.generateJobs(ctx, tenantWithDetails, specsToUpdate, logWriter)
+	// the refreshed jobs are already accepted, they might use pools created outside optimus before the pools were declared
+	validatePools := change.Source != job.ChangeSourceRefresh
+	jobsToUpdate, err := j.generateJobs(ctx, tenantWithDetails, specsToUpdate, validatePools, logWriter)
 	me.Append(err)
 
 	if len(jobsToUpdate) == 0 {
@@ -758,7 +1050,7 @@ func (j *JobService) bulkUpdate(ctx context.Context, tenantWithDetails *tenant.W
 		return nil, me.ToErr()
 	}
 
-	updatedJobs, err := j.jobRepo.Update(ctx, jobsToUpdate)
+	updatedJobs, err := j.jobRepo.Update(ctx, jobsToUpdate, change)
 	if err != nil {
 		j.logger.Error("error updating jobs for namespace [%s]: %s", tenantWithDetails.Namespace().Name(), err)
 		logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] update jobs failure found: %s", tenantWithDetails.Namespace().Name().String(), err.Error()))
@@ -876,14 +1168,14 @@ func (*JobService) differentiateSpecs(existingJobs []*job.Job, specs []*job.Spec
 	return addedSpecs, modifiedSpecs, deletedSpecs, unmodifiedSpecs, me.ToErr()
 }
 
-func (j *JobService) generateJobs(ctx context.Context, tenantWithDetails *tenant.WithDetails, specs []*job.Spec, logWriter writer.LogWriter) ([]*job.Job, error) {
+func (j *JobService) generateJobs(ctx context.Context, tenantWithDetails *tenant.WithDetails, specs []*job.Spec, validatePools bool, logWriter writer.LogWriter) ([]*job.Job, error) {
 	me := errors.NewMultiError("bulk generate jobs errors")
 
 	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
 	for _, spec := range specs {
 		runner.Add(func(currentSpec *job.Spec, lw writer.LogWriter) func() (interface{}, error) {
 			return func() (interface{}, error) {
-				generatedJob, err := j.generateJob(ctx, tenantWithDetails, currentSpec)
+				generatedJob, err := j.generateJob(ctx, tenantWithDetails, currentSpec, validatePools)
 				if err != nil {
 					j.logger.Error("error generating job [%s]: %s", currentSpec.Name(), err)
 					lw.Write(writer.LogLevelError, fmt.Sprintf("[%s] unable to generate job %s: %s", tenantWithDetails.Namespace().Name().String(), currentSpec.Name().String(), err.Error()))
@@ -907,7 +1199,20 @@ func (j *JobService) generateJobs(ctx context.Context, tenantWithDetails *tenant
 	return generatedJobs, me.ToErr()
 }
 
-func (j *JobService) generateJob(ctx context.Context, tenantWithDetails *tenant.WithDetails, spec *job.Spec) (*job.Job, error) {
+func (j *JobService) generateJob(ctx context.Context, tenantWithDetails *tenant.WithDetails, spec *job.Spec, validatePools bool) (*job.Job, error) {
+	if err := validateSchedulerPool(tenantWithDetails, spec); err != nil {
+		if validatePools {
+			j.logger.Error("error validating scheduler pool for [%s]: %s", spec.Name(), err)
+			return nil, err
+		}
+		j.logger.Warn("job [%s] uses a pool not declared in config: %s", spec.Name(), err)
+	}
+
+	if err := j.validateHookDependencyTypes(ctx, spec); err != nil {
+		j.logger.Error("error validating hook dependencies for [%s]: %s", spec.Name(), err)
+		return nil, err
+	}
+
 	destination, err := j.pluginService.GenerateDestination(ctx, tenantWithDetails, spec.Task())
 	if err != nil && !errors.Is(err, ErrUpstreamModNotFound) {
 		j.logger.Error("error generating destination for [%s]: %s", spec.Name(), err)
@@ -925,6 +1230,62 @@ func (j *JobService) generateJob(ctx context.Context, tenantWithDetails *tenant.
 	return job.NewJob(tenantWithDetails.ToTenant(), spec, destination, sources), nil
 }
 
+// schedulerPoolKeys are the scheduler metadata of a job which name a pool
+var schedulerPoolKeys = []string{"pool", "sensor_pool", "task_pool"}
+
+// validateSchedulerPool makes sure every pool used by the job is declared in the project or namespace config
+func validateSchedulerPool(tenantWithDetails *tenant.WithDetails, spec *job.Spec) error {
+	if spec.Metadata() == nil {
+		return nil
+	}
+	var poolNames []string
+	for _, key := range schedulerPoolKeys {
+		if poolName := spec.Metadata().Scheduler()[key]; poolName != "" {
+			poolNames = append(poolNames, poolName)
+		}
+	}
+	if len(poolNames) == 0 {
+		return nil
+	}
+
+	pools, err := tenantWithDetails.SchedulerPools()
+	if err != nil {
+		return err
+	}
+	declared := make(map[string]bool, len(pools))
+	for _, pool := range pools {
+		declared[pool.Name()] = true
+	}
+	for _, poolName := range poolNames {
+		if !declared[poolName] {
+			errorMsg := fmt.Sprintf("pool %s of job %s is not declared in project or namespace config", poolName, spec.Name().String())
+			return errors.InvalidArgument(job.EntityJob, errorMsg)
+		}
+	}
+	return nil
+}
+
+// validateHookDependencyTypes gets the types of the hooks of the spec to check their dependencies against the order of the types
+func (j *JobService) validateHookDependencyTypes(ctx context.Context, spec *job.Spec) error {
+	hasDependency := false
+	for _, hook := range spec.Hooks() {
+		hasDependency = hasDependency || len(hook.DependsOn()) > 0
+	}
+	if !hasDependency {
+		return nil
+	}
+
+	hookTypes := make(map[string]plugin.HookType, len(spec.Hooks()))
+	for _, hook := range spec.Hooks() {
+		info, err := j.pluginService.Info(ctx, job.TaskName(hook.Name()))
+		if err != nil {
+			return err
+		}
+		hookTypes[hook.Name()] = info.HookType
+	}
+	return spec.ValidateHookDependencyTypes(hookTypes)
+}
+
 func (j *JobService) validateCyclic(rootName job.Name, jobMap map[job.Name]*job.WithUpstream, identifierToJobMap map[string][]*job.WithUpstream) ([]string, error) {
 	dagTree := j.buildDAGTree(rootName, jobMap, identifierToJobMap)
 	return dagTree.ValidateCyclic()
@@ -986,7 +1347,7 @@ func (j *JobService) GetJobBasicInfo(ctx context.Context, jobTenant tenant.Tenan
 			logger.Write(writer.LogLevelError, fmt.Sprintf("unable to get tenant detail, err: %v", err))
 			return nil, logger
 		}
-		subjectJob, err = j.generateJob(ctx, tenantWithDetails, spec)
+		subjectJob, err = j.generateJob(ctx, tenantWithDetails, spec, true)
 		if err != nil {
 			j.logger.Info("error generating job for [%s]: %s", spec.Name(), err)
 			logger.Write(writer.LogLevelError, fmt.Sprintf("unable to generate job, err: %v", err))
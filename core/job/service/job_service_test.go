@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/raystack/salt/log"
 	"github.com/stretchr/testify/assert"
@@ -64,6 +65,10 @@ func TestJobService(t *testing.T) {
 	var jobNamesWithInvalidSpec []job.Name
 	var emptyJobNames []string
 
+	apiChange := job.NewSpecChange("optimus@example.io", job.ChangeSourceAPI)
+	replaceAllChange := job.NewSpecChange("optimus@example.io", job.ChangeSourceReplaceAll)
+	refreshChange := job.NewSpecChange("", job.ChangeSourceRefresh)
+
 	t.Run("Add", func(t *testing.T) {
 		t.Run("add jobs", func(t *testing.T) {
 			jobRepo := new(JobRepository)
@@ -102,7 +107,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Add", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
@@ -116,7 +121,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.NoError(t, err)
 		})
 		t.Run("return error if unable to get detailed tenant", func(t *testing.T) {
@@ -144,7 +149,7 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(&tenant.WithDetails{}, errors.New("internal error"))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("skip job that has issue when generating destination and return error", func(t *testing.T) {
@@ -191,7 +196,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Add", ctx, mock.Anything).Return(jobs, nil)
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(jobs, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
@@ -205,9 +210,172 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
+		t.Run("skip job using scheduler pool which is not declared and return error", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			namespaceWithPools, _ := tenant.NewNamespace("test-ns", project.Name(),
+				map[string]string{
+					tenant.SchedulerPoolsKey: "bq_pool:4",
+				})
+			detailedTenantWithPools, _ := tenant.NewTenantDetails(project, namespaceWithPools, nil)
+
+			declaredPoolMetadata, _ := job.NewMetadataBuilder().WithScheduler(map[string]string{"pool": "bq_pool"}).Build()
+			undeclaredPoolMetadata, _ := job.NewMetadataBuilder().WithScheduler(map[string]string{"pool": "bq_pol"}).Build()
+			undeclaredSensorPoolMetadata, _ := job.NewMetadataBuilder().WithScheduler(map[string]string{"pool": "bq_pool", "sensor_pool": "sensor_pool"}).Build()
+			undeclaredTaskPoolMetadata, _ := job.NewMetadataBuilder().WithScheduler(map[string]string{"task_pool": "task_pool"}).Build()
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithMetadata(declaredPoolMetadata).Build()
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).WithMetadata(undeclaredPoolMetadata).Build()
+			specC, _ := job.NewSpecBuilder(jobVersion, "job-C", "sample-owner", jobSchedule, jobWindow, jobTask).WithMetadata(undeclaredSensorPoolMetadata).Build()
+			specD, _ := job.NewSpecBuilder(jobVersion, "job-D", "sample-owner", jobSchedule, jobWindow, jobTask).WithMetadata(undeclaredTaskPoolMetadata).Build()
+			specs := []*job.Spec{specB, specC, specD, specA}
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenantWithPools, nil)
+
+			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("GenerateDestination", ctx, detailedTenantWithPools, specA.Task()).Return(jobADestination, nil).Once()
+
+			jobAUpstreamName := []job.ResourceURN{"job-B"}
+			pluginService.On("GenerateUpstreams", ctx, detailedTenantWithPools, specA, true).Return(jobAUpstreamName, nil)
+
+			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
+			jobs := []*job.Job{jobA}
+			jobRepo.On("Add", ctx, jobs, apiChange).Return(jobs, nil)
+
+			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
+			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
+
+			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+
+			jobNamesToUpload := []string{jobA.GetName()}
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
+
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
+			assert.ErrorContains(t, err, "pool bq_pol of job job-B is not declared in project or namespace config")
+			assert.ErrorContains(t, err, "pool sensor_pool of job job-C is not declared in project or namespace config")
+			assert.ErrorContains(t, err, "pool task_pool of job job-D is not declared in project or namespace config")
+		})
+		t.Run("skip job using scheduler pool when the project declares no pools and return error", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			poolMetadata, _ := job.NewMetadataBuilder().WithScheduler(map[string]string{"pool": "bq_pool"}).Build()
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).WithMetadata(poolMetadata).Build()
+			specs := []*job.Spec{specB, specA}
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+
+			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
+
+			jobAUpstreamName := []job.ResourceURN{"job-B"}
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+
+			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
+			jobs := []*job.Job{jobA}
+			jobRepo.On("Add", ctx, jobs, apiChange).Return(jobs, nil)
+
+			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
+			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
+
+			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+
+			jobNamesToUpload := []string{jobA.GetName()}
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
+
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
+			assert.ErrorContains(t, err, "pool bq_pool of job job-B is not declared in project or namespace config")
+		})
+		t.Run("return error when a pre hook depends on a post hook", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			preHook, _ := job.NewHookBuilder("transporter", nil).WithDependsOn([]string{"predator"}).Build()
+			postHook, _ := job.NewHook("predator", nil)
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{preHook, postHook}).Build()
+			specs := []*job.Spec{specA}
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+
+			pluginService.On("Info", ctx, job.TaskName("transporter")).Return(&plugin.Info{Name: "transporter", HookType: plugin.HookTypePre}, nil)
+			pluginService.On("Info", ctx, job.TaskName("predator")).Return(&plugin.Info{Name: "predator", HookType: plugin.HookTypePost}, nil)
+
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(nil, nil)
+			upstreamResolver.On("BulkResolve", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			upstreamRepo.On("ReplaceUpstreams", ctx, mock.Anything).Return(nil)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
+			assert.ErrorContains(t, err, "pre hook transporter of job job-A can not depend on post hook predator which runs after it")
+		})
 		t.Run("return error when all jobs failed to have destination and upstream generated", func(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
@@ -231,7 +399,7 @@ func TestJobService(t *testing.T) {
 			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			specs := []*job.Spec{specB, specA}
 
-			jobRepo.On("Add", ctx, mock.Anything).Return(nil, nil)
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(nil, nil)
 
 			upstreamResolver.On("BulkResolve", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
 
@@ -247,7 +415,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, errors.New("generate upstream error"))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
 		t.Run("should not skip nor return error if jobs does not have upstream mod and encounter issue on generate destination/upstream", func(t *testing.T) {
@@ -285,7 +453,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, "", nil)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Add", ctx, mock.Anything).Return(jobs, nil)
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(jobs, nil)
 
 			jobWithUpstream := job.NewWithUpstream(jobA, nil)
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
@@ -298,7 +466,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.NoError(t, err)
 		})
 		t.Run("should skip and not return error if one of the job is failed to be inserted to db", func(t *testing.T) {
@@ -342,7 +510,7 @@ func TestJobService(t *testing.T) {
 
 			jobB := job.NewJob(sampleTenant, specB, "", nil)
 			savedJobs := []*job.Job{jobB}
-			jobRepo.On("Add", ctx, mock.Anything).Return(savedJobs, errors.New("unable to save job A"))
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(savedJobs, errors.New("unable to save job A"))
 
 			jobWithUpstreamB := job.NewWithUpstream(jobB, nil)
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), savedJobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstreamB}, nil, nil)
@@ -355,7 +523,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "unable to save job A")
 		})
 		t.Run("return error when all jobs failed to be inserted to db", func(t *testing.T) {
@@ -392,10 +560,10 @@ func TestJobService(t *testing.T) {
 			jobSourcesA := []job.ResourceURN{"resource-B"}
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil)
 
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{}, errors.New("unable to save job A"), errors.New("all jobs failed"))
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return([]*job.Job{}, errors.New("unable to save job A"), errors.New("all jobs failed"))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "unable to save job A")
 		})
 		t.Run("should return error if failed to save upstream", func(t *testing.T) {
@@ -436,7 +604,7 @@ func TestJobService(t *testing.T) {
 			jobA := job.NewJob(sampleTenant, specA, resourceA, jobSourcesA)
 			jobs := []*job.Job{jobA}
 
-			jobRepo.On("Add", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			jobWithUpstreamA := job.NewWithUpstream(jobA, nil)
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstreamA}, nil, nil)
@@ -449,7 +617,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.Error(t, err)
 		})
 		t.Run("return error if encounter issue when uploading jobs", func(t *testing.T) {
@@ -489,7 +657,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Add", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Add", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
@@ -503,7 +671,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Add(ctx, sampleTenant, specs)
+			err := jobService.Add(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, errorMsg)
 		})
 	})
@@ -545,7 +713,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Update", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
@@ -559,7 +727,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.NoError(t, err)
 		})
 		t.Run("return error if unable to get detailed tenant", func(t *testing.T) {
@@ -587,7 +755,7 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(&tenant.WithDetails{}, errors.New("internal error"))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("skip job that has issue when generating destination and return error", func(t *testing.T) {
@@ -634,7 +802,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Update", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
@@ -648,7 +816,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
 		t.Run("return error when all jobs failed to have destination and upstream generated", func(t *testing.T) {
@@ -674,7 +842,7 @@ func TestJobService(t *testing.T) {
 			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			specs := []*job.Spec{specB, specA}
 
-			jobRepo.On("Update", ctx, mock.Anything).Return(nil, nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(nil, nil)
 
 			upstreamResolver.On("BulkResolve", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
 
@@ -690,7 +858,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, errors.New("generate upstream error"))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
 		t.Run("should not skip nor return error if jobs does not have upstream mod and encounter issue on generate destination/upstream", func(t *testing.T) {
@@ -728,7 +896,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, "", nil)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Update", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			jobWithUpstream := job.NewWithUpstream(jobA, nil)
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
@@ -741,7 +909,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.NoError(t, err)
 		})
 		t.Run("should skip and not return error if one of the job is failed to be updated to db", func(t *testing.T) {
@@ -785,7 +953,7 @@ func TestJobService(t *testing.T) {
 
 			jobB := job.NewJob(sampleTenant, specB, "", nil)
 			savedJobs := []*job.Job{jobB}
-			jobRepo.On("Update", ctx, mock.Anything).Return(savedJobs, errors.New("unable to save job A"), nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(savedJobs, errors.New("unable to save job A"), nil)
 
 			jobWithUpstreamB := job.NewWithUpstream(jobB, nil)
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), savedJobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstreamB}, nil, nil)
@@ -798,7 +966,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "unable to save job A")
 		})
 		t.Run("return error when all jobs failed to be updated to db", func(t *testing.T) {
@@ -835,10 +1003,10 @@ func TestJobService(t *testing.T) {
 			jobSourcesA := []job.ResourceURN{"resource-B"}
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil)
 
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{}, errors.New("unable to update job A"), errors.New("all jobs failed"))
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return([]*job.Job{}, errors.New("unable to update job A"), errors.New("all jobs failed"))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, "unable to update job A")
 		})
 		t.Run("should return error if failed to save upstream", func(t *testing.T) {
@@ -878,7 +1046,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, resourceA, jobSourcesA)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Update", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			jobWithUpstreamA := job.NewWithUpstream(jobA, nil)
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstreamA}, nil, nil)
@@ -891,7 +1059,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.Error(t, err)
 		})
 		t.Run("return error if encounter issue when uploading jobs", func(t *testing.T) {
@@ -931,7 +1099,7 @@ func TestJobService(t *testing.T) {
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
-			jobRepo.On("Update", ctx, mock.Anything).Return(jobs, nil, nil)
+			jobRepo.On("Update", ctx, mock.Anything, apiChange).Return(jobs, nil, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
@@ -945,7 +1113,7 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.Update(ctx, sampleTenant, specs)
+			err := jobService.Update(ctx, sampleTenant, specs, apiChange)
 			assert.ErrorContains(t, err, errorMsg)
 		})
 	})
@@ -1239,7 +1407,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
 
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
+			jobRepo.On("Add", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 
@@ -1256,7 +1424,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.NoError(t, err)
 		})
 		t.Run("updates modified existing jobs", func(t *testing.T) {
@@ -1305,7 +1473,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
 
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
+			jobRepo.On("Update", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
@@ -1322,7 +1490,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.NoError(t, err)
 		})
 		t.Run("deletes the removed jobs", func(t *testing.T) {
@@ -1377,7 +1545,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.NoError(t, err)
 		})
 		t.Run("deletes the jobs which the downstreams are also be deleted", func(t *testing.T) {
@@ -1443,7 +1611,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.NoError(t, err)
 		})
 		t.Run("adds, updates, and deletes jobs in a request", func(t *testing.T) {
@@ -1500,10 +1668,10 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamNames, service.ErrUpstreamModNotFound)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamNames)
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
+			jobRepo.On("Add", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, nil)
 
 			jobB := job.NewJob(sampleTenant, specB, "", nil)
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobB}, nil)
+			jobRepo.On("Update", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobB}, nil)
 
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), existingSpecC.Name()).Return(nil, nil)
 			jobRepo.On("Delete", ctx, project.Name(), existingSpecC.Name(), false).Return(nil)
@@ -1522,7 +1690,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.NoError(t, err)
 		})
 		t.Run("skips invalid job when classifying specs as added, modified, or deleted", func(t *testing.T) {
@@ -1581,10 +1749,10 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamNames, service.ErrUpstreamModNotFound)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamNames)
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
+			jobRepo.On("Add", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, nil)
 
 			jobB := job.NewJob(sampleTenant, specB, "", nil)
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobB}, nil)
+			jobRepo.On("Update", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobB}, nil)
 
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), existingSpecC.Name()).Return(nil, nil)
 			jobRepo.On("Delete", ctx, project.Name(), existingSpecC.Name(), false).Return(nil)
@@ -1603,7 +1771,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, []job.Name{"job-D"}, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, []job.Name{"job-D"}, replaceAllChange, logWriter)
 			assert.NoError(t, err)
 		})
 		t.Run("skips adding new invalid jobs", func(t *testing.T) {
@@ -1658,7 +1826,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("skips invalid modified jobs", func(t *testing.T) {
@@ -1716,7 +1884,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("skips to delete jobs if the downstream is not deleted", func(t *testing.T) {
@@ -1769,7 +1937,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamNames, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamNames)
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
+			jobRepo.On("Add", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, nil)
 
 			downstreamList := []*job.Downstream{
 				job.NewDownstream("job-E", project.Name(), namespace.Name(), taskName),
@@ -1794,7 +1962,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "job is being used by")
 		})
 		t.Run("should not break process if one of job failed to be added", func(t *testing.T) {
@@ -1845,7 +2013,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamName, nil).Once()
 
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, errors.New("internal error"))
+			jobRepo.On("Add", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, errors.New("internal error"))
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 
@@ -1862,7 +2030,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("should not break process if one of job failed to be updated", func(t *testing.T) {
@@ -1908,11 +2076,11 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
 
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{}, errors.New("internal error"))
+			jobRepo.On("Update", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{}, errors.New("internal error"))
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("should not break process if one of job failed to be deleted", func(t *testing.T) {
@@ -1960,7 +2128,7 @@ func TestJobService(t *testing.T) {
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Times(3)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("should not delete job if unable to check downstream of the job", func(t *testing.T) {
@@ -2007,7 +2175,7 @@ func TestJobService(t *testing.T) {
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Twice()
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
 		t.Run("should not delete job if one of its downstream is unable to delete", func(t *testing.T) {
@@ -2076,7 +2244,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.Error(t, err)
 		})
 		t.Run("returns error if unable to get tenant details", func(t *testing.T) {
@@ -2120,7 +2288,7 @@ func TestJobService(t *testing.T) {
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
 
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, errorMsg)
 		})
 		t.Run("returns error if encounter error when uploading/removing jobs", func(t *testing.T) {
@@ -2169,7 +2337,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
 
-			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
+			jobRepo.On("Add", ctx, mock.Anything, replaceAllChange).Return([]*job.Job{jobA}, nil)
 
 			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 
@@ -2185,7 +2353,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, mock.Anything, mock.Anything).Return(errors.New(errorMsg))
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
-			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
+			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, replaceAllChange, logWriter)
 			assert.ErrorContains(t, err, errorMsg)
 		})
 	})
@@ -2238,7 +2406,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specB, true).Return(jobBUpstreamName, nil)
 
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA, jobB}, nil)
+			jobRepo.On("Update", ctx, mock.Anything, refreshChange).Return([]*job.Job{jobA, jobB}, nil)
 
 			upstreamB := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
@@ -2259,6 +2427,68 @@ func TestJobService(t *testing.T) {
 			err := jobService.Refresh(ctx, project.Name(), []string{namespace.Name().String()}, nil, logWriter)
 			assert.NoError(t, err)
 		})
+		t.Run("refreshes existing job using scheduler pool which is not declared", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			logWriter := new(mockWriter)
+			defer logWriter.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			namespaceWithPools, _ := tenant.NewNamespace("test-ns", project.Name(),
+				map[string]string{
+					tenant.SchedulerPoolsKey: "bq_pool:4",
+				})
+			detailedTenantWithPools, _ := tenant.NewTenantDetails(project, namespaceWithPools, nil)
+
+			undeclaredPoolMetadata, _ := job.NewMetadataBuilder().WithScheduler(map[string]string{"pool": "external_pool"}).Build()
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithMetadata(undeclaredPoolMetadata).Build()
+			jobADestination := job.ResourceURN("resource-A")
+			jobA := job.NewJob(sampleTenant, specA, jobADestination, nil)
+
+			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA}, nil)
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenantWithPools, nil)
+
+			pluginService.On("GenerateDestination", ctx, detailedTenantWithPools, specA.Task()).Return(jobADestination, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenantWithPools, specA, true).Return(nil, nil)
+
+			jobRepo.On("Update", ctx, []*job.Job{jobA}, refreshChange).Return([]*job.Job{jobA}, nil)
+
+			jobAWithUpstream := job.NewWithUpstream(jobA, nil)
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobAWithUpstream}, nil)
+
+			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobAWithUpstream}).Return(nil)
+
+			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
+			eventHandler.On("HandleEvent", mock.Anything).Once()
+
+			var jobNamesToRemove []string
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{jobA.GetName()}, jobNamesToRemove).Return(nil)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.Refresh(ctx, project.Name(), []string{namespace.Name().String()}, nil, logWriter)
+			assert.NoError(t, err)
+		})
 		t.Run("resolves and saves upstream for all existing jobs for multiple tenant", func(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
@@ -2309,8 +2539,8 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedOtherTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedOtherTenant, specB, true).Return(jobBUpstreamName, nil).Once()
 
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA}, nil).Once()
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobB}, nil).Once()
+			jobRepo.On("Update", ctx, mock.Anything, refreshChange).Return([]*job.Job{jobA}, nil).Once()
+			jobRepo.On("Update", ctx, mock.Anything, refreshChange).Return([]*job.Job{jobB}, nil).Once()
 
 			upstreamB := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
@@ -2455,7 +2685,7 @@ func TestJobService(t *testing.T) {
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specB, true).Return([]job.ResourceURN{jobBUpstreamName}, nil)
 
-			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA, jobB}, nil)
+			jobRepo.On("Update", ctx, mock.Anything, refreshChange).Return([]*job.Job{jobA, jobB}, nil)
 
 			upstreamB := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
 			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
@@ -3026,6 +3256,8 @@ func TestJobService(t *testing.T) {
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specC.Name()).Return(jobCDownstream, nil)
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specB.Name()).Return([]*job.Downstream{}, nil)
 
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), mock.Anything, mock.Anything).Return(nil, nil)
+
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
@@ -3274,13 +3506,154 @@ func TestJobService(t *testing.T) {
 
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specC.Name()).Return([]*job.Downstream{}, nil)
 
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), mock.Anything, mock.Anything).Return(nil, nil)
+
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, specs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
+		t.Run("writes warning when the input window is not fully produced by the upstream", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, mock.Anything).Return(detailedTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			logWriter := new(mockWriter)
+			defer logWriter.AssertExpectations(t)
+
+			dailySchedule, _ := job.NewScheduleBuilder(startDate).WithInterval("0 0 * * *").Build()
+			dailyWindow, _ := models.NewWindow(2, "d", "", "24h")
+			hourlyWindow, _ := models.NewWindow(2, "h", "", "1h")
+
+			jobTaskConfigA, _ := job.ConfigFrom(map[string]string{"table": "table-A"})
+			jobTaskConfigB, _ := job.ConfigFrom(map[string]string{"table": "table-B"})
+			jobTaskA := job.NewTask(taskName, jobTaskConfigA)
+			jobTaskB := job.NewTask(taskName, jobTaskConfigB)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", dailySchedule, dailyWindow, jobTaskA).Build()
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", dailySchedule, hourlyWindow, jobTaskB).Build()
+			specs := []*job.Spec{specA, specB}
+
+			jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
+			jobB := job.NewJob(sampleTenant, specB, "table-B", nil)
+
+			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobB}, nil)
+
+			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return([]job.ResourceURN{"table-B"}, nil)
+
+			upstreamB := job.NewUpstreamResolved("job-B", "", "table-B", sampleTenant, job.UpstreamTypeInferred, taskName, false)
+			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobAWithUpstream}, nil)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specB.Name()).Return(jobB, nil)
+
+			logWriter.On("Write", writer.LogLevelWarning, "[test-ns] input window of job job-A is not fully produced by upstream test-proj/job-B: "+
+				"10 of the simulated runs miss data, eg. [2022-09-30T00:00:00Z, 2022-09-30T23:00:00Z) for the run scheduled at 2022-10-01T00:00:00Z").Return(nil)
+			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
+			err := jobService.Validate(ctx, sampleTenant, specs, jobNamesWithInvalidSpec, logWriter)
+			assert.NoError(t, err)
+		})
 	})
 
+	t.Run("Plan", func(t *testing.T) {
+		t.Run("returns error when unable to get tenant details", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(nil, errors.New("get tenant details fail"))
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			plan, err := jobService.Plan(ctx, sampleTenant, []*job.Spec{}, jobNamesWithInvalidSpec, nil)
+			assert.Nil(t, plan)
+			assert.EqualError(t, err, "get tenant details fail")
+		})
+		t.Run("plans the jobs to add, update and delete without saving them", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			logWriter := new(mockWriter)
+			defer logWriter.AssertExpectations(t)
+
+			taskAConfig, _ := job.ConfigFrom(map[string]string{"table": "table_a"})
+			taskCConfig, _ := job.ConfigFrom(map[string]string{"table": "table_c"})
+			taskA := job.NewTask(taskName, taskAConfig)
+			taskC := job.NewTask(taskName, taskCConfig)
+
+			existingSpecA, _ := job.NewSpecBuilder(jobVersion, "job-A", "old-owner", jobSchedule, jobWindow, taskA).Build()
+			existingJobA := job.NewJob(sampleTenant, existingSpecA, "resource-A", nil)
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			existingJobB := job.NewJob(sampleTenant, specB, "resource-B", nil)
+			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{existingJobA, existingJobB}, nil)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, taskA).Build()
+			specCUpstream, _ := job.NewSpecUpstreamBuilder().WithUpstreamNames([]job.SpecUpstreamName{"job-A", "job-X"}).Build()
+			specC, _ := job.NewSpecBuilder(jobVersion, "job-C", "sample-owner", jobSchedule, jobWindow, taskC).WithSpecUpstream(specCUpstream).Build()
+
+			pluginService.On("GenerateDestination", ctx, detailedTenant, taskA).Return(job.ResourceURN("resource-A2"), nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(nil, nil)
+			pluginService.On("GenerateDestination", ctx, detailedTenant, taskC).Return(job.ResourceURN("resource-C"), nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specC, true).Return(nil, nil)
+
+			jobC := job.NewJob(sampleTenant, specC, "resource-C", nil)
+			jobCWithUpstream := job.NewWithUpstream(jobC, []*job.Upstream{
+				job.NewUpstreamUnresolvedStatic("job-A", project.Name()),
+				job.NewUpstreamUnresolvedStatic("job-X", project.Name()),
+				job.NewUpstreamUnresolvedInferred("resource-Y"),
+			})
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), mock.Anything, mock.Anything).Return([]*job.WithUpstream{jobCWithUpstream}, errors.New("unknown upstream"))
+
+			downstreamRepo.On("GetDownstreamByDestination", ctx, project.Name(), job.ResourceURN("resource-A")).Return([]*job.Downstream{
+				job.NewDownstream("job-F", project.Name(), namespace.Name(), taskName),
+			}, nil)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-B")).Return([]*job.Downstream{
+				job.NewDownstream("job-E", project.Name(), namespace.Name(), taskName),
+			}, nil)
+
+			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
+
+			jobService := service.NewJobService(jobRepo, nil, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
+			plan, err := jobService.Plan(ctx, sampleTenant, []*job.Spec{specA, specC}, jobNamesWithInvalidSpec, logWriter)
+			assert.NoError(t, err)
+			assert.Len(t, plan.Jobs, 3)
+
+			assert.Equal(t, job.Name("job-C"), plan.Jobs[0].Name)
+			assert.Equal(t, job.PlanActionAdd, plan.Jobs[0].Action)
+			assert.Equal(t, []string{"test-proj/job-X"}, plan.Jobs[0].UnresolvedUpstreams)
+			assert.Contains(t, plan.Jobs[0].Diffs, &job.FieldDiff{Field: "dependencies.job", NewValue: "job-A,job-X"})
+
+			assert.Equal(t, job.Name("job-A"), plan.Jobs[1].Name)
+			assert.Equal(t, job.PlanActionUpdate, plan.Jobs[1].Action)
+			assert.Equal(t, []*job.FieldDiff{{Field: "owner", OldValue: "old-owner", NewValue: "sample-owner"}}, plan.Jobs[1].Diffs)
+			assert.Equal(t, []job.FullName{"test-proj/job-F"}, plan.Jobs[1].BrokenDownstreams)
+
+			assert.Equal(t, job.Name("job-B"), plan.Jobs[2].Name)
+			assert.Equal(t, job.PlanActionDelete, plan.Jobs[2].Action)
+			assert.Equal(t, []job.FullName{"test-proj/job-E"}, plan.Jobs[2].BrokenDownstreams)
+		})
+	})
 	t.Run("GetUpstreamsToInspect", func(t *testing.T) {
 		t.Run("should return upstream for an existing job", func(t *testing.T) {
 			jobRepo := new(JobRepository)
@@ -3715,13 +4088,15 @@ func TestJobService(t *testing.T) {
 		jobsToUpdateState := []job.Name{jobName}
 		state := job.DISABLED
 		updateRemark := "job disable remark"
+		enableAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+		change, _ := job.NewStateChange(state, updateRemark, "optimus@example.io", &enableAt)
 		t.Run("should fail if scheduler state change request fails", func(t *testing.T) {
 			scheduler := new(mockScheduler)
 			scheduler.On("UpdateJobState", ctx, sampleTenant, jobsToUpdateState, state.String()).Return(fmt.Errorf("some error in update Job State"))
 			defer scheduler.AssertExpectations(t)
 
 			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, nil, nil, scheduler)
-			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, state, "job disable remark")
+			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, change)
 			assert.ErrorContains(t, err, "some error in update Job State")
 		})
 
@@ -3731,11 +4106,11 @@ func TestJobService(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 
 			jobRepo := new(JobRepository)
-			jobRepo.On("UpdateState", ctx, sampleTenant, jobsToUpdateState, state, updateRemark).Return(fmt.Errorf("some error in update Job State repo"))
+			jobRepo.On("UpdateState", ctx, sampleTenant, jobsToUpdateState, change).Return(fmt.Errorf("some error in update Job State repo"))
 			defer jobRepo.AssertExpectations(t)
 
 			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, scheduler)
-			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, state, updateRemark)
+			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, change)
 			assert.ErrorContains(t, err, "some error in update Job State repo")
 		})
 
@@ -3745,17 +4120,231 @@ func TestJobService(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 
 			jobRepo := new(JobRepository)
-			jobRepo.On("UpdateState", ctx, sampleTenant, jobsToUpdateState, state, updateRemark).Return(nil)
+			jobRepo.On("UpdateState", ctx, sampleTenant, jobsToUpdateState, change).Return(nil)
 			defer jobRepo.AssertExpectations(t)
 
 			eventHandler := newEventHandler(t)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
 			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, eventHandler, nil, nil, scheduler)
-			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, state, updateRemark)
+			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, change)
 			assert.Nil(t, err)
 		})
 	})
+	t.Run("GetDisabledJobs", func(t *testing.T) {
+		t.Run("returns the disabled jobs of the project", func(t *testing.T) {
+			disabledJobs := []*job.DisabledJob{
+				{Name: "job-A", Tenant: sampleTenant, Remark: "maintenance", DisabledBy: "optimus@example.io"},
+			}
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetDisabledJobs", ctx, project.Name(), "").Return(disabledJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			result, err := jobService.GetDisabledJobs(ctx, project.Name(), "")
+			assert.NoError(t, err)
+			assert.Equal(t, disabledJobs, result)
+		})
+	})
+	t.Run("GetSpecHistory", func(t *testing.T) {
+		t.Run("returns the versions of the job", func(t *testing.T) {
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			versions := []*job.SpecVersion{
+				{Version: 1, Job: job.NewJob(sampleTenant, specA, "resource-A", nil), ChangedBy: "optimus@example.io", Source: job.ChangeSourceAPI},
+			}
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetSpecVersions", ctx, project.Name(), specA.Name()).Return(versions, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			result, err := jobService.GetSpecHistory(ctx, project.Name(), specA.Name())
+			assert.NoError(t, err)
+			assert.Equal(t, versions, result)
+		})
+	})
+	t.Run("GetCalendar", func(t *testing.T) {
+		t.Run("returns error if unable to get the project", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetProject", ctx, project.Name()).Return(nil, errors.New("project not found"))
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			calendar, err := jobService.GetCalendar(ctx, project.Name(), "exchange")
+			assert.ErrorContains(t, err, "project not found")
+			assert.Nil(t, calendar)
+		})
+		t.Run("returns error if the calendar is not declared in the project config", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetProject", ctx, project.Name()).Return(project, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			calendar, err := jobService.GetCalendar(ctx, project.Name(), "exchange")
+			assert.ErrorContains(t, err, "calendar [exchange] is not declared")
+			assert.Nil(t, calendar)
+		})
+		t.Run("returns the calendar declared in the project config", func(t *testing.T) {
+			projectWithCalendar, _ := tenant.NewProject("test-proj", map[string]string{
+				tenant.ProjectSchedulerHost:  "host",
+				tenant.ProjectStoragePathKey: "gs://location",
+				"CALENDAR_EXCHANGE":          "weekdays=mon-fri;holidays=2023-12-25",
+			})
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetProject", ctx, project.Name()).Return(projectWithCalendar, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			calendar, err := jobService.GetCalendar(ctx, project.Name(), "exchange")
+			assert.NoError(t, err)
+			assert.Equal(t, "exchange", calendar.Name())
+			assert.False(t, calendar.IsBusinessDay(time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+	t.Run("RegisterTemplateLibrary", func(t *testing.T) {
+		t.Run("returns error if the namespace of the library is not found", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(nil, errors.New("namespace not found"))
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			library, err := job.NewTemplateLibrary(project.Name(), namespace.Name().String(), "dates", `{{ .DSTART }}`)
+			assert.NoError(t, err)
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			savedLibrary, affectedJobs, err := jobService.RegisterTemplateLibrary(ctx, library)
+			assert.ErrorContains(t, err, "namespace not found")
+			assert.Nil(t, savedLibrary)
+			assert.Nil(t, affectedJobs)
+		})
+		t.Run("returns error if unable to save the library", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetProject", ctx, project.Name()).Return(project, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			library, err := job.NewTemplateLibrary(project.Name(), "", "dates", `{{ .DSTART }}`)
+			assert.NoError(t, err)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("SaveTemplateLibrary", ctx, library).Return(nil, errors.New("unable to save"))
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			savedLibrary, affectedJobs, err := jobService.RegisterTemplateLibrary(ctx, library)
+			assert.ErrorContains(t, err, "unable to save")
+			assert.Nil(t, savedLibrary)
+			assert.Nil(t, affectedJobs)
+		})
+		t.Run("saves the library and returns the jobs referring to it", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			library, err := job.NewTemplateLibrary(project.Name(), namespace.Name().String(), "dates", `{{ .DSTART }}`)
+			assert.NoError(t, err)
+			savedLibrary := *library
+			savedLibrary.Version = 2
+
+			asset := job.Asset(map[string]string{"query.sql": `SELECT * FROM table WHERE {{ template "lib/dates" . }}`})
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specC, _ := job.NewSpecBuilder(jobVersion, "job-C", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+			jobA := job.NewJob(sampleTenant, specA, "", nil)
+			jobB := job.NewJob(sampleTenant, specB, "", nil)
+			jobC := job.NewJob(otherTenant, specC, "", nil)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("SaveTemplateLibrary", ctx, library).Return(&savedLibrary, nil)
+			jobRepo.On("GetAllByProjectName", ctx, project.Name()).Return([]*job.Job{jobA, jobB, jobC}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			actualLibrary, affectedJobs, err := jobService.RegisterTemplateLibrary(ctx, library)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, actualLibrary.Version)
+			assert.Equal(t, []job.FullName{"test-proj/job-A"}, affectedJobs)
+		})
+	})
+	t.Run("GetTemplateLibraries", func(t *testing.T) {
+		library, err := job.NewTemplateLibrary(project.Name(), "", "dates", `{{ .DSTART }}`)
+		assert.NoError(t, err)
+
+		t.Run("returns the latest version of the libraries when name is not provided", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetTemplateLibraries", ctx, project.Name(), namespace.Name().String()).Return(job.TemplateLibraries{library}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			libraries, err := jobService.GetTemplateLibraries(ctx, project.Name(), namespace.Name().String(), "")
+			assert.NoError(t, err)
+			assert.Equal(t, job.TemplateLibraries{library}, libraries)
+		})
+		t.Run("returns every version of the library when name is provided", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetTemplateLibraryVersions", ctx, project.Name(), "", "dates").Return(job.TemplateLibraries{library}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			libraries, err := jobService.GetTemplateLibraries(ctx, project.Name(), "", "dates")
+			assert.NoError(t, err)
+			assert.Equal(t, job.TemplateLibraries{library}, libraries)
+		})
+	})
+	t.Run("Rollback", func(t *testing.T) {
+		t.Run("return error if unable to get the version", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetSpecVersion", ctx, project.Name(), job.Name("job-A"), 1).Return(nil, errors.New("version 1 of job job-A is not found"))
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.Rollback(ctx, sampleTenant, "job-A", 1, "optimus@example.io")
+			assert.ErrorContains(t, err, "version 1 of job job-A is not found")
+		})
+		t.Run("updates the job with the spec of the version", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			jobADestination := job.ResourceURN("resource-A")
+			jobAUpstreamName := []job.ResourceURN{"job-B"}
+			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
+			jobs := []*job.Job{jobA}
+
+			jobRepo.On("GetSpecVersion", ctx, project.Name(), specA.Name(), 1).Return(&job.SpecVersion{Version: 1, Job: jobA}, nil)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+
+			rollbackChange := job.NewSpecChange("optimus@example.io", job.ChangeSourceRollback)
+			jobRepo.On("Update", ctx, jobs, rollbackChange).Return(jobs, nil)
+
+			upstream := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "static", taskName, false)
+			jobWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstream})
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
+			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{jobA.GetName()}, emptyJobNames).Return(nil)
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, nil, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.Rollback(ctx, sampleTenant, specA.Name(), 1, "optimus@example.io")
+			assert.NoError(t, err)
+		})
+	})
 }
 
 // JobRepository is an autogenerated mock type for the JobRepository type
@@ -3763,13 +4352,13 @@ type JobRepository struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: _a0, _a1
-func (_m *JobRepository) Add(_a0 context.Context, _a1 []*job.Job) ([]*job.Job, error) {
-	ret := _m.Called(_a0, _a1)
+// Add provides a mock function with given fields: _a0, _a1, _a2
+func (_m *JobRepository) Add(_a0 context.Context, _a1 []*job.Job, _a2 *job.SpecChange) ([]*job.Job, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []*job.Job
-	if rf, ok := ret.Get(0).(func(context.Context, []*job.Job) []*job.Job); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, []*job.Job, *job.SpecChange) []*job.Job); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*job.Job)
@@ -3777,8 +4366,8 @@ func (_m *JobRepository) Add(_a0 context.Context, _a1 []*job.Job) ([]*job.Job, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*job.Job) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, []*job.Job, *job.SpecChange) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3806,12 +4395,78 @@ func (_m *JobRepository) ChangeJobNamespace(ctx context.Context, jobName job.Nam
 	return ret.Error(0)
 }
 
-// UpdateState provides a mock function with given fields: ctx, jobName, jobTenant, jobNewTenant
-func (_m *JobRepository) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error {
-	ret := _m.Called(ctx, jobTenant, jobNames, jobState, remark)
+// UpdateState provides a mock function with given fields: ctx, jobTenant, jobNames, change
+func (_m *JobRepository) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error {
+	ret := _m.Called(ctx, jobTenant, jobNames, change)
 	return ret.Error(0)
 }
 
+// GetDisabledJobs provides a mock function with given fields: ctx, projectName, namespaceName
+func (_m *JobRepository) GetDisabledJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName string) ([]*job.DisabledJob, error) {
+	ret := _m.Called(ctx, projectName, namespaceName)
+
+	var r0 []*job.DisabledJob
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.DisabledJob)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetSpecVersions provides a mock function with given fields: ctx, projectName, jobName
+func (_m *JobRepository) GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.SpecVersion
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.SpecVersion)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetSpecVersion provides a mock function with given fields: ctx, projectName, jobName, version
+func (_m *JobRepository) GetSpecVersion(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, version int) (*job.SpecVersion, error) {
+	ret := _m.Called(ctx, projectName, jobName, version)
+
+	var r0 *job.SpecVersion
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*job.SpecVersion)
+	}
+	return r0, ret.Error(1)
+}
+
+// SaveTemplateLibrary provides a mock function with given fields: ctx, library
+func (_m *JobRepository) SaveTemplateLibrary(ctx context.Context, library *job.TemplateLibrary) (*job.TemplateLibrary, error) {
+	ret := _m.Called(ctx, library)
+
+	var r0 *job.TemplateLibrary
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*job.TemplateLibrary)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetTemplateLibraries provides a mock function with given fields: ctx, projectName, namespaceName
+func (_m *JobRepository) GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName string) (job.TemplateLibraries, error) {
+	ret := _m.Called(ctx, projectName, namespaceName)
+
+	var r0 job.TemplateLibraries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(job.TemplateLibraries)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetTemplateLibraryVersions provides a mock function with given fields: ctx, projectName, namespaceName, name
+func (_m *JobRepository) GetTemplateLibraryVersions(ctx context.Context, projectName tenant.ProjectName, namespaceName, name string) (job.TemplateLibraries, error) {
+	ret := _m.Called(ctx, projectName, namespaceName, name)
+
+	var r0 job.TemplateLibraries
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(job.TemplateLibraries)
+	}
+	return r0, ret.Error(1)
+}
+
 // SyncState provides a mock function with given fields: ctx, jobTenant, disabledJobs, enabledJobs
 func (_m *JobRepository) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	ret := _m.Called(ctx, jobTenant, disabledJobNames, enabledJobNames)
@@ -3910,13 +4565,13 @@ func (_m *JobRepository) GetByJobName(ctx context.Context, projectName tenant.Pr
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: _a0, _a1
-func (_m *JobRepository) Update(_a0 context.Context, _a1 []*job.Job) ([]*job.Job, error) {
-	ret := _m.Called(_a0, _a1)
+// Update provides a mock function with given fields: _a0, _a1, _a2
+func (_m *JobRepository) Update(_a0 context.Context, _a1 []*job.Job, _a2 *job.SpecChange) ([]*job.Job, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []*job.Job
-	if rf, ok := ret.Get(0).(func(context.Context, []*job.Job) []*job.Job); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, []*job.Job, *job.SpecChange) []*job.Job); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*job.Job)
@@ -3924,8 +4579,8 @@ func (_m *JobRepository) Update(_a0 context.Context, _a1 []*job.Job) ([]*job.Job
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*job.Job) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, []*job.Job, *job.SpecChange) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4221,6 +4876,29 @@ func (_m *TenantDetailsGetter) GetDetails(ctx context.Context, jobTenant tenant.
 	return r0, r1
 }
 
+// GetProject provides a mock function with given fields: ctx, name
+func (_m *TenantDetailsGetter) GetProject(ctx context.Context, name tenant.ProjectName) (*tenant.Project, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *tenant.Project
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.ProjectName) *tenant.Project); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenant.Project)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, tenant.ProjectName) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockWriter struct {
 	mock.Mock
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/raystack/optimus/core/job"
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/compiler"
+	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/sdk/plugin"
 )
 
@@ -34,22 +35,28 @@ type PluginRepo interface {
 	GetByName(string) (*plugin.Plugin, error)
 }
 
+type TemplateLibraryRepository interface {
+	GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName string) (job.TemplateLibraries, error)
+}
+
 type Engine interface {
 	Compile(templateMap map[string]string, context map[string]any) (map[string]string, error)
+	CompileWithLibraries(templateMap, libraries map[string]string, context map[string]any) (map[string]string, error)
 	CompileString(input string, context map[string]any) (string, error)
 }
 
 type JobPluginService struct {
-	pluginRepo PluginRepo
-	engine     Engine
+	pluginRepo  PluginRepo
+	libraryRepo TemplateLibraryRepository
+	engine      Engine
 
 	now func() time.Time
 
 	logger log.Logger
 }
 
-func NewJobPluginService(pluginRepo PluginRepo, engine Engine, logger log.Logger) *JobPluginService {
-	return &JobPluginService{pluginRepo: pluginRepo, engine: engine, logger: logger, now: time.Now}
+func NewJobPluginService(pluginRepo PluginRepo, libraryRepo TemplateLibraryRepository, engine Engine, logger log.Logger) *JobPluginService {
+	return &JobPluginService{pluginRepo: pluginRepo, libraryRepo: libraryRepo, engine: engine, logger: logger, now: time.Now}
 }
 
 func (p JobPluginService) Info(_ context.Context, taskName job.TaskName) (*plugin.Info, error) {
@@ -104,8 +111,20 @@ func (p JobPluginService) GenerateUpstreams(ctx context.Context, jobTenant *tena
 		return nil, ErrUpstreamModNotFound
 	}
 
+	window, err := models.WindowWithCalendar(spec.Window(), jobTenant.Project().GetConfigs())
+	if err != nil {
+		p.logger.Error("error getting calendar of window: %s", err)
+		return nil, fmt.Errorf("window calendar failure: %w", err)
+	}
+
+	libraries, err := p.getTemplateLibraries(ctx, jobTenant, spec)
+	if err != nil {
+		p.logger.Error("error getting template libraries: %s", err)
+		return nil, fmt.Errorf("template library failure: %w", err)
+	}
+
 	// TODO: this now will always be a same time for start of service, is it correct ?
-	assets, err := p.compileAsset(ctx, taskPlugin, spec, p.now())
+	assets, err := p.compileAsset(ctx, taskPlugin, spec, window, libraries, p.now())
 	if err != nil {
 		p.logger.Error("error compiling asset: %s", err)
 		return nil, fmt.Errorf("asset compilation failure: %w", err)
@@ -155,7 +174,20 @@ func (p JobPluginService) compileConfig(configs job.Config, tnnt *tenant.WithDet
 	return pluginConfigs
 }
 
-func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.Plugin, spec *job.Spec, scheduledAt time.Time) (map[string]string, error) {
+// getTemplateLibraries returns the libraries available to the job, only when its assets refer to any library
+func (p JobPluginService) getTemplateLibraries(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec) (map[string]string, error) {
+	if len(compiler.LibrariesUsedBy(spec.Asset())) == 0 {
+		return nil, nil
+	}
+	namespaceName := jobTenant.Namespace().Name().String()
+	libraries, err := p.libraryRepo.GetTemplateLibraries(ctx, jobTenant.Project().Name(), namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return libraries.ContentsFor(namespaceName), nil
+}
+
+func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.Plugin, spec *job.Spec, window models.Window, libraries map[string]string, scheduledAt time.Time) (map[string]string, error) {
 	var jobDestination string
 	if taskPlugin.DependencyMod != nil {
 		var assets map[string]string
@@ -176,12 +208,12 @@ func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.P
 		jobDestination = jobDestinationResponse.Destination
 	}
 
-	startTime, err := spec.Window().GetStartTime(scheduledAt)
+	startTime, err := window.GetStartTime(scheduledAt)
 	if err != nil {
 		p.logger.Error("error getting start time: %s", err)
 		return nil, fmt.Errorf("error getting start time: %w", err)
 	}
-	endTime, err := spec.Window().GetEndTime(scheduledAt)
+	endTime, err := window.GetEndTime(scheduledAt)
 	if err != nil {
 		p.logger.Error("error getting end time: %s", err)
 		return nil, fmt.Errorf("error getting end time: %w", err)
@@ -192,7 +224,7 @@ func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.P
 		assets = spec.Asset()
 	}
 
-	templates, err := p.engine.Compile(assets, map[string]interface{}{
+	templates, err := p.engine.CompileWithLibraries(assets, libraries, map[string]interface{}{
 		configKeyDstart:        startTime.Format(TimeISOFormat),
 		configKeyDend:          endTime.Format(TimeISOFormat),
 		configKeyExecutionTime: scheduledAt.Format(TimeISOFormat),
@@ -60,7 +60,7 @@ func TestPluginService(t *testing.T) {
 			pluginRepo.On("GetByName", jobTask.Name().String()).Return(nil, errors.New("some error when fetch plugin"))
 			defer pluginRepo.AssertExpectations(t)
 
-			pluginService := service.NewJobPluginService(pluginRepo, nil, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
 			result, err := pluginService.Info(ctx, jobTask.Name())
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -79,7 +79,7 @@ func TestPluginService(t *testing.T) {
 			newPlugin := &plugin.Plugin{DependencyMod: depMod}
 			pluginRepo.On("GetByName", jobTask.Name().String()).Return(newPlugin, nil)
 
-			pluginService := service.NewJobPluginService(pluginRepo, nil, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
 			result, err := pluginService.Info(ctx, jobTask.Name())
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -105,7 +105,7 @@ func TestPluginService(t *testing.T) {
 			}, nil)
 			defer yamlMod.AssertExpectations(t)
 
-			pluginService := service.NewJobPluginService(pluginRepo, nil, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
 			result, err := pluginService.Info(ctx, jobTask.Name())
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
@@ -141,7 +141,7 @@ func TestPluginService(t *testing.T) {
 				Type:        "bigquery",
 			}, nil)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.Nil(t, err)
 			assert.Equal(t, destinationURN, result)
@@ -157,7 +157,7 @@ func TestPluginService(t *testing.T) {
 
 			pluginRepo.On("GetByName", jobTask.Name().String()).Return(nil, errors.New("not found"))
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.ErrorContains(t, err, "not found")
 			assert.Equal(t, "", result.String())
@@ -180,7 +180,7 @@ func TestPluginService(t *testing.T) {
 			pluginWithoutDependencyMod := &plugin.Plugin{YamlMod: yamlMod}
 			pluginRepo.On("GetByName", jobTask.Name().String()).Return(pluginWithoutDependencyMod, nil)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.ErrorIs(t, err, service.ErrUpstreamModNotFound)
 			assert.Equal(t, "", result.String())
@@ -204,7 +204,7 @@ func TestPluginService(t *testing.T) {
 
 			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{}, errors.New("generate destination error"))
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.ErrorContains(t, err, "generate destination error")
 			assert.Equal(t, "", result.String())
@@ -247,11 +247,51 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.Nil(t, err)
 			assert.Equal(t, []job.ResourceURN{jobSource}, result)
 		})
+		t.Run("returns upstreams from assets compiled with the template libraries", func(t *testing.T) {
+			logger := log.NewLogrus()
+
+			pluginRepo := new(mockPluginRepo)
+			defer pluginRepo.AssertExpectations(t)
+
+			libraryRepo := new(mockTemplateLibraryRepository)
+			defer libraryRepo.AssertExpectations(t)
+
+			engine := compiler.NewEngine()
+
+			depMod := new(mockOpt.DependencyResolverMod)
+			defer depMod.AssertExpectations(t)
+
+			taskPlugin := &plugin.Plugin{DependencyMod: depMod}
+			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+
+			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{Destination: "project.dataset.table"}, nil)
+
+			projectLibrary, err := job.NewTemplateLibrary(project.Name(), "", "source", "project.dataset.project_source")
+			assert.NoError(t, err)
+			namespaceLibrary, err := job.NewTemplateLibrary(project.Name(), namespace.Name().String(), "source", "project.dataset.namespace_source")
+			assert.NoError(t, err)
+			libraryRepo.On("GetTemplateLibraries", ctx, project.Name(), namespace.Name().String()).Return(job.TemplateLibraries{projectLibrary, namespaceLibrary}, nil)
+
+			jobSource := job.ResourceURN("project.dataset.namespace_source")
+			depMod.On("GenerateDependencies", ctx, mock.MatchedBy(func(req plugin.GenerateDependenciesRequest) bool {
+				return req.Assets.ToMap()["query.sql"] == "SELECT * FROM project.dataset.namespace_source"
+			})).Return(&plugin.GenerateDependenciesResponse{Dependencies: []string{jobSource.String()}}, nil)
+
+			asset, err := job.AssetFrom(map[string]string{"query.sql": `SELECT * FROM {{ template "lib/source" . }}`})
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, libraryRepo, engine, logger)
+			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []job.ResourceURN{jobSource}, result)
+		})
 		t.Run("returns error if unable to find the plugin", func(t *testing.T) {
 			logger := log.NewLogrus()
 
@@ -266,11 +306,35 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "not found")
 			assert.Nil(t, result)
 		})
+		t.Run("returns error if the calendar of the window is not declared in the project", func(t *testing.T) {
+			logger := log.NewLogrus()
+
+			pluginRepo := new(mockPluginRepo)
+			defer pluginRepo.AssertExpectations(t)
+
+			engine := compiler.NewEngine()
+
+			depMod := new(mockOpt.DependencyResolverMod)
+			defer depMod.AssertExpectations(t)
+
+			taskPlugin := &plugin.Plugin{DependencyMod: depMod}
+			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+
+			calendarWindow, err := models.NewWindowWithCalendar(3, "d", "0", "1b", "exchange")
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(3, "job-A", "sample-owner", jobSchedule, calendarWindow, jobTask).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
+			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			assert.ErrorContains(t, err, "calendar [exchange] is not declared")
+			assert.Nil(t, result)
+		})
 		t.Run("returns proper error if the upstream mod is not found", func(t *testing.T) {
 			logger := log.NewLogrus()
 
@@ -292,7 +356,7 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "not found")
 			assert.Nil(t, result)
@@ -320,7 +384,7 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "generate destination error")
 			assert.Nil(t, result)
@@ -355,7 +419,7 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, nil, engine, logger)
 			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "generate dependencies error")
 			assert.Nil(t, result)
@@ -363,6 +427,18 @@ func TestPluginService(t *testing.T) {
 	})
 }
 
+type mockTemplateLibraryRepository struct {
+	mock.Mock
+}
+
+func (m *mockTemplateLibraryRepository) GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName string) (job.TemplateLibraries, error) {
+	args := m.Called(ctx, projectName, namespaceName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(job.TemplateLibraries), args.Error(1)
+}
+
 type mockPluginRepo struct {
 	mock.Mock
 }
@@ -6,13 +6,18 @@ import (
 	"time"
 
 	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/compiler"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/models"
+	"github.com/raystack/optimus/sdk/plugin"
 )
 
 const (
 	DateLayout       = "2006-01-02"
 	maxJobNameLength = 125
+
+	// upstreamWindowVersion is the window version of the windows set in the upstream run configs
+	upstreamWindowVersion = 2
 )
 
 type Spec struct {
@@ -108,6 +113,9 @@ func (s *SpecBuilder) Build() (*Spec, error) {
 	if s.spec.owner == "" {
 		return nil, errors.InvalidArgument(EntityJob, "owner is empty")
 	}
+	if err := validateHookDependencies(s.spec.hooks); err != nil {
+		return nil, err
+	}
 	return s.spec, nil
 }
 
@@ -468,16 +476,28 @@ func (m *MetadataBuilder) WithScheduler(scheduler map[string]string) *MetadataBu
 	return m
 }
 
+const (
+	// HookRunIfSuccess runs the hook when its upstreams succeed, which is the default for pre and post hooks
+	HookRunIfSuccess = "success"
+	// HookRunIfFailure runs the hook when one of its upstreams fails, which is the default for fail hooks
+	HookRunIfFailure = "failure"
+	// HookRunIfAlways runs the hook once its upstreams are done, whether they succeed or not
+	HookRunIfAlways = "always"
+)
+
 type Hook struct {
 	name   string
 	config Config
+
+	dependsOn  []string
+	runIf      string
+	retryCount int
+	retryDelay time.Duration
+	timeout    time.Duration
 }
 
 func NewHook(name string, config Config) (*Hook, error) {
-	if name == "" {
-		return nil, errors.InvalidArgument(EntityJob, "hook name is empty")
-	}
-	return &Hook{name: name, config: config}, nil
+	return NewHookBuilder(name, config).Build()
 }
 
 func (h Hook) Name() string {
@@ -488,6 +508,165 @@ func (h Hook) Config() Config {
 	return h.config
 }
 
+// DependsOn returns the names of the hooks of the job this hook runs after
+func (h Hook) DependsOn() []string {
+	return h.dependsOn
+}
+
+// RunIf returns the condition the hook runs on, either one of the HookRunIf values or
+// a template which renders to a boolean with the context of the job run
+func (h Hook) RunIf() string {
+	return h.runIf
+}
+
+func (h Hook) RetryCount() int {
+	return h.retryCount
+}
+
+func (h Hook) RetryDelay() time.Duration {
+	return h.retryDelay
+}
+
+func (h Hook) Timeout() time.Duration {
+	return h.timeout
+}
+
+// IsRunIfExpression tells whether the condition of the hook is a template evaluated on each job run
+func (h Hook) IsRunIfExpression() bool {
+	return IsHookRunIfExpression(h.runIf)
+}
+
+func IsHookRunIfExpression(runIf string) bool {
+	return strings.Contains(runIf, "{{")
+}
+
+type HookBuilder struct {
+	hook *Hook
+}
+
+func NewHookBuilder(name string, config Config) *HookBuilder {
+	return &HookBuilder{
+		hook: &Hook{name: name, config: config},
+	}
+}
+
+func (h *HookBuilder) WithDependsOn(hookNames []string) *HookBuilder {
+	h.hook.dependsOn = hookNames
+	return h
+}
+
+func (h *HookBuilder) WithRunIf(runIf string) *HookBuilder {
+	h.hook.runIf = runIf
+	return h
+}
+
+func (h *HookBuilder) WithRetry(count int, delay time.Duration) *HookBuilder {
+	h.hook.retryCount = count
+	h.hook.retryDelay = delay
+	return h
+}
+
+func (h *HookBuilder) WithTimeout(timeout time.Duration) *HookBuilder {
+	h.hook.timeout = timeout
+	return h
+}
+
+func (h *HookBuilder) Build() (*Hook, error) {
+	hook := h.hook
+	if hook.name == "" {
+		return nil, errors.InvalidArgument(EntityJob, "hook name is empty")
+	}
+	for _, dependency := range hook.dependsOn {
+		if dependency == hook.name {
+			return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("hook [%s] depends on itself", hook.name))
+		}
+	}
+	switch {
+	case hook.runIf == "", hook.runIf == HookRunIfSuccess, hook.runIf == HookRunIfFailure, hook.runIf == HookRunIfAlways:
+	case IsHookRunIfExpression(hook.runIf):
+		if err := compiler.ValidateTemplate(hook.runIf); err != nil {
+			return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("run_if of hook [%s] is invalid: %s", hook.name, err))
+		}
+	default:
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("run_if of hook [%s] should be %s, %s, %s or a template, got [%s]",
+			hook.name, HookRunIfSuccess, HookRunIfFailure, HookRunIfAlways, hook.runIf))
+	}
+	if hook.retryCount < 0 || hook.retryDelay < 0 {
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("retry of hook [%s] is negative", hook.name))
+	}
+	if hook.timeout < 0 {
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("timeout of hook [%s] is negative", hook.name))
+	}
+	return hook, nil
+}
+
+// hookTypeOrder is the position of the hooks of a type relative to the task, pre hooks run before it and the others after
+var hookTypeOrder = map[plugin.HookType]int{
+	plugin.HookTypePre:  0,
+	plugin.HookTypePost: 1,
+	plugin.HookTypeFail: 1,
+}
+
+// ValidateHookDependencyTypes makes sure no hook depends on a hook which runs after it, eg. a pre hook on a post hook,
+// hookTypes holds the type of every hook of the spec by its name
+func (s *Spec) ValidateHookDependencyTypes(hookTypes map[string]plugin.HookType) error {
+	for _, hook := range s.hooks {
+		for _, dependency := range hook.dependsOn {
+			if hookTypeOrder[hookTypes[dependency]] > hookTypeOrder[hookTypes[hook.name]] {
+				errorMsg := fmt.Sprintf("%s hook %s of job %s can not depend on %s hook %s which runs after it",
+					hookTypes[hook.name], hook.name, s.name, hookTypes[dependency], dependency)
+				return errors.InvalidArgument(EntityJob, errorMsg)
+			}
+		}
+	}
+	return nil
+}
+
+// validateHookDependencies checks the hooks only depend on other hooks of the job, without a cycle
+func validateHookDependencies(hooks []*Hook) error {
+	hooksByName := make(map[string]*Hook, len(hooks))
+	for _, hook := range hooks {
+		hooksByName[hook.name] = hook
+	}
+	for _, hook := range hooks {
+		for _, dependency := range hook.dependsOn {
+			if _, ok := hooksByName[dependency]; !ok {
+				return errors.InvalidArgument(EntityJob, fmt.Sprintf("hook [%s] depends on hook [%s] which is not in the job", hook.name, dependency))
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(hooks))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return errors.InvalidArgument(EntityJob, "hook dependencies have a cycle: "+strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dependency := range hooksByName[name].dependsOn {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, hook := range hooks {
+		if err := visit(hook.name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type Asset map[string]string
 
 func AssetFrom(fileNameToContent map[string]string) (Asset, error) {
@@ -627,9 +806,112 @@ func (s SpecUpstreamName) GetJobName() (Name, error) {
 	return NameFrom(s.String())
 }
 
+// UpstreamRunConfig configures which runs of a static upstream a job waits for.
+// The run offset shifts the window of the job, eg. -24h to wait for the runs of the previous day,
+// while the window replaces the window of the job when checking the upstream runs.
+type UpstreamRunConfig struct {
+	runOffset string
+
+	windowSize       string
+	windowOffset     string
+	windowTruncateTo string
+
+	optional bool
+	maxWait  time.Duration
+}
+
+func NewUpstreamRunConfig(runOffset, windowSize, windowOffset, windowTruncateTo string) (*UpstreamRunConfig, error) {
+	config := &UpstreamRunConfig{
+		runOffset:        runOffset,
+		windowSize:       windowSize,
+		windowOffset:     windowOffset,
+		windowTruncateTo: windowTruncateTo,
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (c UpstreamRunConfig) RunOffset() string {
+	return c.runOffset
+}
+
+func (c UpstreamRunConfig) WindowSize() string {
+	return c.windowSize
+}
+
+func (c UpstreamRunConfig) WindowOffset() string {
+	return c.windowOffset
+}
+
+func (c UpstreamRunConfig) WindowTruncateTo() string {
+	return c.windowTruncateTo
+}
+
+// WithOptional returns a copy of the config for an upstream the job can run without, after waiting for it up to
+// maxWait, or right away when the upstream is disabled. A zero maxWait waits for the upstream as long as the sensor does.
+func (c UpstreamRunConfig) WithOptional(maxWait time.Duration) (*UpstreamRunConfig, error) {
+	c.optional = true
+	c.maxWait = maxWait
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c UpstreamRunConfig) IsOptional() bool {
+	return c.optional
+}
+
+func (c UpstreamRunConfig) MaxWait() time.Duration {
+	return c.maxWait
+}
+
+func (c UpstreamRunConfig) HasWindow() bool {
+	return c.windowSize != ""
+}
+
+// Window returns the window used to check the upstream runs, nil if the window of the job is used
+func (c UpstreamRunConfig) Window() (models.Window, error) {
+	if !c.HasWindow() {
+		return nil, nil
+	}
+	return models.NewWindow(upstreamWindowVersion, c.windowTruncateTo, c.windowOffset, c.windowSize)
+}
+
+func (c UpstreamRunConfig) validate() error {
+	if c.runOffset != "" {
+		if _, err := time.ParseDuration(c.runOffset); err != nil {
+			return errors.InvalidArgument(EntityJob, fmt.Sprintf("invalid run offset %s for upstream: %s", c.runOffset, err))
+		}
+	}
+	if c.maxWait < 0 {
+		return errors.InvalidArgument(EntityJob, fmt.Sprintf("invalid max wait %s for upstream: should not be negative", c.maxWait))
+	}
+	if c.maxWait > 0 && !c.optional {
+		return errors.InvalidArgument(EntityJob, "max wait for upstream is only allowed when the upstream is optional")
+	}
+	if !c.HasWindow() {
+		if c.windowOffset != "" || c.windowTruncateTo != "" {
+			return errors.InvalidArgument(EntityJob, "window size for upstream is required when window offset or truncate_to is set")
+		}
+		return nil
+	}
+	window, err := c.Window()
+	if err != nil {
+		return errors.InvalidArgument(EntityJob, fmt.Sprintf("invalid window for upstream: %s", err))
+	}
+	if err := window.Validate(); err != nil {
+		return errors.InvalidArgument(EntityJob, fmt.Sprintf("invalid window for upstream: %s", err))
+	}
+	return nil
+}
+
 type UpstreamSpec struct {
 	upstreamNames []SpecUpstreamName
 	httpUpstreams []*SpecHTTPUpstream
+	runConfigs    map[SpecUpstreamName]*UpstreamRunConfig
 }
 
 func (s UpstreamSpec) UpstreamNames() []SpecUpstreamName {
@@ -640,11 +922,29 @@ func (s UpstreamSpec) HTTPUpstreams() []*SpecHTTPUpstream {
 	return s.httpUpstreams
 }
 
+func (s UpstreamSpec) RunConfigs() map[SpecUpstreamName]*UpstreamRunConfig {
+	return s.runConfigs
+}
+
 func (s UpstreamSpec) validate() error {
 	me := errors.NewMultiError("errors on spec upstream")
 	for _, u := range s.httpUpstreams {
 		me.Append(u.validate())
 	}
+
+	upstreamNames := make(map[SpecUpstreamName]bool, len(s.upstreamNames))
+	for _, name := range s.upstreamNames {
+		upstreamNames[name] = true
+	}
+	for name, config := range s.runConfigs {
+		if !upstreamNames[name] {
+			me.Append(errors.InvalidArgument(EntityJob, fmt.Sprintf("run config is set for unknown upstream %s", name)))
+			continue
+		}
+		if config != nil {
+			me.Append(config.validate())
+		}
+	}
 	return me.ToErr()
 }
 
@@ -675,6 +975,11 @@ func (s *SpecUpstreamBuilder) WithSpecHTTPUpstream(httpUpstreams []*SpecHTTPUpst
 	return s
 }
 
+func (s *SpecUpstreamBuilder) WithRunConfigs(runConfigs map[SpecUpstreamName]*UpstreamRunConfig) *SpecUpstreamBuilder {
+	s.upstream.runConfigs = runConfigs
+	return s
+}
+
 func NewLabels(labels map[string]string) (map[string]string, error) {
 	if err := validateMap(labels); err != nil {
 		return nil, err
@@ -2,12 +2,14 @@ package job_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/raystack/optimus/core/job"
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/models"
+	"github.com/raystack/optimus/sdk/plugin"
 )
 
 func TestEntitySpec(t *testing.T) {
@@ -227,6 +229,162 @@ func TestEntitySpec(t *testing.T) {
 		})
 	})
 
+	t.Run("UpstreamRunConfig", func(t *testing.T) {
+		t.Run("should return values as inserted", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("-24h", "4h", "-1h", "h")
+			assert.NoError(t, err)
+			assert.Equal(t, "-24h", runConfig.RunOffset())
+			assert.Equal(t, "4h", runConfig.WindowSize())
+			assert.Equal(t, "-1h", runConfig.WindowOffset())
+			assert.Equal(t, "h", runConfig.WindowTruncateTo())
+
+			window, err := runConfig.Window()
+			assert.NoError(t, err)
+			assert.Equal(t, 2, window.GetVersion())
+			assert.Equal(t, "4h", window.GetSize())
+		})
+		t.Run("should return nil window if window size is not set", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("-24h", "", "", "")
+			assert.NoError(t, err)
+			assert.False(t, runConfig.HasWindow())
+
+			window, err := runConfig.Window()
+			assert.NoError(t, err)
+			assert.Nil(t, window)
+		})
+		t.Run("should return error if run offset is invalid", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("yesterday", "", "", "")
+			assert.Nil(t, runConfig)
+			assert.ErrorContains(t, err, "invalid run offset yesterday for upstream")
+		})
+		t.Run("should return error if window is invalid", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("", "4h", "", "y")
+			assert.Nil(t, runConfig)
+			assert.ErrorContains(t, err, "invalid window for upstream")
+		})
+		t.Run("should return error if window offset is set without window size", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("", "", "1h", "")
+			assert.Nil(t, runConfig)
+			assert.ErrorContains(t, err, "window size for upstream is required")
+		})
+		t.Run("should return optional config with max wait", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("-24h", "", "", "")
+			assert.NoError(t, err)
+			assert.False(t, runConfig.IsOptional())
+
+			optionalConfig, err := runConfig.WithOptional(2 * time.Hour)
+			assert.NoError(t, err)
+			assert.True(t, optionalConfig.IsOptional())
+			assert.Equal(t, 2*time.Hour, optionalConfig.MaxWait())
+			assert.Equal(t, "-24h", optionalConfig.RunOffset())
+			assert.False(t, runConfig.IsOptional())
+		})
+		t.Run("should return error if max wait is negative", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("", "", "", "")
+			assert.NoError(t, err)
+
+			optionalConfig, err := runConfig.WithOptional(-time.Hour)
+			assert.Nil(t, optionalConfig)
+			assert.ErrorContains(t, err, "invalid max wait -1h0m0s for upstream")
+		})
+		t.Run("should return error on upstream spec build if run config is set for unknown upstream", func(t *testing.T) {
+			runConfig, err := job.NewUpstreamRunConfig("-24h", "", "", "")
+			assert.NoError(t, err)
+
+			upstreamSpec, err := job.NewSpecUpstreamBuilder().
+				WithUpstreamNames([]job.SpecUpstreamName{"job-a"}).
+				WithRunConfigs(map[job.SpecUpstreamName]*job.UpstreamRunConfig{"job-b": runConfig}).
+				Build()
+			assert.Nil(t, upstreamSpec)
+			assert.ErrorContains(t, err, "run config is set for unknown upstream job-b")
+		})
+	})
+
+	t.Run("Hook", func(t *testing.T) {
+		t.Run("should return values as inserted", func(t *testing.T) {
+			hookWithBehavior, err := job.NewHookBuilder("sample-hook", jobTaskConfig).
+				WithDependsOn([]string{"other-hook"}).
+				WithRunIf(`{{ eq .GLOBAL__ENV "production" }}`).
+				WithRetry(2, time.Minute).
+				WithTimeout(time.Hour).
+				Build()
+			assert.NoError(t, err)
+
+			assert.Equal(t, []string{"other-hook"}, hookWithBehavior.DependsOn())
+			assert.Equal(t, `{{ eq .GLOBAL__ENV "production" }}`, hookWithBehavior.RunIf())
+			assert.True(t, hookWithBehavior.IsRunIfExpression())
+			assert.Equal(t, 2, hookWithBehavior.RetryCount())
+			assert.Equal(t, time.Minute, hookWithBehavior.RetryDelay())
+			assert.Equal(t, time.Hour, hookWithBehavior.Timeout())
+		})
+		t.Run("should return error if run_if is unknown", func(t *testing.T) {
+			_, err := job.NewHookBuilder("sample-hook", jobTaskConfig).WithRunIf("sometimes").Build()
+			assert.ErrorContains(t, err, "run_if of hook [sample-hook] should be success, failure, always or a template")
+		})
+		t.Run("should return error if run_if template cannot be parsed", func(t *testing.T) {
+			_, err := job.NewHookBuilder("sample-hook", jobTaskConfig).WithRunIf("{{ .DSTART").Build()
+			assert.ErrorContains(t, err, "run_if of hook [sample-hook] is invalid")
+		})
+		t.Run("should return error if hook depends on itself", func(t *testing.T) {
+			_, err := job.NewHookBuilder("sample-hook", jobTaskConfig).WithDependsOn([]string{"sample-hook"}).Build()
+			assert.ErrorContains(t, err, "hook [sample-hook] depends on itself")
+		})
+		t.Run("should return error if retry or timeout is negative", func(t *testing.T) {
+			_, err := job.NewHookBuilder("sample-hook", jobTaskConfig).WithRetry(-1, 0).Build()
+			assert.ErrorContains(t, err, "retry of hook [sample-hook] is negative")
+
+			_, err = job.NewHookBuilder("sample-hook", jobTaskConfig).WithTimeout(-time.Second).Build()
+			assert.ErrorContains(t, err, "timeout of hook [sample-hook] is negative")
+		})
+		t.Run("should return error on spec build if hook depends on hook outside of the job", func(t *testing.T) {
+			hookA, _ := job.NewHookBuilder("hook-a", jobTaskConfig).WithDependsOn([]string{"hook-b"}).Build()
+			_, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{hookA}).Build()
+			assert.ErrorContains(t, err, "hook [hook-a] depends on hook [hook-b] which is not in the job")
+		})
+		t.Run("should return error on spec build if hook dependencies have a cycle", func(t *testing.T) {
+			hookA, _ := job.NewHookBuilder("hook-a", jobTaskConfig).WithDependsOn([]string{"hook-c"}).Build()
+			hookB, _ := job.NewHookBuilder("hook-b", jobTaskConfig).WithDependsOn([]string{"hook-a"}).Build()
+			hookC, _ := job.NewHookBuilder("hook-c", jobTaskConfig).WithDependsOn([]string{"hook-b"}).Build()
+			_, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{hookA, hookB, hookC}).Build()
+			assert.ErrorContains(t, err, "hook dependencies have a cycle: hook-a -> hook-c -> hook-b -> hook-a")
+		})
+		t.Run("should build spec with hooks depending on each other", func(t *testing.T) {
+			hookA, _ := job.NewHookBuilder("hook-a", jobTaskConfig).Build()
+			hookB, _ := job.NewHookBuilder("hook-b", jobTaskConfig).WithDependsOn([]string{"hook-a"}).WithRunIf(job.HookRunIfAlways).Build()
+			spec, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{hookA, hookB}).Build()
+			assert.NoError(t, err)
+			assert.Len(t, spec.Hooks(), 2)
+		})
+		t.Run("should return error if hook depends on hook of a type which runs after it", func(t *testing.T) {
+			preHook, _ := job.NewHookBuilder("transporter", jobTaskConfig).WithDependsOn([]string{"predator"}).Build()
+			postHook, _ := job.NewHookBuilder("predator", jobTaskConfig).Build()
+			spec, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{preHook, postHook}).Build()
+			assert.NoError(t, err)
+
+			err = spec.ValidateHookDependencyTypes(map[string]plugin.HookType{"transporter": plugin.HookTypePre, "predator": plugin.HookTypePost})
+			assert.ErrorContains(t, err, "pre hook transporter of job job-A can not depend on post hook predator which runs after it")
+		})
+		t.Run("should allow hooks to depend on hooks of the same type or running before them", func(t *testing.T) {
+			preHook, _ := job.NewHookBuilder("transporter", jobTaskConfig).Build()
+			postHook, _ := job.NewHookBuilder("predator", jobTaskConfig).WithDependsOn([]string{"transporter"}).Build()
+			failHook, _ := job.NewHookBuilder("failureHook", jobTaskConfig).WithDependsOn([]string{"predator"}).Build()
+			spec, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{preHook, postHook, failHook}).Build()
+			assert.NoError(t, err)
+
+			err = spec.ValidateHookDependencyTypes(map[string]plugin.HookType{
+				"transporter": plugin.HookTypePre,
+				"predator":    plugin.HookTypePost,
+				"failureHook": plugin.HookTypeFail,
+			})
+			assert.NoError(t, err)
+		})
+	})
+
 	t.Run("Metadata", func(t *testing.T) {
 		t.Run("should return nil if no error found", func(t *testing.T) {
 			schedulerConf := map[string]string{"key": "val"}
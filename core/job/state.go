@@ -0,0 +1,45 @@
+package job
+
+import (
+	"time"
+
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+// StateChange enables or disables jobs, along with who did it and why. Disabled jobs are enabled back
+// automatically once EnableAt is passed, when it is set.
+type StateChange struct {
+	State     State
+	Remark    string
+	ChangedBy string
+	EnableAt  *time.Time
+}
+
+func NewStateChange(state State, remark, changedBy string, enableAt *time.Time) (*StateChange, error) {
+	if remark == "" {
+		return nil, errors.InvalidArgument(EntityJob, "can not update job state without a valid remark")
+	}
+	if enableAt != nil && state != DISABLED {
+		return nil, errors.InvalidArgument(EntityJob, "enable time can only be set when disabling jobs")
+	}
+	return &StateChange{
+		State:     state,
+		Remark:    remark,
+		ChangedBy: changedBy,
+		EnableAt:  enableAt,
+	}, nil
+}
+
+// DisabledJob is a disabled job along with who disabled it, why and since when
+type DisabledJob struct {
+	Name   Name
+	Tenant tenant.Tenant
+
+	Remark     string
+	DisabledBy string
+	DisabledAt time.Time
+
+	// EnableAt is when the job is enabled back, nil when it stays disabled until enabled manually
+	EnableAt *time.Time
+}
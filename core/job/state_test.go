@@ -0,0 +1,33 @@
+package job_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/job"
+)
+
+func TestStateChange(t *testing.T) {
+	enableAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("returns error when remark is empty", func(t *testing.T) {
+		change, err := job.NewStateChange(job.DISABLED, "", "optimus@example.io", nil)
+		assert.Nil(t, change)
+		assert.EqualError(t, err, "invalid argument for entity job: can not update job state without a valid remark")
+	})
+	t.Run("returns error when enable time is set when enabling", func(t *testing.T) {
+		change, err := job.NewStateChange(job.ENABLED, "done", "optimus@example.io", &enableAt)
+		assert.Nil(t, change)
+		assert.EqualError(t, err, "invalid argument for entity job: enable time can only be set when disabling jobs")
+	})
+	t.Run("returns state change to disable until the enable time", func(t *testing.T) {
+		change, err := job.NewStateChange(job.DISABLED, "maintenance", "optimus@example.io", &enableAt)
+		assert.NoError(t, err)
+		assert.Equal(t, job.DISABLED, change.State)
+		assert.Equal(t, "maintenance", change.Remark)
+		assert.Equal(t, "optimus@example.io", change.ChangedBy)
+		assert.Equal(t, &enableAt, change.EnableAt)
+	})
+}
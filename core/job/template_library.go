@@ -0,0 +1,87 @@
+package job
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/compiler"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+var templateLibraryNamePattern = regexp.MustCompile(`^[\w\-]+$`)
+
+// TemplateLibrary is a template shared by the job assets of a project, or of a namespace when the namespace
+// name is set. Every registration of a library is kept as a new version, assets always use the latest one.
+type TemplateLibrary struct {
+	ProjectName   tenant.ProjectName
+	NamespaceName string
+
+	Name    string
+	Version int
+	Content string
+
+	CreatedAt time.Time
+}
+
+func NewTemplateLibrary(projectName tenant.ProjectName, namespaceName, name, content string) (*TemplateLibrary, error) {
+	if projectName == "" {
+		return nil, errors.InvalidArgument(EntityJob, "project name of template library is empty")
+	}
+	if !templateLibraryNamePattern.MatchString(name) {
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("template library name [%s] should only contain letters, digits, underscore and dash", name))
+	}
+	if content == "" {
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("content of template library [%s] is empty", name))
+	}
+	if err := compiler.ValidateLibrary(name, content); err != nil {
+		return nil, err
+	}
+	return &TemplateLibrary{
+		ProjectName:   projectName,
+		NamespaceName: namespaceName,
+		Name:          name,
+		Content:       content,
+	}, nil
+}
+
+// TemplateName is the name the assets pull in the library with
+func (t *TemplateLibrary) TemplateName() string {
+	return compiler.LibraryTemplateName(t.Name)
+}
+
+// IsUsedBy tells whether the job is in scope of the library and its assets refer to the library
+func (t *TemplateLibrary) IsUsedBy(j *Job) bool {
+	if j.ProjectName() != t.ProjectName {
+		return false
+	}
+	if t.NamespaceName != "" && j.Tenant().NamespaceName().String() != t.NamespaceName {
+		return false
+	}
+	for _, name := range compiler.LibrariesUsedBy(j.Spec().Asset()) {
+		if name == t.Name {
+			return true
+		}
+	}
+	return false
+}
+
+type TemplateLibraries []*TemplateLibrary
+
+// ContentsFor returns the content of the libraries available to the namespace mapped by library name,
+// the library of the namespace takes precedence over the one of the project with the same name
+func (t TemplateLibraries) ContentsFor(namespaceName string) map[string]string {
+	contents := make(map[string]string)
+	for _, library := range t {
+		if library.NamespaceName == "" {
+			contents[library.Name] = library.Content
+		}
+	}
+	for _, library := range t {
+		if library.NamespaceName != "" && library.NamespaceName == namespaceName {
+			contents[library.Name] = library.Content
+		}
+	}
+	return contents
+}
@@ -0,0 +1,67 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestTemplateLibrary(t *testing.T) {
+	projectName := tenant.ProjectName("test-proj")
+
+	t.Run("NewTemplateLibrary", func(t *testing.T) {
+		t.Run("returns error when name is invalid", func(t *testing.T) {
+			library, err := job.NewTemplateLibrary(projectName, "", "lib/dates", `{{ .DSTART }}`)
+			assert.Nil(t, library)
+			assert.EqualError(t, err, "invalid argument for entity job: template library name [lib/dates] should only contain letters, digits, underscore and dash")
+		})
+		t.Run("returns error when content is empty", func(t *testing.T) {
+			library, err := job.NewTemplateLibrary(projectName, "", "dates", "")
+			assert.Nil(t, library)
+			assert.EqualError(t, err, "invalid argument for entity job: content of template library [dates] is empty")
+		})
+		t.Run("returns error when content cannot be parsed", func(t *testing.T) {
+			library, err := job.NewTemplateLibrary(projectName, "", "dates", `{{ .DSTART `)
+			assert.Nil(t, library)
+			assert.ErrorContains(t, err, "unable to parse library dates")
+		})
+		t.Run("returns library with its template name", func(t *testing.T) {
+			library, err := job.NewTemplateLibrary(projectName, "test-ns", "dates", `{{ .DSTART }}`)
+			assert.NoError(t, err)
+			assert.Equal(t, "test-ns", library.NamespaceName)
+			assert.Equal(t, "lib/dates", library.TemplateName())
+		})
+	})
+	t.Run("IsUsedBy", func(t *testing.T) {
+		sampleTenant, _ := tenant.NewTenant(projectName.String(), "test-ns")
+		otherTenant, _ := tenant.NewTenant(projectName.String(), "other-ns")
+		startDate, _ := job.ScheduleDateFrom("2022-10-01")
+		jobSchedule, _ := job.NewScheduleBuilder(startDate).Build()
+		jobWindow, _ := models.NewWindow(2, "d", "24h", "24h")
+		jobTask := job.NewTask("bq2bq", nil)
+		asset := job.Asset(map[string]string{"query.sql": `SELECT * FROM {{ include "lib/dates" . }}`})
+		spec, _ := job.NewSpecBuilder(2, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+
+		projectLibrary, _ := job.NewTemplateLibrary(projectName, "", "dates", `{{ .DSTART }}`)
+		namespaceLibrary, _ := job.NewTemplateLibrary(projectName, "test-ns", "dates", `{{ .DSTART }}`)
+		otherLibrary, _ := job.NewTemplateLibrary(projectName, "", "tables", `{{ .DSTART }}`)
+
+		assert.True(t, projectLibrary.IsUsedBy(job.NewJob(otherTenant, spec, "", nil)))
+		assert.True(t, namespaceLibrary.IsUsedBy(job.NewJob(sampleTenant, spec, "", nil)))
+		assert.False(t, namespaceLibrary.IsUsedBy(job.NewJob(otherTenant, spec, "", nil)))
+		assert.False(t, otherLibrary.IsUsedBy(job.NewJob(sampleTenant, spec, "", nil)))
+	})
+	t.Run("ContentsFor", func(t *testing.T) {
+		projectDates, _ := job.NewTemplateLibrary(projectName, "", "dates", "project dates")
+		projectTables, _ := job.NewTemplateLibrary(projectName, "", "tables", "project tables")
+		namespaceDates, _ := job.NewTemplateLibrary(projectName, "test-ns", "dates", "namespace dates")
+		libraries := job.TemplateLibraries{namespaceDates, projectDates, projectTables}
+
+		assert.Equal(t, map[string]string{"dates": "namespace dates", "tables": "project tables"}, libraries.ContentsFor("test-ns"))
+		assert.Equal(t, map[string]string{"dates": "project dates", "tables": "project tables"}, libraries.ContentsFor("other-ns"))
+	})
+}
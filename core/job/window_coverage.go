@@ -0,0 +1,147 @@
+package job
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/raystack/optimus/internal/errors"
+	"github.com/raystack/optimus/internal/lib/cron"
+	"github.com/raystack/optimus/internal/models"
+)
+
+// windowCoverageSimulatedRuns is the number of schedule times of the downstream job simulated to check the coverage
+const windowCoverageSimulatedRuns = 10
+
+// WindowCoverageGap is a part of the input window of a job run which is not produced by the upstream runs it waits for
+type WindowCoverageGap struct {
+	ScheduledAt time.Time
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+// CheckWindowCoverage simulates the schedule times of the downstream job, starting from the latest start date of both
+// jobs, and returns the parts of their input windows not produced by the output windows of the upstream runs waited for.
+// The input window is the window of the downstream job, or the window of the run config, shifted by its run offset,
+// and the upstream runs waited for are the ones scheduled within it, the same way as the sensor of the upstream.
+// Nothing is checked when the schedule or window of either job is unknown.
+func CheckWindowCoverage(downstream, upstream *Spec, runConfig *UpstreamRunConfig) ([]*WindowCoverageGap, error) {
+	if !hasScheduleAndWindow(downstream) || !hasScheduleAndWindow(upstream) {
+		return nil, nil
+	}
+	downstreamCron, err := cron.ParseCronSchedule(downstream.Schedule().Interval())
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("unable to parse cron interval of job %s: %s", downstream.Name(), err))
+	}
+	upstreamCron, err := cron.ParseCronSchedule(upstream.Schedule().Interval())
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityJob, fmt.Sprintf("unable to parse cron interval of job %s: %s", upstream.Name(), err))
+	}
+
+	inputWindow := downstream.Window()
+	var runOffset time.Duration
+	if runConfig != nil {
+		if runConfig.HasWindow() {
+			if inputWindow, err = runConfig.Window(); err != nil {
+				return nil, err
+			}
+		}
+		if runConfig.RunOffset() != "" {
+			if runOffset, err = time.ParseDuration(runConfig.RunOffset()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	startTime, err := latestStartDate(downstream, upstream)
+	if err != nil {
+		return nil, err
+	}
+
+	var gaps []*WindowCoverageGap
+	scheduledAt := downstreamCron.Next(startTime.Add(-time.Second))
+	for i := 0; i < windowCoverageSimulatedRuns; i++ {
+		inputStart, err := inputWindow.GetStartTime(scheduledAt)
+		if err != nil {
+			return nil, err
+		}
+		inputEnd, err := inputWindow.GetEndTime(scheduledAt)
+		if err != nil {
+			return nil, err
+		}
+		inputStart, inputEnd = inputStart.Add(runOffset), inputEnd.Add(runOffset)
+
+		uncovered, err := uncoveredIntervals(upstream.Window(), upstreamCron, inputStart, inputEnd)
+		if err != nil {
+			return nil, err
+		}
+		for _, interval := range uncovered {
+			gaps = append(gaps, &WindowCoverageGap{ScheduledAt: scheduledAt, StartTime: interval[0], EndTime: interval[1]})
+		}
+		scheduledAt = downstreamCron.Next(scheduledAt)
+	}
+	return gaps, nil
+}
+
+// uncoveredIntervals returns the intervals between start and end time which are not in the output windows of the
+// upstream runs scheduled after the start time up to the end time
+func uncoveredIntervals(upstreamWindow models.Window, upstreamCron *cron.ScheduleSpec, startTime, endTime time.Time) ([][2]time.Time, error) {
+	var outputs [][2]time.Time
+	for runAt := upstreamCron.Next(startTime); !runAt.After(endTime); runAt = upstreamCron.Next(runAt) {
+		outputStart, err := upstreamWindow.GetStartTime(runAt)
+		if err != nil {
+			return nil, err
+		}
+		outputEnd, err := upstreamWindow.GetEndTime(runAt)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, [2]time.Time{outputStart, outputEnd})
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i][0].Before(outputs[j][0])
+	})
+
+	var uncovered [][2]time.Time
+	covered := startTime
+	for _, output := range outputs {
+		if !covered.Before(endTime) {
+			break
+		}
+		if output[0].After(covered) {
+			uncovered = append(uncovered, [2]time.Time{covered, minTime(output[0], endTime)})
+		}
+		if output[1].After(covered) {
+			covered = output[1]
+		}
+	}
+	if covered.Before(endTime) {
+		uncovered = append(uncovered, [2]time.Time{covered, endTime})
+	}
+	return uncovered, nil
+}
+
+func hasScheduleAndWindow(spec *Spec) bool {
+	return spec.Schedule() != nil && spec.Schedule().Interval() != "" && spec.Window() != nil
+}
+
+func latestStartDate(specs ...*Spec) (time.Time, error) {
+	var latest time.Time
+	for _, spec := range specs {
+		startDate, err := time.Parse(DateLayout, spec.Schedule().StartDate().String())
+		if err != nil {
+			return time.Time{}, errors.InvalidArgument(EntityJob, fmt.Sprintf("invalid start date of job %s: %s", spec.Name(), err))
+		}
+		if startDate.After(latest) {
+			latest = startDate
+		}
+	}
+	return latest, nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
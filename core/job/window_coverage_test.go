@@ -0,0 +1,85 @@
+package job_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestCheckWindowCoverage(t *testing.T) {
+	startDate, _ := job.ScheduleDateFrom("2023-01-01")
+	jobTaskConfig, _ := job.ConfigFrom(map[string]string{"sample_task_key": "sample_value"})
+	jobTask := job.NewTask("bq2bq", jobTaskConfig)
+	dailyWindow, _ := models.NewWindow(2, "d", "", "24h")
+	hourlyWindow, _ := models.NewWindow(2, "h", "", "1h")
+
+	specWith := func(name job.Name, interval string, window models.Window) *job.Spec {
+		schedule, _ := job.NewScheduleBuilder(startDate).WithInterval(interval).Build()
+		spec, _ := job.NewSpecBuilder(2, name, "sample-owner", schedule, window, jobTask).Build()
+		return spec
+	}
+
+	t.Run("should return no gap when the upstream runs produce the whole input window", func(t *testing.T) {
+		downstream := specWith("job-A", "0 0 * * *", dailyWindow)
+		upstream := specWith("job-B", "0 * * * *", hourlyWindow)
+
+		gaps, err := job.CheckWindowCoverage(downstream, upstream, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, gaps)
+	})
+	t.Run("should return the part of the input window not produced by the upstream runs", func(t *testing.T) {
+		downstream := specWith("job-A", "0 0 * * *", dailyWindow)
+		upstream := specWith("job-B", "0 0 * * *", hourlyWindow)
+
+		gaps, err := job.CheckWindowCoverage(downstream, upstream, nil)
+		assert.NoError(t, err)
+		assert.Len(t, gaps, 10)
+		assert.Equal(t, &job.WindowCoverageGap{
+			ScheduledAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			StartTime:   time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+			EndTime:     time.Date(2022, 12, 31, 23, 0, 0, 0, time.UTC),
+		}, gaps[0])
+	})
+	t.Run("should return gaps when the upstream is not scheduled within the input window", func(t *testing.T) {
+		downstream := specWith("job-A", "0 * * * *", hourlyWindow)
+		upstream := specWith("job-B", "0 0 * * *", dailyWindow)
+
+		gaps, err := job.CheckWindowCoverage(downstream, upstream, nil)
+		assert.NoError(t, err)
+		assert.Len(t, gaps, 9)
+		assert.Equal(t, &job.WindowCoverageGap{
+			ScheduledAt: time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC),
+			StartTime:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:     time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC),
+		}, gaps[0])
+	})
+	t.Run("should use the window and run offset of the run config as input window", func(t *testing.T) {
+		downstream := specWith("job-A", "0 * * * *", hourlyWindow)
+		upstream := specWith("job-B", "0 0 * * *", dailyWindow)
+		runConfig, _ := job.NewUpstreamRunConfig("-24h", "24h", "", "d")
+
+		gaps, err := job.CheckWindowCoverage(downstream, upstream, runConfig)
+		assert.NoError(t, err)
+		assert.Empty(t, gaps)
+	})
+	t.Run("should return no gap when the schedule interval is unknown", func(t *testing.T) {
+		downstream := specWith("job-A", "", dailyWindow)
+		upstream := specWith("job-B", "0 0 * * *", hourlyWindow)
+
+		gaps, err := job.CheckWindowCoverage(downstream, upstream, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, gaps)
+	})
+	t.Run("should return error when the schedule interval is invalid", func(t *testing.T) {
+		downstream := specWith("job-A", "0 0 * * *", dailyWindow)
+		upstream := specWith("job-B", "every day", hourlyWindow)
+
+		gaps, err := job.CheckWindowCoverage(downstream, upstream, nil)
+		assert.Nil(t, gaps)
+		assert.ErrorContains(t, err, "unable to parse cron interval of job job-B")
+	})
+}
@@ -0,0 +1,146 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/raystack/optimus/internal/errors"
+)
+
+const (
+	DurationAnomalyConfigSensitivity = "sensitivity"
+	DurationAnomalyConfigMinHistory  = "min_history"
+
+	DefaultDurationAnomalySensitivity = 3.0
+	DefaultDurationAnomalyMinHistory  = 10
+
+	// DurationAnomalyHistorySize is the number of past runs the baseline is rolled over
+	DurationAnomalyHistorySize = 30
+
+	// minDurationAnomalyDeviation keeps the band from collapsing when past runs take almost the same time
+	minDurationAnomalyDeviation = time.Minute
+)
+
+type DurationAnomalyConfig struct {
+	// Sensitivity is the number of median absolute deviations a run can be away from the median
+	Sensitivity float64
+	// MinHistory is the number of past runs required before a run is compared against the baseline
+	MinHistory int
+}
+
+func DurationAnomalyConfigFrom(config map[string]string) (*DurationAnomalyConfig, error) {
+	anomalyConfig := &DurationAnomalyConfig{
+		Sensitivity: DefaultDurationAnomalySensitivity,
+		MinHistory:  DefaultDurationAnomalyMinHistory,
+	}
+	if sensitivity, ok := config[DurationAnomalyConfigSensitivity]; ok {
+		value, err := strconv.ParseFloat(sensitivity, 64)
+		if err != nil || value <= 0 {
+			return nil, errors.InvalidArgument(EntityEvent, "duration anomaly sensitivity should be a positive number: "+sensitivity)
+		}
+		anomalyConfig.Sensitivity = value
+	}
+	if minHistory, ok := config[DurationAnomalyConfigMinHistory]; ok {
+		value, err := strconv.Atoi(minHistory)
+		if err != nil || value <= 0 {
+			return nil, errors.InvalidArgument(EntityEvent, "duration anomaly min_history should be a positive integer: "+minHistory)
+		}
+		anomalyConfig.MinHistory = value
+	}
+	return anomalyConfig, nil
+}
+
+// HistorySize is the number of past runs to build the baseline on, at least the min history
+func (c DurationAnomalyConfig) HistorySize() int {
+	if c.MinHistory > DurationAnomalyHistorySize {
+		return c.MinHistory
+	}
+	return DurationAnomalyHistorySize
+}
+
+// DurationBaseline is the band of expected durations, median ± sensitivity × MAD of the past runs
+type DurationBaseline struct {
+	Median     time.Duration
+	LowerBound time.Duration
+	UpperBound time.Duration
+}
+
+func NewDurationBaseline(durations []time.Duration, sensitivity float64) *DurationBaseline {
+	median := medianDuration(durations)
+
+	deviations := make([]time.Duration, len(durations))
+	for i, duration := range durations {
+		deviations[i] = absDuration(duration - median)
+	}
+	mad := medianDuration(deviations)
+
+	deviation := time.Duration(sensitivity * float64(mad))
+	if deviation < minDurationAnomalyDeviation {
+		deviation = minDurationAnomalyDeviation
+	}
+	lowerBound := median - deviation
+	if lowerBound < 0 {
+		lowerBound = 0
+	}
+	return &DurationBaseline{
+		Median:     median,
+		LowerBound: lowerBound,
+		UpperBound: median + deviation,
+	}
+}
+
+func (b *DurationBaseline) IsAnomaly(duration time.Duration) bool {
+	return duration < b.LowerBound || duration > b.UpperBound
+}
+
+func (b *DurationBaseline) String() string {
+	return fmt.Sprintf("%s - %s", b.LowerBound.Round(time.Second), b.UpperBound.Round(time.Second))
+}
+
+// NewDurationAnomalyEvent creates the event to notify for a finished job run whose duration is outside the baseline
+func NewDurationAnomalyEvent(finishedEvent *Event, duration time.Duration, baseline *DurationBaseline) *Event {
+	values := map[string]any{
+		"scheduled_at":      finishedEvent.JobScheduledAt.UTC().Format(ISODateFormat),
+		"duration":          duration.Round(time.Second).String(),
+		"median_duration":   baseline.Median.Round(time.Second).String(),
+		"expected_duration": baseline.String(),
+	}
+	for _, key := range []string{"job_url", "log_url"} {
+		if value, ok := finishedEvent.Values[key]; ok {
+			values[key] = value
+		}
+	}
+	return &Event{
+		JobName:        finishedEvent.JobName,
+		Tenant:         finishedEvent.Tenant,
+		Type:           DurationAnomalyEvent,
+		EventTime:      finishedEvent.EventTime,
+		Status:         finishedEvent.Status,
+		JobScheduledAt: finishedEvent.JobScheduledAt,
+		Values:         values,
+	}
+}
+
+func medianDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func absDuration(duration time.Duration) time.Duration {
+	if duration < 0 {
+		return -duration
+	}
+	return duration
+}
@@ -0,0 +1,95 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestDurationAnomaly(t *testing.T) {
+	t.Run("DurationAnomalyConfigFrom", func(t *testing.T) {
+		t.Run("returns default config when not configured", func(t *testing.T) {
+			config, err := scheduler.DurationAnomalyConfigFrom(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.DefaultDurationAnomalySensitivity, config.Sensitivity)
+			assert.Equal(t, scheduler.DefaultDurationAnomalyMinHistory, config.MinHistory)
+			assert.Equal(t, scheduler.DurationAnomalyHistorySize, config.HistorySize())
+		})
+		t.Run("returns config with the sensitivity and min history", func(t *testing.T) {
+			config, err := scheduler.DurationAnomalyConfigFrom(map[string]string{
+				"sensitivity": "2.5",
+				"min_history": "40",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, 2.5, config.Sensitivity)
+			assert.Equal(t, 40, config.MinHistory)
+			assert.Equal(t, 40, config.HistorySize())
+		})
+		t.Run("returns error when sensitivity is invalid", func(t *testing.T) {
+			config, err := scheduler.DurationAnomalyConfigFrom(map[string]string{"sensitivity": "-1"})
+			assert.Nil(t, config)
+			assert.EqualError(t, err, "invalid argument for entity event: duration anomaly sensitivity should be a positive number: -1")
+		})
+		t.Run("returns error when min history is invalid", func(t *testing.T) {
+			config, err := scheduler.DurationAnomalyConfigFrom(map[string]string{"min_history": "ten"})
+			assert.Nil(t, config)
+			assert.EqualError(t, err, "invalid argument for entity event: duration anomaly min_history should be a positive integer: ten")
+		})
+	})
+	t.Run("DurationBaseline", func(t *testing.T) {
+		durations := []time.Duration{
+			time.Minute * 10, time.Minute * 12, time.Minute * 11, time.Minute * 14, time.Minute * 9,
+		}
+		t.Run("returns band of median and MAD times sensitivity", func(t *testing.T) {
+			baseline := scheduler.NewDurationBaseline(durations, 3)
+			assert.Equal(t, time.Minute*11, baseline.Median)
+			assert.Equal(t, time.Minute*8, baseline.LowerBound)
+			assert.Equal(t, time.Minute*14, baseline.UpperBound)
+			assert.Equal(t, "8m0s - 14m0s", baseline.String())
+		})
+		t.Run("detects durations outside the band as anomaly", func(t *testing.T) {
+			baseline := scheduler.NewDurationBaseline(durations, 3)
+			assert.False(t, baseline.IsAnomaly(time.Minute*13))
+			assert.True(t, baseline.IsAnomaly(time.Minute*30))
+			assert.True(t, baseline.IsAnomaly(time.Minute*2))
+		})
+		t.Run("keeps a minimum band when past runs take the same time", func(t *testing.T) {
+			baseline := scheduler.NewDurationBaseline([]time.Duration{time.Minute * 5, time.Minute * 5, time.Minute * 5}, 3)
+			assert.False(t, baseline.IsAnomaly(time.Minute*5+time.Second*30))
+			assert.True(t, baseline.IsAnomaly(time.Minute*7))
+		})
+	})
+	t.Run("NewDurationAnomalyEvent", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant("proj", "ns")
+		scheduledAt := time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC)
+		finishedEvent := &scheduler.Event{
+			JobName:        "job1",
+			Tenant:         tnnt,
+			Type:           scheduler.JobSuccessEvent,
+			EventTime:      scheduledAt.Add(time.Minute * 30),
+			Status:         scheduler.StateSuccess,
+			JobScheduledAt: scheduledAt,
+			Values: map[string]any{
+				"job_url": "http://scheduler/job1",
+				"task_id": "job1",
+			},
+		}
+		baseline := scheduler.NewDurationBaseline([]time.Duration{time.Minute * 10}, 3)
+
+		anomalyEvent := scheduler.NewDurationAnomalyEvent(finishedEvent, time.Minute*30, baseline)
+		assert.Equal(t, scheduler.DurationAnomalyEvent, anomalyEvent.Type)
+		assert.True(t, anomalyEvent.Type.IsOfType(scheduler.EventCategoryDurationAnomaly))
+		assert.Equal(t, finishedEvent.JobName, anomalyEvent.JobName)
+		assert.Equal(t, map[string]any{
+			"scheduled_at":      "2023-01-01T02:00:00Z",
+			"duration":          "30m0s",
+			"median_duration":   "10m0s",
+			"expected_duration": "9m0s - 11m0s",
+			"job_url":           "http://scheduler/job1",
+		}, anomalyEvent.Values)
+	})
+}
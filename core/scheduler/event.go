@@ -23,12 +23,21 @@ const (
 
 	ISODateFormat = "2006-01-02T15:04:05Z"
 
-	EventCategorySLAMiss    JobEventCategory = "sla_miss"
-	EventCategoryJobFailure JobEventCategory = "failure"
+	EventCategorySLAMiss         JobEventCategory = "sla_miss"
+	EventCategoryJobFailure      JobEventCategory = "failure"
+	EventCategoryDurationAnomaly JobEventCategory = "duration_anomaly"
+	EventCategorySLADeadlineMiss JobEventCategory = "sla_deadline_miss"
+	EventCategoryMissingRun      JobEventCategory = "missing_run"
 
-	SLAMissEvent    JobEventType = "sla_miss"
-	JobFailureEvent JobEventType = "failure"
-	JobSuccessEvent JobEventType = "job_success"
+	SLAMissEvent         JobEventType = "sla_miss"
+	JobFailureEvent      JobEventType = "failure"
+	JobSuccessEvent      JobEventType = "job_success"
+	DurationAnomalyEvent JobEventType = "duration_anomaly"
+	SLADeadlineMissEvent JobEventType = "sla_deadline_miss"
+	MissingRunEvent      JobEventType = "missing_run"
+
+	// SLADeadlineConfigKey is the offset from the window end of a run by which the run should finish
+	SLADeadlineConfigKey = "deadline"
 
 	TaskStartEvent   JobEventType = "task_start"
 	TaskRetryEvent   JobEventType = "task_retry"
@@ -103,6 +112,26 @@ type Event struct {
 	JobScheduledAt time.Time
 	Values         map[string]any
 	SLAObjectList  []*SLAObject
+
+	// UpstreamRuns are the upstream runs a sensor was satisfied by
+	UpstreamRuns []*JobRunRef
+	// SkippedUpstream is the optional upstream a sensor proceeded without, the data of the job run might be partial
+	SkippedUpstream *SkippedUpstream
+}
+
+// MonitoringValues are the values reported by the task of the job run under the monitoring key of the event
+func (e *Event) MonitoringValues() map[string]any {
+	var output map[string]any
+	if value, ok := e.Values["monitoring"]; ok && value != nil {
+		output, _ = value.(map[string]any)
+	}
+	return output
+}
+
+type SkippedUpstream struct {
+	JobName JobName
+	Tenant  tenant.Tenant
+	Reason  string
 }
 
 func (event JobEventType) IsOfType(category JobEventCategory) bool {
@@ -115,6 +144,18 @@ func (event JobEventType) IsOfType(category JobEventCategory) bool {
 		if event == SLAMissEvent {
 			return true
 		}
+	case EventCategoryDurationAnomaly:
+		if event == DurationAnomalyEvent {
+			return true
+		}
+	case EventCategorySLADeadlineMiss:
+		if event == SLADeadlineMissEvent {
+			return true
+		}
+	case EventCategoryMissingRun:
+		if event == MissingRunEvent {
+			return true
+		}
 	}
 	return false
 }
@@ -123,6 +164,42 @@ func (event JobEventType) String() string {
 	return string(event)
 }
 
+// NewSLADeadlineMissEvent creates the event to notify for a job run not finished by its deadline
+func NewSLADeadlineMissEvent(jobRun *JobRun, deadline, detectedAt time.Time) *Event {
+	return &Event{
+		JobName:        jobRun.JobName,
+		Tenant:         jobRun.Tenant,
+		Type:           SLADeadlineMissEvent,
+		EventTime:      detectedAt,
+		Status:         jobRun.State,
+		JobScheduledAt: jobRun.ScheduledAt,
+		Values: map[string]any{
+			"scheduled_at": jobRun.ScheduledAt.UTC().Format(ISODateFormat),
+			"deadline":     deadline.UTC().Format(ISODateFormat),
+			"state":        jobRun.State.String(),
+		},
+	}
+}
+
+// NewMissingRunEvent creates the event to notify for the scheduled times of a job found without a successful run
+func NewMissingRunEvent(gap *JobRunGap, detectedAt time.Time) *Event {
+	missingRuns := make([]string, len(gap.ScheduledAt))
+	for i, scheduledAt := range gap.ScheduledAt {
+		missingRuns[i] = scheduledAt.UTC().Format(ISODateFormat)
+	}
+	return &Event{
+		JobName:        gap.JobName,
+		Tenant:         gap.Tenant,
+		Type:           MissingRunEvent,
+		EventTime:      detectedAt,
+		JobScheduledAt: gap.StartDate(),
+		Values: map[string]any{
+			"scheduled_at": gap.StartDate().UTC().Format(ISODateFormat),
+			"missing_runs": strings.Join(missingRuns, ", "),
+		},
+	}
+}
+
 func EventFrom(eventTypeName string, eventValues map[string]any, jobName JobName, tenent tenant.Tenant) (*Event, error) {
 	eventType, err := FromStringToEventType(eventTypeName)
 	if err != nil {
@@ -195,6 +272,89 @@ func EventFrom(eventTypeName string, eventValues map[string]any, jobName JobName
 			return nil, errors.InvalidArgument(EntityEvent, "property 'scheduled_at' is not in appropriate format")
 		}
 		eventObj.JobScheduledAt = scheduledAtTimeStamp
+
+		if eventType == SensorSuccessEvent {
+			upstreamRuns, err := upstreamRunsFrom(eventValues)
+			if err != nil {
+				return nil, err
+			}
+			eventObj.UpstreamRuns = upstreamRuns
+
+			skippedUpstream, err := skippedUpstreamFrom(eventValues)
+			if err != nil {
+				return nil, err
+			}
+			eventObj.SkippedUpstream = skippedUpstream
+		}
 	}
 	return &eventObj, nil
 }
+
+func upstreamRunsFrom(eventValues map[string]any) ([]*JobRunRef, error) {
+	type upstreamRunsInput struct {
+		UpstreamRuns []struct {
+			ProjectName   string `mapstructure:"project_name"`
+			NamespaceName string `mapstructure:"namespace_name"`
+			JobName       string `mapstructure:"job_name"`
+			ScheduledAt   string `mapstructure:"scheduled_at"`
+		} `mapstructure:"upstream_runs"`
+	}
+	var input upstreamRunsInput
+	if err := mapstructure.Decode(eventValues, &input); err != nil {
+		return nil, errors.InvalidArgument(EntityEvent, "bad upstream_runs payload")
+	}
+
+	var upstreamRuns []*JobRunRef
+	for _, upstreamRun := range input.UpstreamRuns {
+		upstreamTenant, err := tenant.NewTenant(upstreamRun.ProjectName, upstreamRun.NamespaceName)
+		if err != nil {
+			return nil, errors.InvalidArgument(EntityEvent, "invalid tenant of upstream run: "+err.Error())
+		}
+		upstreamJobName, err := JobNameFrom(upstreamRun.JobName)
+		if err != nil {
+			return nil, errors.InvalidArgument(EntityEvent, "empty job name of upstream run")
+		}
+		scheduledAt, err := time.Parse(ISODateFormat, upstreamRun.ScheduledAt)
+		if err != nil {
+			return nil, errors.InvalidArgument(EntityEvent, "property 'scheduled_at' in upstream_runs list is not in appropriate format")
+		}
+		upstreamRuns = append(upstreamRuns, &JobRunRef{
+			JobName:     upstreamJobName,
+			Tenant:      upstreamTenant,
+			ScheduledAt: scheduledAt,
+		})
+	}
+	return upstreamRuns, nil
+}
+
+func skippedUpstreamFrom(eventValues map[string]any) (*SkippedUpstream, error) {
+	type skippedUpstreamInput struct {
+		SkippedUpstream *struct {
+			ProjectName   string `mapstructure:"project_name"`
+			NamespaceName string `mapstructure:"namespace_name"`
+			JobName       string `mapstructure:"job_name"`
+			Reason        string `mapstructure:"reason"`
+		} `mapstructure:"skipped_upstream"`
+	}
+	var input skippedUpstreamInput
+	if err := mapstructure.Decode(eventValues, &input); err != nil {
+		return nil, errors.InvalidArgument(EntityEvent, "bad skipped_upstream payload")
+	}
+	if input.SkippedUpstream == nil {
+		return nil, nil
+	}
+
+	upstreamTenant, err := tenant.NewTenant(input.SkippedUpstream.ProjectName, input.SkippedUpstream.NamespaceName)
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityEvent, "invalid tenant of skipped upstream: "+err.Error())
+	}
+	upstreamJobName, err := JobNameFrom(input.SkippedUpstream.JobName)
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityEvent, "empty job name of skipped upstream")
+	}
+	return &SkippedUpstream{
+		JobName: upstreamJobName,
+		Tenant:  upstreamTenant,
+		Reason:  input.SkippedUpstream.Reason,
+	}, nil
+}
@@ -211,11 +211,105 @@ func TestFromStringToEventType(t *testing.T) {
 			assert.Equal(t, outputObj.JobScheduledAt, output.JobScheduledAt)
 			assert.Equal(t, &outputObj, output)
 		})
+		t.Run("Should parse the upstream runs of a sensor success event", func(t *testing.T) {
+			eventValues := map[string]any{
+				"event_time":   16000631600.0,
+				"task_id":      "wait_upstream_job",
+				"status":       "success",
+				"scheduled_at": "2022-01-02T15:04:05Z",
+				"upstream_runs": []any{
+					map[string]any{
+						"project_name":   "upstreamProject",
+						"namespace_name": "upstreamNamespace",
+						"job_name":       "upstream_job",
+						"scheduled_at":   "2022-01-02T14:00:00Z",
+					},
+				},
+			}
+			tnnt, _ := tenant.NewTenant("someProject", "someNamespace")
+			upstreamTnnt, _ := tenant.NewTenant("upstreamProject", "upstreamNamespace")
+
+			output, err := scheduler.EventFrom("TYPE_SENSOR_SUCCESS", eventValues, "some_job", tnnt)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobRunRef{
+				{
+					JobName:     "upstream_job",
+					Tenant:      upstreamTnnt,
+					ScheduledAt: time.Date(2022, time.January, 2, 14, 0, 0, 0, time.UTC),
+				},
+			}, output.UpstreamRuns)
+		})
+		t.Run("Should parse the skipped upstream of a sensor success event", func(t *testing.T) {
+			eventValues := map[string]any{
+				"event_time":   16000631600.0,
+				"task_id":      "wait_upstream_job",
+				"status":       "success",
+				"scheduled_at": "2022-01-02T15:04:05Z",
+				"skipped_upstream": map[string]any{
+					"project_name":   "upstreamProject",
+					"namespace_name": "upstreamNamespace",
+					"job_name":       "upstream_job",
+					"reason":         "upstream is disabled",
+				},
+			}
+			tnnt, _ := tenant.NewTenant("someProject", "someNamespace")
+			upstreamTnnt, _ := tenant.NewTenant("upstreamProject", "upstreamNamespace")
+
+			output, err := scheduler.EventFrom("TYPE_SENSOR_SUCCESS", eventValues, "some_job", tnnt)
+			assert.Nil(t, err)
+			assert.Empty(t, output.UpstreamRuns)
+			assert.Equal(t, &scheduler.SkippedUpstream{
+				JobName: "upstream_job",
+				Tenant:  upstreamTnnt,
+				Reason:  "upstream is disabled",
+			}, output.SkippedUpstream)
+		})
+		t.Run("Should return error if job name of skipped upstream is empty", func(t *testing.T) {
+			eventValues := map[string]any{
+				"event_time":   16000631600.0,
+				"task_id":      "wait_upstream_job",
+				"status":       "success",
+				"scheduled_at": "2022-01-02T15:04:05Z",
+				"skipped_upstream": map[string]any{
+					"project_name":   "upstreamProject",
+					"namespace_name": "upstreamNamespace",
+				},
+			}
+			tnnt, _ := tenant.NewTenant("someProject", "someNamespace")
+
+			output, err := scheduler.EventFrom("TYPE_SENSOR_SUCCESS", eventValues, "some_job", tnnt)
+			assert.Nil(t, output)
+			assert.EqualError(t, err, "invalid argument for entity event: empty job name of skipped upstream")
+		})
+		t.Run("Should return error if scheduled_at of upstream run is incorrect format", func(t *testing.T) {
+			eventValues := map[string]any{
+				"event_time":   16000631600.0,
+				"task_id":      "wait_upstream_job",
+				"status":       "success",
+				"scheduled_at": "2022-01-02T15:04:05Z",
+				"upstream_runs": []any{
+					map[string]any{
+						"project_name":   "upstreamProject",
+						"namespace_name": "upstreamNamespace",
+						"job_name":       "upstream_job",
+						"scheduled_at":   "2022-01-02",
+					},
+				},
+			}
+			tnnt, _ := tenant.NewTenant("someProject", "someNamespace")
+
+			output, err := scheduler.EventFrom("TYPE_SENSOR_SUCCESS", eventValues, "some_job", tnnt)
+			assert.Nil(t, output)
+			assert.EqualError(t, err, "invalid argument for entity event: property 'scheduled_at' in upstream_runs list is not in appropriate format")
+		})
 	})
 	t.Run("IsOfType JobEventCategory", func(t *testing.T) {
 		positiveExpectationMap := map[scheduler.JobEventType]scheduler.JobEventCategory{
-			scheduler.JobFailureEvent: scheduler.EventCategoryJobFailure,
-			scheduler.SLAMissEvent:    scheduler.EventCategorySLAMiss,
+			scheduler.JobFailureEvent:      scheduler.EventCategoryJobFailure,
+			scheduler.SLAMissEvent:         scheduler.EventCategorySLAMiss,
+			scheduler.DurationAnomalyEvent: scheduler.EventCategoryDurationAnomaly,
+			scheduler.SLADeadlineMissEvent: scheduler.EventCategorySLADeadlineMiss,
+			scheduler.MissingRunEvent:      scheduler.EventCategoryMissingRun,
 		}
 		for eventType, category := range positiveExpectationMap {
 			assert.True(t, eventType.IsOfType(category))
@@ -224,9 +318,64 @@ func TestFromStringToEventType(t *testing.T) {
 			scheduler.SLAMissEvent:       scheduler.EventCategoryJobFailure,
 			scheduler.SensorRetryEvent:   scheduler.EventCategoryJobFailure,
 			scheduler.SensorSuccessEvent: scheduler.EventCategorySLAMiss,
+			scheduler.JobSuccessEvent:    scheduler.EventCategoryDurationAnomaly,
+			scheduler.JobFailureEvent:    scheduler.EventCategorySLADeadlineMiss,
+			scheduler.TaskFailEvent:      scheduler.EventCategoryMissingRun,
 		}
 		for eventType, category := range negativeExpectationMap {
 			assert.False(t, eventType.IsOfType(category))
 		}
 	})
+	t.Run("NewSLADeadlineMissEvent", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant("proj", "ns")
+		scheduledAt := time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)
+		deadline := time.Date(2023, 1, 2, 7, 0, 0, 0, time.UTC)
+		detectedAt := deadline.Add(time.Minute * 3)
+		jobRun := &scheduler.JobRun{
+			JobName:     "job1",
+			Tenant:      tnnt,
+			State:       scheduler.StateInProgress,
+			ScheduledAt: scheduledAt,
+		}
+
+		event := scheduler.NewSLADeadlineMissEvent(jobRun, deadline, detectedAt)
+		assert.Equal(t, &scheduler.Event{
+			JobName:        "job1",
+			Tenant:         tnnt,
+			Type:           scheduler.SLADeadlineMissEvent,
+			EventTime:      detectedAt,
+			Status:         scheduler.StateInProgress,
+			JobScheduledAt: scheduledAt,
+			Values: map[string]any{
+				"scheduled_at": "2023-01-02T02:00:00Z",
+				"deadline":     "2023-01-02T07:00:00Z",
+				"state":        "in_progress",
+			},
+		}, event)
+	})
+	t.Run("NewMissingRunEvent", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant("proj", "ns")
+		detectedAt := time.Date(2023, 1, 3, 5, 0, 0, 0, time.UTC)
+		gap := &scheduler.JobRunGap{
+			JobName: "job1",
+			Tenant:  tnnt,
+			ScheduledAt: []time.Time{
+				time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC),
+			},
+		}
+
+		event := scheduler.NewMissingRunEvent(gap, detectedAt)
+		assert.Equal(t, &scheduler.Event{
+			JobName:        "job1",
+			Tenant:         tnnt,
+			Type:           scheduler.MissingRunEvent,
+			EventTime:      detectedAt,
+			JobScheduledAt: gap.ScheduledAt[0],
+			Values: map[string]any{
+				"scheduled_at": "2023-01-01T02:00:00Z",
+				"missing_runs": "2023-01-01T02:00:00Z, 2023-01-02T02:00:00Z",
+			},
+		}, event)
+	})
 }
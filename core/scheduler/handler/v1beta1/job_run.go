@@ -2,8 +2,12 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/raystack/salt/log"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/raystack/optimus/core/scheduler"
@@ -17,16 +21,32 @@ type JobRunService interface {
 	UpdateJobState(context.Context, *scheduler.Event) error
 	GetJobRuns(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, criteria *scheduler.JobRunsCriteria) ([]*scheduler.JobRunStatus, error)
 	UploadToScheduler(ctx context.Context, projectName tenant.ProjectName) error
+	UpdateJobRunState(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time, change *scheduler.JobRunStateChange) ([]*scheduler.JobRunStatus, error)
+	GetCompiledJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) ([]byte, error)
+	GetJobRunStats(ctx context.Context, criteria *scheduler.JobRunStatsCriteria) (*scheduler.JobRunStats, error)
+	GetJobRunGaps(ctx context.Context, projectName tenant.ProjectName, namespaceName string, startTime, endTime time.Time) ([]*scheduler.JobRunGap, error)
+	GetJobRunLineage(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time, direction scheduler.LineageDirection, depth int) (*scheduler.JobRunLineage, error)
+	GetJobRunMetrics(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.MetricSeries, error)
+}
+
+type Reconciler interface {
+	Reconcile(ctx context.Context, projectName tenant.ProjectName, namespaceName string, fix bool) ([]*scheduler.JobDrift, error)
 }
 
 type Notifier interface {
 	Push(ctx context.Context, event *scheduler.Event) error
 }
 
+type JobRunWatcher interface {
+	Watch(ctx context.Context, filter *scheduler.JobRunWatchFilter, onChange func(*scheduler.JobRun) error) error
+}
+
 type JobRunHandler struct {
-	l        log.Logger
-	service  JobRunService
-	notifier Notifier
+	l          log.Logger
+	service    JobRunService
+	notifier   Notifier
+	reconciler Reconciler
+	watcher    JobRunWatcher
 
 	pb.UnimplementedJobRunServiceServer
 }
@@ -107,8 +127,10 @@ func (h JobRunHandler) JobRun(ctx context.Context, req *pb.JobRunRequest) (*pb.J
 	for _, run := range jobRuns {
 		ts := timestamppb.New(run.ScheduledAt)
 		runs = append(runs, &pb.JobRun{
-			State:       run.State.String(),
-			ScheduledAt: ts,
+			State:             run.State.String(),
+			ScheduledAt:       ts,
+			StateChangedBy:    run.StateChangedBy,
+			StateChangeReason: run.StateChangeReason,
 		})
 	}
 	return &pb.JobRunResponse{JobRuns: runs}, nil
@@ -127,14 +149,367 @@ func buildCriteriaForJobRun(req *pb.JobRunRequest) (*scheduler.JobRunsCriteria,
 	if !req.GetEndDate().IsValid() {
 		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "empty end date is given")
 	}
+	var runOffset time.Duration
+	if req.GetRunOffset() != "" {
+		var err error
+		runOffset, err = time.ParseDuration(req.GetRunOffset())
+		if err != nil {
+			return nil, errors.InvalidArgument(scheduler.EntityJobRun, "invalid run offset: "+req.GetRunOffset())
+		}
+	}
 	return &scheduler.JobRunsCriteria{
 		Name:      req.GetJobName(),
 		StartDate: req.GetStartDate().AsTime(),
 		EndDate:   req.GetEndDate().AsTime(),
 		Filter:    req.GetFilter(),
+		RunOffset: runOffset,
 	}, nil
 }
 
+func (h JobRunHandler) UpdateJobRunState(ctx context.Context, req *pb.UpdateJobRunStateRequest) (*pb.UpdateJobRunStateResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to update job run state for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		h.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to update job run state for "+req.GetJobName())
+	}
+
+	if err := req.GetStartDate().CheckValid(); err != nil {
+		h.l.Error("invalid start date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid start_date"), "unable to update job run state for "+req.GetJobName())
+	}
+	startDate := req.GetStartDate().AsTime()
+	endDate := startDate
+	if req.GetEndDate() != nil {
+		if err := req.GetEndDate().CheckValid(); err != nil {
+			h.l.Error("invalid end date: %s", err)
+			return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid end_date"), "unable to update job run state for "+req.GetJobName())
+		}
+		endDate = req.GetEndDate().AsTime()
+	}
+
+	change, err := scheduler.NewJobRunStateChange(req.GetState(), req.GetChangedBy(), req.GetReason())
+	if err != nil {
+		h.l.Error("error adapting job run state change: %s", err)
+		return nil, errors.GRPCErr(err, "unable to update job run state for "+req.GetJobName())
+	}
+
+	jobRuns, err := h.service.UpdateJobRunState(ctx, projectName, jobName, startDate, endDate, change)
+	if err != nil {
+		h.l.Error("error updating job run state: %s", err)
+		return nil, errors.GRPCErr(err, "unable to update job run state for "+req.GetJobName())
+	}
+
+	var runs []*pb.JobRun
+	for _, run := range jobRuns {
+		runs = append(runs, &pb.JobRun{
+			State:             run.State.String(),
+			ScheduledAt:       timestamppb.New(run.ScheduledAt),
+			StateChangedBy:    run.StateChangedBy,
+			StateChangeReason: run.StateChangeReason,
+		})
+	}
+	return &pb.UpdateJobRunStateResponse{JobRuns: runs}, nil
+}
+
+func (h JobRunHandler) GetCompiledJob(ctx context.Context, req *pb.GetCompiledJobRequest) (*pb.GetCompiledJobResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get compiled job for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		h.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get compiled job for "+req.GetJobName())
+	}
+
+	compiledJob, err := h.service.GetCompiledJob(ctx, projectName, jobName)
+	if err != nil {
+		h.l.Error("error compiling job [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get compiled job for "+req.GetJobName())
+	}
+
+	return &pb.GetCompiledJobResponse{CompiledJob: string(compiledJob)}, nil
+}
+
+func (h JobRunHandler) ReconcileSchedulerJobs(ctx context.Context, req *pb.ReconcileSchedulerJobsRequest) (*pb.ReconcileSchedulerJobsResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to reconcile scheduler jobs")
+	}
+
+	drifts, err := h.reconciler.Reconcile(ctx, projectName, req.GetNamespaceName(), req.GetFix())
+	if err != nil {
+		h.l.Error("error reconciling scheduler jobs of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to reconcile scheduler jobs of project "+projectName.String())
+	}
+
+	driftsProto := make([]*pb.SchedulerJobDrift, len(drifts))
+	for i, drift := range drifts {
+		driftsProto[i] = &pb.SchedulerJobDrift{
+			JobName:       drift.JobName.String(),
+			NamespaceName: drift.Tenant.NamespaceName().String(),
+			Type:          drift.Type.String(),
+		}
+	}
+	return &pb.ReconcileSchedulerJobsResponse{Drifts: driftsProto}, nil
+}
+
+func (h JobRunHandler) GetJobRunStats(ctx context.Context, req *pb.GetJobRunStatsRequest) (*pb.GetJobRunStatsResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run stats")
+	}
+
+	if err := req.GetStartDate().CheckValid(); err != nil {
+		h.l.Error("invalid start date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid start_date"), "unable to get job run stats")
+	}
+	if err := req.GetEndDate().CheckValid(); err != nil {
+		h.l.Error("invalid end date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid end_date"), "unable to get job run stats")
+	}
+
+	criteria, err := scheduler.NewJobRunStatsCriteria(projectName, req.GetNamespaceName(), req.GetJobName(),
+		req.GetStartDate().AsTime(), req.GetEndDate().AsTime())
+	if err != nil {
+		h.l.Error("error building job run stats criteria: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get job run stats")
+	}
+
+	stats, err := h.service.GetJobRunStats(ctx, criteria)
+	if err != nil {
+		h.l.Error("error getting job run stats of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to get job run stats of project "+projectName.String())
+	}
+	return toJobRunStatsProto(stats), nil
+}
+
+func (h JobRunHandler) GetJobRunGaps(ctx context.Context, req *pb.GetJobRunGapsRequest) (*pb.GetJobRunGapsResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run gaps")
+	}
+
+	if err := req.GetStartDate().CheckValid(); err != nil {
+		h.l.Error("invalid start date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid start_date"), "unable to get job run gaps")
+	}
+	if err := req.GetEndDate().CheckValid(); err != nil {
+		h.l.Error("invalid end date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid end_date"), "unable to get job run gaps")
+	}
+	if req.GetEndDate().AsTime().Before(req.GetStartDate().AsTime()) {
+		h.l.Error("end date [%s] is before start date [%s]", req.GetEndDate().AsTime(), req.GetStartDate().AsTime())
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "end date cannot be before start date"), "unable to get job run gaps")
+	}
+
+	gaps, err := h.service.GetJobRunGaps(ctx, projectName, req.GetNamespaceName(), req.GetStartDate().AsTime(), req.GetEndDate().AsTime())
+	if err != nil {
+		h.l.Error("error getting job run gaps of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to get job run gaps of project "+projectName.String())
+	}
+
+	gapsProto := make([]*pb.JobRunGap, len(gaps))
+	for i, gap := range gaps {
+		scheduledAt := make([]*timestamppb.Timestamp, len(gap.ScheduledAt))
+		for j, missing := range gap.ScheduledAt {
+			scheduledAt[j] = timestamppb.New(missing)
+		}
+		gapsProto[i] = &pb.JobRunGap{
+			JobName:       gap.JobName.String(),
+			NamespaceName: gap.Tenant.NamespaceName().String(),
+			ScheduledAt:   scheduledAt,
+		}
+	}
+	return &pb.GetJobRunGapsResponse{Gaps: gapsProto}, nil
+}
+
+func (h JobRunHandler) GetJobRunLineage(ctx context.Context, req *pb.GetJobRunLineageRequest) (*pb.GetJobRunLineageResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run lineage for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		h.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run lineage for "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		h.l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"), "unable to get job run lineage for "+req.GetJobName())
+	}
+
+	direction, err := scheduler.LineageDirectionFrom(req.GetDirection())
+	if err != nil {
+		h.l.Error("error adapting lineage direction [%s]: %s", req.GetDirection(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run lineage for "+req.GetJobName())
+	}
+
+	depth := int(req.GetDepth())
+	if depth == 0 {
+		depth = scheduler.DefaultLineageDepth
+	}
+	if depth < 0 || depth > scheduler.MaxLineageDepth {
+		h.l.Error("invalid lineage depth [%d]", depth)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, fmt.Sprintf("lineage depth should be between 1 and %d", scheduler.MaxLineageDepth)),
+			"unable to get job run lineage for "+req.GetJobName())
+	}
+
+	lineage, err := h.service.GetJobRunLineage(ctx, projectName, jobName, req.GetScheduledAt().AsTime(), direction, depth)
+	if err != nil {
+		h.l.Error("error getting job run lineage of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get job run lineage for "+req.GetJobName())
+	}
+	return &pb.GetJobRunLineageResponse{Lineage: toJobRunLineageProto(lineage)}, nil
+}
+
+func toJobRunLineageProto(lineage *scheduler.JobRunLineage) *pb.JobRunLineage {
+	links := make([]*pb.JobRunLineage, len(lineage.Links))
+	for i, link := range lineage.Links {
+		links[i] = toJobRunLineageProto(link)
+	}
+	var id string
+	if lineage.ID != uuid.Nil {
+		id = lineage.ID.String()
+	}
+	return &pb.JobRunLineage{
+		Id:            id,
+		ProjectName:   lineage.Tenant.ProjectName().String(),
+		NamespaceName: lineage.Tenant.NamespaceName().String(),
+		JobName:       lineage.JobName.String(),
+		ScheduledAt:   timestamppb.New(lineage.ScheduledAt),
+		State:         lineage.State.String(),
+		Links:         links,
+	}
+}
+
+func (h JobRunHandler) GetJobRunMetrics(ctx context.Context, req *pb.GetJobRunMetricsRequest) (*pb.GetJobRunMetricsResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run metrics for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		h.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run metrics for "+req.GetJobName())
+	}
+
+	if err := req.GetStartDate().CheckValid(); err != nil {
+		h.l.Error("invalid start date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid start_date"), "unable to get job run metrics for "+req.GetJobName())
+	}
+	if err := req.GetEndDate().CheckValid(); err != nil {
+		h.l.Error("invalid end date: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid end_date"), "unable to get job run metrics for "+req.GetJobName())
+	}
+	if req.GetEndDate().AsTime().Before(req.GetStartDate().AsTime()) {
+		h.l.Error("end date [%s] is before start date [%s]", req.GetEndDate().AsTime(), req.GetStartDate().AsTime())
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "end date cannot be before start date"), "unable to get job run metrics for "+req.GetJobName())
+	}
+
+	series, err := h.service.GetJobRunMetrics(ctx, projectName, jobName, req.GetStartDate().AsTime(), req.GetEndDate().AsTime())
+	if err != nil {
+		h.l.Error("error getting job run metrics of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get job run metrics for "+req.GetJobName())
+	}
+
+	seriesProto := make([]*pb.MetricSeries, len(series))
+	for i, metricSeries := range series {
+		points := make([]*pb.MetricPoint, len(metricSeries.Points))
+		for j, point := range metricSeries.Points {
+			points[j] = &pb.MetricPoint{
+				ScheduledAt: timestamppb.New(point.ScheduledAt),
+				Value:       point.Value,
+			}
+		}
+		seriesProto[i] = &pb.MetricSeries{
+			Name:   metricSeries.Name.String(),
+			Points: points,
+		}
+	}
+	return &pb.GetJobRunMetricsResponse{Series: seriesProto}, nil
+}
+
+func (h JobRunHandler) WatchJobRuns(req *pb.WatchJobRunsRequest, stream pb.JobRunService_WatchJobRunsServer) error {
+	filter, err := scheduler.NewJobRunWatchFilter(req.GetProjectName(), req.GetNamespaceName(), req.GetJobNames(), req.GetLabels())
+	if err != nil {
+		h.l.Error("error adapting job run watch filter: %s", err)
+		return errors.GRPCErr(err, "unable to watch job runs of project "+req.GetProjectName())
+	}
+
+	err = h.watcher.Watch(stream.Context(), filter, func(jobRun *scheduler.JobRun) error {
+		resp := &pb.WatchJobRunsResponse{
+			ProjectName:   jobRun.Tenant.ProjectName().String(),
+			NamespaceName: jobRun.Tenant.NamespaceName().String(),
+			JobName:       jobRun.JobName.String(),
+			ScheduledAt:   timestamppb.New(jobRun.ScheduledAt),
+			State:         jobRun.State.String(),
+		}
+		if !jobRun.StartTime.IsZero() {
+			resp.StartTime = timestamppb.New(jobRun.StartTime)
+		}
+		if !jobRun.EndTime.IsZero() {
+			resp.EndTime = timestamppb.New(jobRun.EndTime)
+		}
+		return stream.Send(resp)
+	})
+	if err != nil {
+		h.l.Error("error watching job runs of project [%s]: %s", req.GetProjectName(), err)
+		return errors.GRPCErr(err, "unable to watch job runs of project "+req.GetProjectName())
+	}
+	return nil
+}
+
+func toJobRunStatsProto(stats *scheduler.JobRunStats) *pb.GetJobRunStatsResponse {
+	operators := make([]*pb.OperatorRunStats, len(stats.Operators))
+	for i, operator := range stats.Operators {
+		operators[i] = &pb.OperatorRunStats{
+			OperatorType: operator.OperatorType.String(),
+			Runs:         int32(operator.Runs),
+			Retries:      int32(operator.Retries),
+			P50Duration:  durationpb.New(operator.P50Duration),
+			P95Duration:  durationpb.New(operator.P95Duration),
+		}
+	}
+
+	trend := make([]*pb.JobRunStatsTrend, len(stats.Trend))
+	for i, day := range stats.Trend {
+		trend[i] = &pb.JobRunStatsTrend{
+			Date:        timestamppb.New(day.Date),
+			TotalRuns:   int32(day.TotalRuns),
+			SuccessRuns: int32(day.SuccessRuns),
+			SlaMisses:   int32(day.SLAMisses),
+		}
+	}
+
+	return &pb.GetJobRunStatsResponse{
+		TotalRuns:   int32(stats.TotalRuns),
+		SuccessRuns: int32(stats.SuccessRuns),
+		FailedRuns:  int32(stats.FailedRuns),
+		SuccessRate: stats.SuccessRate(),
+		Retries:     int32(stats.Retries()),
+		SlaMisses:   int32(stats.SLAMisses),
+		Operators:   operators,
+		Trend:       trend,
+	}
+}
+
 func (h JobRunHandler) UploadToScheduler(_ context.Context, req *pb.UploadToSchedulerRequest) (*pb.UploadToSchedulerResponse, error) {
 	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
@@ -177,16 +552,36 @@ func (h JobRunHandler) RegisterJobEvent(ctx context.Context, req *pb.RegisterJob
 		me.Append(errors.AddErrContext(err, scheduler.EntityJobRun, "scheduler could not update job run state"))
 	}
 
+	if err := validateMonitoringMetrics(event); err != nil {
+		h.l.Error("invalid monitoring metrics for Job: %s, Project: %s, schedule_at: %s, err: %s", jobName, tnnt.ProjectName(), event.JobScheduledAt.String(), err.Error())
+		me.Append(err)
+	}
+
 	err = h.notifier.Push(ctx, event)
 	me.Append(err)
 
 	return &pb.RegisterJobEventResponse{}, me.ToErr()
 }
 
-func NewJobRunHandler(l log.Logger, service JobRunService, notifier Notifier) *JobRunHandler {
+// validateMonitoringMetrics fails the event of a job run when every metric it reports is invalid, the run is still
+// updated with the event. Partly invalid metrics are only logged by the service, as the valid ones are stored.
+func validateMonitoringMetrics(event *scheduler.Event) error {
+	if event.Type != scheduler.JobSuccessEvent && event.Type != scheduler.JobFailureEvent {
+		return nil
+	}
+	metrics, err := scheduler.JobRunMetricsFrom(event.JobScheduledAt, event.MonitoringValues())
+	if err != nil && metrics.IsEmpty() {
+		return errors.InvalidArgument(scheduler.EntityJobRun, "every monitoring metric of the job run is invalid: "+err.Error())
+	}
+	return nil
+}
+
+func NewJobRunHandler(l log.Logger, service JobRunService, notifier Notifier, reconciler Reconciler, watcher JobRunWatcher) *JobRunHandler {
 	return &JobRunHandler{
-		l:        l,
-		service:  service,
-		notifier: notifier,
+		l:          l,
+		service:    service,
+		notifier:   notifier,
+		reconciler: reconciler,
+		watcher:    watcher,
 	}
 }
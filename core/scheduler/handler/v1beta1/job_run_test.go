@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/raystack/salt/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -31,7 +33,7 @@ func TestJobRunHandler(t *testing.T) {
 	t.Run("JobRunInput", func(t *testing.T) {
 		t.Run("returns error when project name is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "",
@@ -49,7 +51,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when job name is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -67,7 +69,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when executor is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -85,7 +87,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when scheduled_at is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -102,7 +104,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when run config is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -124,7 +126,7 @@ func TestJobRunHandler(t *testing.T) {
 				Return(&scheduler.ExecutorInput{}, fmt.Errorf("error in service"))
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -150,7 +152,7 @@ func TestJobRunHandler(t *testing.T) {
 				}, nil)
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -191,7 +193,7 @@ func TestJobRunHandler(t *testing.T) {
 			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(jobRuns, nil)
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			req := &pb.JobRunRequest{
 				ProjectName: projectName,
@@ -236,7 +238,7 @@ func TestJobRunHandler(t *testing.T) {
 			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(jobRuns, nil)
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			req := &pb.JobRunRequest{
 				ProjectName: projectName,
@@ -274,7 +276,7 @@ func TestJobRunHandler(t *testing.T) {
 			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(nil, fmt.Errorf("some random error"))
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			req := &pb.JobRunRequest{
 				ProjectName: projectName,
@@ -288,7 +290,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 
 		t.Run("should not return job runs if project name is not valid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "",
 				JobName:     "transform-tables",
@@ -303,7 +305,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 
 		t.Run("should not return job runs if job name is not valid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "some-project",
 				JobName:     "",
@@ -317,7 +319,7 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 		t.Run("should not return job runs if only start date is invalid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "some-project",
 				JobName:     "jobname",
@@ -330,7 +332,7 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 		t.Run("should not return job runs if only end date is invalid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "some-project",
 				JobName:     "jobname",
@@ -342,10 +344,682 @@ func TestJobRunHandler(t *testing.T) {
 			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: empty end date is given: unable to get job run for jobname")
 			assert.Nil(t, resp)
 		})
+		t.Run("should not return job runs if run offset is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+			req := &pb.JobRunRequest{
+				ProjectName: "some-project",
+				JobName:     "jobname",
+				StartDate:   timestamppb.New(date),
+				EndDate:     timestamppb.New(date.Add(time.Hour * 24)),
+				RunOffset:   "yesterday",
+			}
+			resp, err := jobRunHandler.JobRun(ctx, req)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid run offset: yesterday: unable to get job run for jobname")
+			assert.Nil(t, resp)
+		})
+		t.Run("should get job runs with the run offset", func(t *testing.T) {
+			query := &scheduler.JobRunsCriteria{
+				Name:      "jobname",
+				StartDate: date,
+				EndDate:   date.Add(time.Hour * 24),
+				RunOffset: -24 * time.Hour,
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName("some-project"), scheduler.JobName("jobname"), query).
+				Return([]*scheduler.JobRunStatus{{ScheduledAt: date.Add(-24 * time.Hour), State: scheduler.StateSuccess}}, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+			req := &pb.JobRunRequest{
+				ProjectName: "some-project",
+				JobName:     "jobname",
+				StartDate:   timestamppb.New(date),
+				EndDate:     timestamppb.New(date.Add(time.Hour * 24)),
+				RunOffset:   "-24h",
+			}
+			resp, err := jobRunHandler.JobRun(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.JobRuns, 1)
+		})
+	})
+	t.Run("GetCompiledJob", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetCompiledJobRequest{
+				ProjectName: "",
+				JobName:     jobName,
+			}
+			resp, err := jobRunHandler.GetCompiledJob(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity project: project name is empty: unable to get compiled job for a-job-name")
+		})
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetCompiledJobRequest{
+				ProjectName: projectName,
+				JobName:     "",
+			}
+			resp, err := jobRunHandler.GetCompiledJob(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: job name is empty: unable to get compiled job for ")
+		})
+		t.Run("returns error when service fails to compile the job", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetCompiledJob", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName)).
+				Return(nil, fmt.Errorf("some error"))
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetCompiledJobRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+			}
+			resp, err := jobRunHandler.GetCompiledJob(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to get compiled job for a-job-name")
+		})
+		t.Run("returns the compiled job", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetCompiledJob", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName)).
+				Return([]byte("compiled dag"), nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetCompiledJobRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+			}
+			resp, err := jobRunHandler.GetCompiledJob(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, "compiled dag", resp.GetCompiledJob())
+		})
+	})
+	t.Run("ReconcileSchedulerJobs", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.ReconcileSchedulerJobsRequest{ProjectName: ""}
+			resp, err := jobRunHandler.ReconcileSchedulerJobs(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity project: project name is empty: unable to reconcile scheduler jobs")
+		})
+		t.Run("returns error when unable to reconcile", func(t *testing.T) {
+			reconciler := new(mockReconciler)
+			reconciler.On("Reconcile", ctx, tenant.ProjectName(projectName), "", true).Return(nil, fmt.Errorf("some error"))
+			defer reconciler.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, reconciler, nil)
+
+			req := &pb.ReconcileSchedulerJobsRequest{ProjectName: projectName, Fix: true}
+			resp, err := jobRunHandler.ReconcileSchedulerJobs(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to reconcile scheduler jobs of project a-data-proj")
+		})
+		t.Run("returns the drifted jobs", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "a-namespace")
+			reconciler := new(mockReconciler)
+			reconciler.On("Reconcile", ctx, tenant.ProjectName(projectName), "a-namespace", false).Return([]*scheduler.JobDrift{
+				{JobName: "job-orphaned", Tenant: tnnt, Type: scheduler.DriftOrphaned},
+				{JobName: "job-missing", Tenant: tnnt, Type: scheduler.DriftMissing},
+			}, nil)
+			defer reconciler.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, reconciler, nil)
+
+			namespaceName := "a-namespace"
+			req := &pb.ReconcileSchedulerJobsRequest{ProjectName: projectName, NamespaceName: &namespaceName}
+			resp, err := jobRunHandler.ReconcileSchedulerJobs(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.GetDrifts(), 2)
+			assert.Equal(t, "job-orphaned", resp.GetDrifts()[0].GetJobName())
+			assert.Equal(t, "a-namespace", resp.GetDrifts()[0].GetNamespaceName())
+			assert.Equal(t, "orphaned", resp.GetDrifts()[0].GetType())
+			assert.Equal(t, "missing", resp.GetDrifts()[1].GetType())
+		})
+	})
+	t.Run("GetJobRunStats", func(t *testing.T) {
+		startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.Add(time.Hour * 24 * 7)
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunStatsRequest{ProjectName: ""}
+			resp, err := jobRunHandler.GetJobRunStats(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity project: project name is empty: unable to get job run stats")
+		})
+		t.Run("returns error when start date is not given", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunStatsRequest{ProjectName: projectName, EndDate: timestamppb.New(endDate)}
+			resp, err := jobRunHandler.GetJobRunStats(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid start_date: unable to get job run stats")
+		})
+		t.Run("returns error when end date is before start date", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunStatsRequest{
+				ProjectName: projectName,
+				StartDate:   timestamppb.New(endDate),
+				EndDate:     timestamppb.New(startDate),
+			}
+			resp, err := jobRunHandler.GetJobRunStats(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: end date cannot be before start date: unable to get job run stats")
+		})
+		t.Run("returns error when unable to get job run stats", func(t *testing.T) {
+			criteria := &scheduler.JobRunStatsCriteria{
+				ProjectName: tenant.ProjectName(projectName),
+				JobName:     scheduler.JobName(jobName),
+				StartDate:   startDate,
+				EndDate:     endDate,
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunStats", ctx, criteria).Return(nil, fmt.Errorf("some error"))
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunStatsRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(startDate),
+				EndDate:     timestamppb.New(endDate),
+			}
+			resp, err := jobRunHandler.GetJobRunStats(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to get job run stats of project a-data-proj")
+		})
+		t.Run("returns the job run stats", func(t *testing.T) {
+			criteria := &scheduler.JobRunStatsCriteria{
+				ProjectName:   tenant.ProjectName(projectName),
+				NamespaceName: tenant.NamespaceName("a-namespace"),
+				StartDate:     startDate,
+				EndDate:       endDate,
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunStats", ctx, criteria).Return(&scheduler.JobRunStats{
+				TotalRuns:   4,
+				SuccessRuns: 3,
+				FailedRuns:  1,
+				SLAMisses:   1,
+				Operators: []*scheduler.OperatorRunStats{
+					{OperatorType: scheduler.OperatorTask, Runs: 4, Retries: 2, P50Duration: time.Minute, P95Duration: time.Minute * 5},
+				},
+				Trend: []*scheduler.JobRunStatsTrend{
+					{Date: startDate, TotalRuns: 4, SuccessRuns: 3, SLAMisses: 1},
+				},
+			}, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunStatsRequest{
+				ProjectName:   projectName,
+				NamespaceName: "a-namespace",
+				StartDate:     timestamppb.New(startDate),
+				EndDate:       timestamppb.New(endDate),
+			}
+			resp, err := jobRunHandler.GetJobRunStats(ctx, req)
+			assert.Nil(t, err)
+			assert.EqualValues(t, 4, resp.GetTotalRuns())
+			assert.EqualValues(t, 0.75, resp.GetSuccessRate())
+			assert.EqualValues(t, 2, resp.GetRetries())
+			assert.EqualValues(t, 1, resp.GetSlaMisses())
+			assert.Len(t, resp.GetOperators(), 1)
+			assert.Equal(t, "task", resp.GetOperators()[0].GetOperatorType())
+			assert.Equal(t, time.Minute*5, resp.GetOperators()[0].GetP95Duration().AsDuration())
+			assert.Len(t, resp.GetTrend(), 1)
+			assert.Equal(t, startDate, resp.GetTrend()[0].GetDate().AsTime())
+		})
+	})
+	t.Run("GetJobRunGaps", func(t *testing.T) {
+		startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.Add(time.Hour * 24 * 7)
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunGapsRequest{ProjectName: ""}
+			resp, err := jobRunHandler.GetJobRunGaps(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity project: project name is empty: unable to get job run gaps")
+		})
+		t.Run("returns error when end date is not given", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunGapsRequest{ProjectName: projectName, StartDate: timestamppb.New(startDate)}
+			resp, err := jobRunHandler.GetJobRunGaps(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid end_date: unable to get job run gaps")
+		})
+		t.Run("returns error when end date is before start date", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunGapsRequest{
+				ProjectName: projectName,
+				StartDate:   timestamppb.New(endDate),
+				EndDate:     timestamppb.New(startDate),
+			}
+			resp, err := jobRunHandler.GetJobRunGaps(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: end date cannot be before start date: unable to get job run gaps")
+		})
+		t.Run("returns error when unable to get job run gaps", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunGaps", ctx, tenant.ProjectName(projectName), "", startDate, endDate).Return(nil, fmt.Errorf("some error"))
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunGapsRequest{
+				ProjectName: projectName,
+				StartDate:   timestamppb.New(startDate),
+				EndDate:     timestamppb.New(endDate),
+			}
+			resp, err := jobRunHandler.GetJobRunGaps(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to get job run gaps of project a-data-proj")
+		})
+		t.Run("returns the job run gaps", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "a-namespace")
+			missing := []time.Time{startDate.Add(time.Hour * 2), startDate.Add(time.Hour * 26)}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunGaps", ctx, tenant.ProjectName(projectName), "a-namespace", startDate, endDate).Return([]*scheduler.JobRunGap{
+				{JobName: scheduler.JobName(jobName), Tenant: tnnt, ScheduledAt: missing},
+			}, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunGapsRequest{
+				ProjectName:   projectName,
+				NamespaceName: "a-namespace",
+				StartDate:     timestamppb.New(startDate),
+				EndDate:       timestamppb.New(endDate),
+			}
+			resp, err := jobRunHandler.GetJobRunGaps(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.GetGaps(), 1)
+			assert.Equal(t, jobName, resp.GetGaps()[0].GetJobName())
+			assert.Equal(t, "a-namespace", resp.GetGaps()[0].GetNamespaceName())
+			assert.Len(t, resp.GetGaps()[0].GetScheduledAt(), 2)
+			assert.Equal(t, missing[1], resp.GetGaps()[0].GetScheduledAt()[1].AsTime())
+		})
+	})
+	t.Run("GetJobRunLineage", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC)
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunLineageRequest{ProjectName: projectName, JobName: ""}
+			resp, err := jobRunHandler.GetJobRunLineage(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: job name is empty: unable to get job run lineage for ")
+		})
+		t.Run("returns error when scheduled at is not given", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunLineageRequest{ProjectName: projectName, JobName: jobName}
+			resp, err := jobRunHandler.GetJobRunLineage(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid scheduled_at: unable to get job run lineage for a-job-name")
+		})
+		t.Run("returns error when direction is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunLineageRequest{ProjectName: projectName, JobName: jobName, ScheduledAt: timestamppb.New(scheduledAt), Direction: "sideways"}
+			resp, err := jobRunHandler.GetJobRunLineage(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid lineage direction: sideways: unable to get job run lineage for a-job-name")
+		})
+		t.Run("returns error when depth is too deep", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunLineageRequest{ProjectName: projectName, JobName: jobName, ScheduledAt: timestamppb.New(scheduledAt), Depth: 20}
+			resp, err := jobRunHandler.GetJobRunLineage(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: lineage depth should be between 1 and 10: unable to get job run lineage for a-job-name")
+		})
+		t.Run("returns error when unable to get job run lineage", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunLineage", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt,
+				scheduler.LineageDirectionUpstream, scheduler.DefaultLineageDepth).Return(nil, fmt.Errorf("some error"))
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunLineageRequest{ProjectName: projectName, JobName: jobName, ScheduledAt: timestamppb.New(scheduledAt)}
+			resp, err := jobRunHandler.GetJobRunLineage(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to get job run lineage for a-job-name")
+		})
+		t.Run("returns the job run lineage", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "a-namespace")
+			remoteTnnt, _ := tenant.NewTenant("remote-proj", "remote-ns")
+			runID := uuid.New()
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunLineage", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt,
+				scheduler.LineageDirectionDownstream, 2).Return(&scheduler.JobRunLineage{
+				JobRunRef: scheduler.JobRunRef{JobName: scheduler.JobName(jobName), Tenant: tnnt, ScheduledAt: scheduledAt},
+				ID:        runID,
+				State:     scheduler.StateSuccess,
+				Links: []*scheduler.JobRunLineage{
+					{JobRunRef: scheduler.JobRunRef{JobName: "remote-job", Tenant: remoteTnnt, ScheduledAt: scheduledAt.Add(time.Hour)}},
+				},
+			}, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunLineageRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+				Direction:   "downstream",
+				Depth:       2,
+			}
+			resp, err := jobRunHandler.GetJobRunLineage(ctx, req)
+			assert.Nil(t, err)
+			assert.Equal(t, runID.String(), resp.GetLineage().GetId())
+			assert.Equal(t, "success", resp.GetLineage().GetState())
+			assert.Len(t, resp.GetLineage().GetLinks(), 1)
+			assert.Empty(t, resp.GetLineage().GetLinks()[0].GetId())
+			assert.Equal(t, "remote-proj", resp.GetLineage().GetLinks()[0].GetProjectName())
+			assert.Equal(t, "remote-job", resp.GetLineage().GetLinks()[0].GetJobName())
+		})
+	})
+	t.Run("GetJobRunMetrics", func(t *testing.T) {
+		startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.Add(time.Hour * 24 * 7)
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunMetricsRequest{ProjectName: projectName, JobName: ""}
+			resp, err := jobRunHandler.GetJobRunMetrics(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: job name is empty: unable to get job run metrics for ")
+		})
+		t.Run("returns error when start date is not given", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunMetricsRequest{ProjectName: projectName, JobName: jobName, EndDate: timestamppb.New(endDate)}
+			resp, err := jobRunHandler.GetJobRunMetrics(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid start_date: unable to get job run metrics for a-job-name")
+		})
+		t.Run("returns error when end date is before start date", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.GetJobRunMetricsRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(endDate),
+				EndDate:     timestamppb.New(startDate),
+			}
+			resp, err := jobRunHandler.GetJobRunMetrics(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: end date cannot be before start date: unable to get job run metrics for a-job-name")
+		})
+		t.Run("returns error when unable to get job run metrics", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunMetrics", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), startDate, endDate).Return(nil, fmt.Errorf("some error"))
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunMetricsRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(startDate),
+				EndDate:     timestamppb.New(endDate),
+			}
+			resp, err := jobRunHandler.GetJobRunMetrics(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to get job run metrics for a-job-name")
+		})
+		t.Run("returns the metric series of the job", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRunMetrics", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), startDate, endDate).Return([]*scheduler.MetricSeries{
+				{
+					Name: scheduler.MetricRowsWritten,
+					Points: []scheduler.MetricPoint{
+						{ScheduledAt: startDate, Value: 100},
+						{ScheduledAt: startDate.Add(time.Hour * 24), Value: 120},
+					},
+				},
+				{
+					Name:   scheduler.MetricCost,
+					Points: []scheduler.MetricPoint{{ScheduledAt: startDate, Value: 0.25}},
+				},
+			}, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.GetJobRunMetricsRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(startDate),
+				EndDate:     timestamppb.New(endDate),
+			}
+			resp, err := jobRunHandler.GetJobRunMetrics(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.GetSeries(), 2)
+			assert.Equal(t, "rows_written", resp.GetSeries()[0].GetName())
+			assert.Len(t, resp.GetSeries()[0].GetPoints(), 2)
+			assert.Equal(t, startDate.Add(time.Hour*24), resp.GetSeries()[0].GetPoints()[1].GetScheduledAt().AsTime())
+			assert.Equal(t, float64(120), resp.GetSeries()[0].GetPoints()[1].GetValue())
+			assert.Equal(t, "cost", resp.GetSeries()[1].GetName())
+			assert.Equal(t, 0.25, resp.GetSeries()[1].GetPoints()[0].GetValue())
+		})
+	})
+	t.Run("WatchJobRuns", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			err := jobRunHandler.WatchJobRuns(&pb.WatchJobRunsRequest{ProjectName: ""}, new(watchJobRunsStreamMock))
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity project: project name is empty: unable to watch job runs of project ")
+		})
+		t.Run("returns error when a job name is empty", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.WatchJobRunsRequest{ProjectName: projectName, JobNames: []string{""}}
+			err := jobRunHandler.WatchJobRuns(req, new(watchJobRunsStreamMock))
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: job name is empty: unable to watch job runs of project a-data-proj")
+		})
+		t.Run("returns error when unable to send a state change", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "a-namespace")
+			jobRun := &scheduler.JobRun{JobName: scheduler.JobName(jobName), Tenant: tnnt, State: scheduler.StateFailed}
+			filter, _ := scheduler.NewJobRunWatchFilter(projectName, "", nil, nil)
+
+			stream := new(watchJobRunsStreamMock)
+			stream.On("Context").Return(ctx)
+			stream.On("Send", mock.Anything).Return(fmt.Errorf("some error"))
+			defer stream.AssertExpectations(t)
+
+			watcher := new(mockJobRunWatcher)
+			watcher.On("Watch", ctx, filter, mock.Anything).Return(nil, jobRun)
+			defer watcher.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, watcher)
+
+			err := jobRunHandler.WatchJobRuns(&pb.WatchJobRunsRequest{ProjectName: projectName}, stream)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to watch job runs of project a-data-proj")
+		})
+		t.Run("streams the state changes of the watched job runs", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "a-namespace")
+			scheduledAt := time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)
+			jobRun := &scheduler.JobRun{
+				JobName:     scheduler.JobName(jobName),
+				Tenant:      tnnt,
+				State:       scheduler.StateInProgress,
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt.Add(time.Minute),
+			}
+			filter, _ := scheduler.NewJobRunWatchFilter(projectName, "a-namespace", []string{jobName}, map[string]string{"team": "data"})
+
+			stream := new(watchJobRunsStreamMock)
+			stream.On("Context").Return(ctx)
+			stream.On("Send", &pb.WatchJobRunsResponse{
+				ProjectName:   projectName,
+				NamespaceName: "a-namespace",
+				JobName:       jobName,
+				ScheduledAt:   timestamppb.New(scheduledAt),
+				State:         "in_progress",
+				StartTime:     timestamppb.New(scheduledAt.Add(time.Minute)),
+			}).Return(nil)
+			defer stream.AssertExpectations(t)
+
+			watcher := new(mockJobRunWatcher)
+			watcher.On("Watch", ctx, filter, mock.Anything).Return(nil, jobRun)
+			defer watcher.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, watcher)
+
+			req := &pb.WatchJobRunsRequest{
+				ProjectName:   projectName,
+				NamespaceName: "a-namespace",
+				JobNames:      []string{jobName},
+				Labels:        map[string]string{"team": "data"},
+			}
+			err := jobRunHandler.WatchJobRuns(req, stream)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("UpdateJobRunState", func(t *testing.T) {
+		scheduledAt := time.Date(2022, 3, 25, 2, 0, 0, 0, time.UTC)
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.UpdateJobRunStateRequest{
+				ProjectName: "",
+				JobName:     jobName,
+				StartDate:   timestamppb.New(scheduledAt),
+				State:       "success",
+				ChangedBy:   "optimus@example.io",
+				Reason:      "upstream incident",
+			}
+			resp, err := jobRunHandler.UpdateJobRunState(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity project: project name is empty: unable to update job run state for a-job-name")
+		})
+		t.Run("returns error when start date is not given", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.UpdateJobRunStateRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				State:       "success",
+				ChangedBy:   "optimus@example.io",
+				Reason:      "upstream incident",
+			}
+			resp, err := jobRunHandler.UpdateJobRunState(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: invalid start_date: unable to update job run state for a-job-name")
+		})
+		t.Run("returns error when state is not allowed", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			req := &pb.UpdateJobRunStateRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(scheduledAt),
+				State:       "running",
+				ChangedBy:   "optimus@example.io",
+				Reason:      "upstream incident",
+			}
+			resp, err := jobRunHandler.UpdateJobRunState(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity jobRun: job run state can only be changed to success, failed or skipped: unable to update job run state for a-job-name")
+		})
+		t.Run("returns error when service fails to update the state", func(t *testing.T) {
+			change, _ := scheduler.NewJobRunStateChange("success", "optimus@example.io", "upstream incident")
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("UpdateJobRunState", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt, scheduledAt, change).
+				Return(nil, fmt.Errorf("some error"))
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.UpdateJobRunStateRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(scheduledAt),
+				State:       "success",
+				ChangedBy:   "optimus@example.io",
+				Reason:      "upstream incident",
+			}
+			resp, err := jobRunHandler.UpdateJobRunState(ctx, req)
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = some error: unable to update job run state for a-job-name")
+		})
+		t.Run("returns the updated job runs for the given range", func(t *testing.T) {
+			endDate := scheduledAt.Add(time.Hour * 24)
+			change, _ := scheduler.NewJobRunStateChange("failed", "optimus@example.io", "bad data")
+			updatedRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledAt, State: scheduler.StateFailed, StateChangedBy: "optimus@example.io", StateChangeReason: "bad data"},
+				{ScheduledAt: endDate, State: scheduler.StateFailed, StateChangedBy: "optimus@example.io", StateChangeReason: "bad data"},
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("UpdateJobRunState", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt, endDate, change).
+				Return(updatedRuns, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.UpdateJobRunStateRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(scheduledAt),
+				EndDate:     timestamppb.New(endDate),
+				State:       "failed",
+				ChangedBy:   "optimus@example.io",
+				Reason:      "bad data",
+			}
+			resp, err := jobRunHandler.UpdateJobRunState(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.JobRuns, 2)
+			assert.Equal(t, "failed", resp.JobRuns[0].State)
+			assert.Equal(t, "optimus@example.io", resp.JobRuns[0].StateChangedBy)
+			assert.Equal(t, "bad data", resp.JobRuns[0].StateChangeReason)
+			assert.True(t, endDate.Equal(resp.JobRuns[1].ScheduledAt.AsTime()))
+		})
+		t.Run("returns the job runs marked as skipped", func(t *testing.T) {
+			change, _ := scheduler.NewJobRunStateChange("skipped", "optimus@example.io", "holiday")
+			updatedRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledAt, State: scheduler.StateSkipped, StateChangedBy: "optimus@example.io", StateChangeReason: "holiday"},
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("UpdateJobRunState", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt, scheduledAt, change).
+				Return(updatedRuns, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			req := &pb.UpdateJobRunStateRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				StartDate:   timestamppb.New(scheduledAt),
+				State:       "skipped",
+				ChangedBy:   "optimus@example.io",
+				Reason:      "holiday",
+			}
+			resp, err := jobRunHandler.UpdateJobRunState(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.JobRuns, 1)
+			assert.Equal(t, "skipped", resp.JobRuns[0].State)
+			assert.Equal(t, "holiday", resp.JobRuns[0].StateChangeReason)
+		})
 	})
 	t.Run("UploadToScheduler", func(t *testing.T) {
 		t.Run("should fail deployment if project name empty", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			namespaceName := "namespace-name"
 			req := &pb.UploadToSchedulerRequest{
 				ProjectName:   "",
@@ -364,7 +1038,7 @@ func TestJobRunHandler(t *testing.T) {
 			}
 			jobRunService := new(mockJobRunService)
 			jobRunService.On("UploadToScheduler", ctx, tenant.ProjectName(projectName)).Return(nil)
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			_, err := jobRunHandler.UploadToScheduler(ctx, req)
 			assert.Nil(t, err)
@@ -385,7 +1059,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -409,7 +1083,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -432,7 +1106,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -456,7 +1130,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -500,7 +1174,7 @@ func TestJobRunHandler(t *testing.T) {
 				Return(nil)
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -544,13 +1218,110 @@ func TestJobRunHandler(t *testing.T) {
 				Return(fmt.Errorf("some error"))
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "errors in RegisterJobEvent:\n some error")
 			assert.Equal(t, &pb.RegisterJobEventResponse{}, resp)
 		})
+		t.Run("should return error if every monitoring metric of job run is invalid", func(t *testing.T) {
+			namespaceName := "some-namespace"
+			tnnt, _ := tenant.NewTenant(projectName, namespaceName)
+			eventValues, _ := structpb.NewStruct(
+				map[string]interface{}{
+					"url":          "https://example.io",
+					"event_time":   1600361600,
+					"status":       "success",
+					"scheduled_at": "2022-01-02T15:04:05Z",
+					"task_id":      "sample_select",
+					"monitoring": map[string]interface{}{
+						"rows_written":    -10,
+						"bytes_processed": "100",
+						"total_rows":      10,
+					},
+				},
+			)
+			req := &pb.RegisterJobEventRequest{
+				ProjectName:   projectName,
+				JobName:       jobName,
+				NamespaceName: namespaceName,
+				Event: &pb.JobEvent{
+					Type:  pb.JobEvent_TYPE_JOB_SUCCESS,
+					Value: eventValues,
+				},
+			}
+			event, err := scheduler.EventFrom(
+				req.GetEvent().Type.String(),
+				req.GetEvent().Value.AsMap(),
+				scheduler.JobName(jobName), tnnt,
+			)
+			assert.Nil(t, err)
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("UpdateJobState", ctx, event).
+				Return(nil)
+			defer jobRunService.AssertExpectations(t)
+
+			notifier := new(mockNotifier)
+			notifier.On("Push", ctx, event).
+				Return(nil)
+			defer notifier.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier, nil, nil)
+
+			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
+			assert.ErrorContains(t, err, "every monitoring metric of the job run is invalid")
+			assert.ErrorContains(t, err, "monitoring metric rows_written should not be negative, got -10")
+			assert.ErrorContains(t, err, "monitoring metric bytes_processed should be a number, got 100")
+			assert.Equal(t, &pb.RegisterJobEventResponse{}, resp)
+		})
+		t.Run("should not return error if some monitoring metrics of job run are valid", func(t *testing.T) {
+			namespaceName := "some-namespace"
+			tnnt, _ := tenant.NewTenant(projectName, namespaceName)
+			eventValues, _ := structpb.NewStruct(
+				map[string]interface{}{
+					"url":          "https://example.io",
+					"event_time":   1600361600,
+					"status":       "success",
+					"scheduled_at": "2022-01-02T15:04:05Z",
+					"task_id":      "sample_select",
+					"monitoring": map[string]interface{}{
+						"rows_written": -10,
+						"slot_millis":  300,
+					},
+				},
+			)
+			req := &pb.RegisterJobEventRequest{
+				ProjectName:   projectName,
+				JobName:       jobName,
+				NamespaceName: namespaceName,
+				Event: &pb.JobEvent{
+					Type:  pb.JobEvent_TYPE_JOB_SUCCESS,
+					Value: eventValues,
+				},
+			}
+			event, err := scheduler.EventFrom(
+				req.GetEvent().Type.String(),
+				req.GetEvent().Value.AsMap(),
+				scheduler.JobName(jobName), tnnt,
+			)
+			assert.Nil(t, err)
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("UpdateJobState", ctx, event).
+				Return(nil)
+			defer jobRunService.AssertExpectations(t)
+
+			notifier := new(mockNotifier)
+			notifier.On("Push", ctx, event).
+				Return(nil)
+			defer notifier.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier, nil, nil)
+
+			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, &pb.RegisterJobEventResponse{}, resp)
+		})
 	})
 }
 
@@ -584,6 +1355,115 @@ func (m *mockJobRunService) GetJobRuns(ctx context.Context, projectName tenant.P
 	return args.Get(0).([]*scheduler.JobRunStatus), args.Error(1)
 }
 
+func (m *mockJobRunService) UpdateJobRunState(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time, change *scheduler.JobRunStateChange) ([]*scheduler.JobRunStatus, error) {
+	args := m.Called(ctx, projectName, jobName, startDate, endDate, change)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRunStatus), args.Error(1)
+}
+
+func (m *mockJobRunService) GetCompiledJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) ([]byte, error) {
+	args := m.Called(ctx, projectName, jobName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *mockJobRunService) GetJobRunStats(ctx context.Context, criteria *scheduler.JobRunStatsCriteria) (*scheduler.JobRunStats, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.JobRunStats), args.Error(1)
+}
+
+func (m *mockJobRunService) GetJobRunGaps(ctx context.Context, projectName tenant.ProjectName, namespaceName string, startTime, endTime time.Time) ([]*scheduler.JobRunGap, error) {
+	args := m.Called(ctx, projectName, namespaceName, startTime, endTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRunGap), args.Error(1)
+}
+
+func (m *mockJobRunService) GetJobRunLineage(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time, direction scheduler.LineageDirection, depth int) (*scheduler.JobRunLineage, error) {
+	args := m.Called(ctx, projectName, jobName, scheduledAt, direction, depth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.JobRunLineage), args.Error(1)
+}
+
+func (m *mockJobRunService) GetJobRunMetrics(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.MetricSeries, error) {
+	args := m.Called(ctx, projectName, jobName, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.MetricSeries), args.Error(1)
+}
+
+// mockJobRunWatcher calls the change handler with the job runs given after the error to return
+type mockJobRunWatcher struct {
+	mock.Mock
+}
+
+func (m *mockJobRunWatcher) Watch(ctx context.Context, filter *scheduler.JobRunWatchFilter, onChange func(*scheduler.JobRun) error) error {
+	args := m.Called(ctx, filter, onChange)
+	for _, jobRun := range args[1:] {
+		if err := onChange(jobRun.(*scheduler.JobRun)); err != nil {
+			return err
+		}
+	}
+	return args.Error(0)
+}
+
+type watchJobRunsStreamMock struct {
+	mock.Mock
+}
+
+func (m *watchJobRunsStreamMock) Context() context.Context {
+	args := m.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (m *watchJobRunsStreamMock) Send(response *pb.WatchJobRunsResponse) error {
+	args := m.Called(response)
+	return args.Error(0)
+}
+
+func (*watchJobRunsStreamMock) SetHeader(metadata.MD) error {
+	panic("not supported")
+}
+
+func (*watchJobRunsStreamMock) SendHeader(metadata.MD) error {
+	panic("not supported")
+}
+
+func (*watchJobRunsStreamMock) SetTrailer(metadata.MD) {
+	panic("not supported")
+}
+
+func (*watchJobRunsStreamMock) SendMsg(interface{}) error {
+	panic("not supported")
+}
+
+func (*watchJobRunsStreamMock) RecvMsg(interface{}) error {
+	panic("not supported")
+}
+
+type mockReconciler struct {
+	mock.Mock
+}
+
+func (m *mockReconciler) Reconcile(ctx context.Context, projectName tenant.ProjectName, namespaceName string, fix bool) ([]*scheduler.JobDrift, error) {
+	args := m.Called(ctx, projectName, namespaceName, fix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobDrift), args.Error(1)
+}
+
 type mockNotifier struct {
 	mock.Mock
 }
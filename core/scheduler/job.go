@@ -67,9 +67,28 @@ type Task struct {
 	Config map[string]string
 }
 
+const (
+	HookRunIfSuccess = "success"
+	HookRunIfFailure = "failure"
+	HookRunIfAlways  = "always"
+
+	// HookSkipConfig is set in the configs of a hook when its run_if expression evaluates to false
+	HookSkipConfig = "OPTIMUS_SKIP_HOOK"
+)
+
 type Hook struct {
-	Name   string
-	Config map[string]string
+	Name       string
+	Config     map[string]string
+	DependsOn  []string
+	RunIf      string
+	RetryCount int
+	RetryDelay time.Duration
+	Timeout    time.Duration
+}
+
+// IsRunIfExpression tells whether run_if of the hook is a template evaluated on every run
+func (h *Hook) IsRunIfExpression() bool {
+	return strings.Contains(h.RunIf, "{{")
 }
 
 // JobWithDetails contains the details for a job
@@ -84,6 +103,9 @@ type JobWithDetails struct {
 	RuntimeConfig RuntimeConfig
 	Priority      int
 	Upstreams     Upstreams
+
+	UpdatedAt time.Time
+	Disabled  bool
 }
 
 func (j *JobWithDetails) GetName() string {
@@ -116,6 +138,30 @@ func (j *JobWithDetails) SLADuration() (int64, error) {
 	return 0, nil
 }
 
+// SLADeadline returns the time by which the run scheduled at the given time should finish, which is the
+// deadline offset of the sla_deadline_miss alert added to the end of the window of the run
+func (j *JobWithDetails) SLADeadline(scheduledAt time.Time) (time.Time, bool, error) {
+	for _, notify := range j.Alerts {
+		if notify.On != EventCategorySLADeadlineMiss {
+			continue
+		}
+		if _, ok := notify.Config[SLADeadlineConfigKey]; !ok {
+			continue
+		}
+
+		offset, err := time.ParseDuration(notify.Config[SLADeadlineConfigKey])
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("failed to parse sla_deadline_miss deadline %s: %w", notify.Config[SLADeadlineConfigKey], err)
+		}
+		windowEnd, err := j.Job.Window.GetEndTime(scheduledAt)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("failed to get window end of run scheduled at %s: %w", scheduledAt, err)
+		}
+		return windowEnd.Add(offset), true, nil
+	}
+	return time.Time{}, false, nil
+}
+
 type JobMetadata struct {
 	Version     int
 	Owner       string
@@ -198,4 +244,17 @@ type JobUpstream struct {
 	Type           string
 	External       bool
 	State          string
+
+	// RunOffset shifts the window of the job when checking the upstream runs, eg. -24h
+	RunOffset string
+	// WindowSize, WindowOffset and WindowTruncateTo replace the window of the job when checking the upstream runs,
+	// following window version 2
+	WindowSize       string
+	WindowOffset     string
+	WindowTruncateTo string
+
+	// Optional upstreams do not block the job run when disabled or not done within MaxWait, zero MaxWait
+	// waits as long as the sensor does
+	Optional bool
+	MaxWait  time.Duration
 }
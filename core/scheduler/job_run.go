@@ -43,9 +43,45 @@ type JobRun struct {
 	SLAAlert    bool
 	EndTime     time.Time
 
+	// SLADeadlineMissed is set once the run is found not finished by its deadline
+	SLADeadlineMissed bool
+	// SkippedUpstreams are the optional upstreams the run proceeded without, the data of the run might be partial
+	SkippedUpstreams []*SkippedUpstream
+	// StateChangedBy and StateChangeReason are set once the state of the run is changed manually
+	StateChangedBy    string
+	StateChangeReason string
+
 	Monitoring map[string]any
 }
 
+// JobRunStateChange is a manual override of the state of job runs, along with who did it and why
+type JobRunStateChange struct {
+	State     State
+	ChangedBy string
+	Reason    string
+}
+
+func NewJobRunStateChange(state, changedBy, reason string) (*JobRunStateChange, error) {
+	runState, err := StateFromString(state)
+	if err != nil {
+		return nil, err
+	}
+	if runState != StateSuccess && runState != StateFailed && runState != StateSkipped {
+		return nil, errors.InvalidArgument(EntityJobRun, "job run state can only be changed to success, failed or skipped")
+	}
+	if changedBy == "" {
+		return nil, errors.InvalidArgument(EntityJobRun, "changed by is empty")
+	}
+	if reason == "" {
+		return nil, errors.InvalidArgument(EntityJobRun, "reason for changing job run state is empty")
+	}
+	return &JobRunStateChange{
+		State:     runState,
+		ChangedBy: changedBy,
+		Reason:    reason,
+	}, nil
+}
+
 type OperatorRun struct {
 	ID           uuid.UUID
 	Name         string
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+	"github.com/raystack/optimus/internal/lib/cron"
+	"github.com/raystack/optimus/internal/models"
+)
+
+// JobRunGap holds the scheduled times of a job which are expected from its schedule but have no successful run,
+// for example when the job is paused or broken on the scheduler
+type JobRunGap struct {
+	JobName JobName
+	Tenant  tenant.Tenant
+
+	// ScheduledAt is the missing scheduled times in ascending order
+	ScheduledAt []time.Time
+}
+
+func (g *JobRunGap) StartDate() time.Time {
+	return g.ScheduledAt[0]
+}
+
+func (g *JobRunGap) EndDate() time.Time {
+	return g.ScheduledAt[len(g.ScheduledAt)-1]
+}
+
+// MissingScheduledTimes returns the scheduled times of the job between start and end time without a successful run
+func MissingScheduledTimes(job *JobWithDetails, successfulRuns []time.Time, startTime, endTime time.Time) ([]time.Time, error) {
+	expected, err := ExpectedScheduledTimes(job, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	successful := make(map[time.Time]struct{}, len(successfulRuns))
+	for _, scheduledAt := range successfulRuns {
+		successful[scheduledAt.UTC()] = struct{}{}
+	}
+
+	var missing []time.Time
+	for _, scheduledAt := range expected {
+		if _, ok := successful[scheduledAt]; !ok {
+			missing = append(missing, scheduledAt)
+		}
+	}
+	return missing, nil
+}
+
+// ExpectedScheduledTimes returns the scheduled times of the job between start and end time in UTC, the scheduled
+// times which are not on a business day of the calendar of the job window are not expected to run
+func ExpectedScheduledTimes(job *JobWithDetails, startTime, endTime time.Time) ([]time.Time, error) {
+	if job.Schedule == nil || job.Schedule.Interval == "" {
+		return nil, nil
+	}
+	spec, err := cron.ParseCronSchedule(job.Schedule.Interval)
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityJobRun, "unable to parse job cron interval: "+err.Error())
+	}
+
+	if startTime.Before(job.Schedule.StartDate) {
+		startTime = job.Schedule.StartDate
+	}
+	if job.Schedule.EndDate != nil && endTime.After(*job.Schedule.EndDate) {
+		endTime = *job.Schedule.EndDate
+	}
+
+	var calendarWindow models.CalendarWindow
+	var skipNonBusinessDays bool
+	if job.Job != nil {
+		calendarWindow, skipNonBusinessDays = job.Job.Window.(models.CalendarWindow)
+	}
+
+	var expected []time.Time
+	for scheduledAt := spec.Next(startTime.Add(-time.Second)); !scheduledAt.After(endTime); scheduledAt = spec.Next(scheduledAt) {
+		if skipNonBusinessDays && !calendarWindow.IsBusinessDay(scheduledAt) {
+			continue
+		}
+		expected = append(expected, scheduledAt.UTC())
+	}
+	return expected, nil
+}
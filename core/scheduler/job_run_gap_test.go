@@ -0,0 +1,67 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestJobRunGap(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC)
+	newJob := func(schedule *scheduler.Schedule) *scheduler.JobWithDetails {
+		return &scheduler.JobWithDetails{Name: "job1", Schedule: schedule}
+	}
+
+	t.Run("MissingScheduledTimes", func(t *testing.T) {
+		t.Run("returns nothing when job has no interval", func(t *testing.T) {
+			missing, err := scheduler.MissingScheduledTimes(newJob(&scheduler.Schedule{StartDate: startTime}), nil, startTime, endTime)
+			assert.NoError(t, err)
+			assert.Empty(t, missing)
+		})
+		t.Run("returns error when interval is invalid", func(t *testing.T) {
+			job := newJob(&scheduler.Schedule{StartDate: startTime, Interval: "invalid"})
+			missing, err := scheduler.MissingScheduledTimes(job, nil, startTime, endTime)
+			assert.Nil(t, missing)
+			assert.ErrorContains(t, err, "unable to parse job cron interval")
+		})
+		t.Run("returns scheduled times without a successful run", func(t *testing.T) {
+			job := newJob(&scheduler.Schedule{StartDate: startTime.Add(-time.Hour * 24 * 10), Interval: "0 2 * * *"})
+			successfulRuns := []time.Time{time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)}
+
+			missing, err := scheduler.MissingScheduledTimes(job, successfulRuns, startTime, endTime)
+			assert.NoError(t, err)
+			assert.Equal(t, []time.Time{
+				time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 3, 2, 0, 0, 0, time.UTC),
+			}, missing)
+		})
+		t.Run("returns only the scheduled times within the job start and end date", func(t *testing.T) {
+			jobEndDate := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+			job := newJob(&scheduler.Schedule{StartDate: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), EndDate: &jobEndDate, Interval: "0 2 * * *"})
+
+			missing, err := scheduler.MissingScheduledTimes(job, nil, startTime, endTime)
+			assert.NoError(t, err)
+			assert.Equal(t, []time.Time{time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)}, missing)
+		})
+		t.Run("skips the scheduled times which are not on a business day of the window calendar", func(t *testing.T) {
+			calendar, err := models.CalendarFrom("exchange", "weekdays=mon-fri;holidays=2023-01-03")
+			assert.NoError(t, err)
+			window, err := models.NewWindowWithCalendar(3, "d", "0", "1b", "exchange")
+			assert.NoError(t, err)
+			window, err = window.(models.CalendarWindow).WithCalendar(calendar)
+			assert.NoError(t, err)
+
+			job := newJob(&scheduler.Schedule{StartDate: startTime.Add(-time.Hour * 24 * 10), Interval: "0 2 * * *"})
+			job.Job = &scheduler.Job{Name: "job1", Window: window}
+
+			missing, err := scheduler.MissingScheduledTimes(job, nil, startTime, endTime)
+			assert.NoError(t, err)
+			assert.Equal(t, []time.Time{time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)}, missing)
+		})
+	})
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+const (
+	LineageDirectionUpstream   LineageDirection = "upstream"
+	LineageDirectionDownstream LineageDirection = "downstream"
+
+	DefaultLineageDepth = 3
+	MaxLineageDepth     = 10
+)
+
+type LineageDirection string
+
+func LineageDirectionFrom(direction string) (LineageDirection, error) {
+	switch direction {
+	case "", string(LineageDirectionUpstream):
+		return LineageDirectionUpstream, nil
+	case string(LineageDirectionDownstream):
+		return LineageDirectionDownstream, nil
+	default:
+		return "", errors.InvalidArgument(EntityJobRun, "invalid lineage direction: "+direction)
+	}
+}
+
+func (d LineageDirection) String() string {
+	return string(d)
+}
+
+// JobRunRef identifies a run of a job, the job might be registered on another optimus server
+type JobRunRef struct {
+	JobName     JobName
+	Tenant      tenant.Tenant
+	ScheduledAt time.Time
+}
+
+// JobRunLineage is a job run along with the runs it consumed the data of when walked upstream,
+// or the runs which consumed its data when walked downstream
+type JobRunLineage struct {
+	JobRunRef
+
+	// ID and State are empty when the run is not known by this server
+	ID    uuid.UUID
+	State State
+
+	Links []*JobRunLineage
+}
+
+func NewJobRunLineage(jobRun *JobRun) *JobRunLineage {
+	return &JobRunLineage{
+		JobRunRef: JobRunRef{
+			JobName:     jobRun.JobName,
+			Tenant:      jobRun.Tenant,
+			ScheduledAt: jobRun.ScheduledAt,
+		},
+		ID:    jobRun.ID,
+		State: jobRun.State,
+	}
+}
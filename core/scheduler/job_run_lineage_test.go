@@ -0,0 +1,29 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+)
+
+func TestJobRunLineage(t *testing.T) {
+	t.Run("LineageDirectionFrom", func(t *testing.T) {
+		t.Run("returns upstream when direction is not given", func(t *testing.T) {
+			direction, err := scheduler.LineageDirectionFrom("")
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.LineageDirectionUpstream, direction)
+		})
+		t.Run("returns the direction", func(t *testing.T) {
+			direction, err := scheduler.LineageDirectionFrom("downstream")
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.LineageDirectionDownstream, direction)
+		})
+		t.Run("returns error when direction is invalid", func(t *testing.T) {
+			direction, err := scheduler.LineageDirectionFrom("sideways")
+			assert.Empty(t, direction)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: invalid lineage direction: sideways")
+		})
+	})
+}
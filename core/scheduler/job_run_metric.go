@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/raystack/optimus/internal/errors"
+)
+
+const (
+	MetricRowsWritten    MetricName = "rows_written"
+	MetricBytesProcessed MetricName = "bytes_processed"
+	MetricSlotMillis     MetricName = "slot_millis"
+	MetricCost           MetricName = "cost"
+)
+
+// MetricName is a metric of the data processed by a job run, reported by the task under the monitoring key of its result
+type MetricName string
+
+func (m MetricName) String() string {
+	return string(m)
+}
+
+// isCount tells whether the metric can only be a whole number
+func (m MetricName) isCount() bool {
+	return m != MetricCost
+}
+
+// MetricNames are the metrics of the schema in the order they are stored and reported
+var MetricNames = []MetricName{MetricRowsWritten, MetricBytesProcessed, MetricSlotMillis, MetricCost}
+
+// JobRunMetrics are the metrics reported by a run, only the reported metrics are set
+type JobRunMetrics struct {
+	ScheduledAt time.Time
+	Values      map[MetricName]float64
+}
+
+// JobRunMetricsFrom validates the metrics of the schema from the monitoring values of a job run,
+// the values outside the schema are kept only in the monitoring of the run. The invalid metrics are left out
+// of the returned metrics, along with an error telling why.
+func JobRunMetricsFrom(scheduledAt time.Time, monitoring map[string]any) (*JobRunMetrics, error) {
+	me := errors.NewMultiError("invalid monitoring metrics")
+	values := map[MetricName]float64{}
+	for _, name := range MetricNames {
+		raw, ok := monitoring[name.String()]
+		if !ok || raw == nil {
+			continue
+		}
+		value, ok := raw.(float64)
+		if !ok {
+			me.Append(errors.InvalidArgument(EntityJobRun, fmt.Sprintf("monitoring metric %s should be a number, got %v", name, raw)))
+			continue
+		}
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			me.Append(errors.InvalidArgument(EntityJobRun, fmt.Sprintf("monitoring metric %s should be finite, got %v", name, value)))
+			continue
+		}
+		if value < 0 {
+			me.Append(errors.InvalidArgument(EntityJobRun, fmt.Sprintf("monitoring metric %s should not be negative, got %v", name, value)))
+			continue
+		}
+		if name.isCount() && value != math.Trunc(value) {
+			me.Append(errors.InvalidArgument(EntityJobRun, fmt.Sprintf("monitoring metric %s should be a whole number, got %v", name, value)))
+			continue
+		}
+		values[name] = value
+	}
+	return &JobRunMetrics{
+		ScheduledAt: scheduledAt,
+		Values:      values,
+	}, me.ToErr()
+}
+
+func (m *JobRunMetrics) IsEmpty() bool {
+	return len(m.Values) == 0
+}
+
+type MetricPoint struct {
+	ScheduledAt time.Time
+	Value       float64
+}
+
+// MetricSeries is the values of a metric over the runs of a job
+type MetricSeries struct {
+	Name   MetricName
+	Points []MetricPoint
+}
+
+// MetricSeriesFrom groups the metrics of the runs per metric, runs without the metric are left out of its series
+func MetricSeriesFrom(runMetrics []*JobRunMetrics) []*MetricSeries {
+	sorted := make([]*JobRunMetrics, len(runMetrics))
+	copy(sorted, runMetrics)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ScheduledAt.Before(sorted[j].ScheduledAt) })
+
+	var series []*MetricSeries
+	for _, name := range MetricNames {
+		var points []MetricPoint
+		for _, metrics := range sorted {
+			if value, ok := metrics.Values[name]; ok {
+				points = append(points, MetricPoint{ScheduledAt: metrics.ScheduledAt, Value: value})
+			}
+		}
+		if len(points) > 0 {
+			series = append(series, &MetricSeries{Name: name, Points: points})
+		}
+	}
+	return series
+}
@@ -0,0 +1,99 @@
+package scheduler_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+)
+
+func TestJobRunMetric(t *testing.T) {
+	scheduledAt := time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC)
+
+	t.Run("JobRunMetricsFrom", func(t *testing.T) {
+		t.Run("returns the metrics of the schema from monitoring values", func(t *testing.T) {
+			metrics, err := scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{
+				"rows_written":    float64(1200),
+				"bytes_processed": float64(2048),
+				"slot_millis":     float64(5000),
+				"cost":            0.75,
+				"custom_metric":   "not in schema",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, scheduledAt, metrics.ScheduledAt)
+			assert.Equal(t, map[scheduler.MetricName]float64{
+				scheduler.MetricRowsWritten:    1200,
+				scheduler.MetricBytesProcessed: 2048,
+				scheduler.MetricSlotMillis:     5000,
+				scheduler.MetricCost:           0.75,
+			}, metrics.Values)
+			assert.False(t, metrics.IsEmpty())
+		})
+		t.Run("returns empty metrics when none of the schema is reported", func(t *testing.T) {
+			metrics, err := scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{"total_bytes_processed": float64(10)})
+			assert.NoError(t, err)
+			assert.True(t, metrics.IsEmpty())
+
+			metrics, err = scheduler.JobRunMetricsFrom(scheduledAt, nil)
+			assert.NoError(t, err)
+			assert.True(t, metrics.IsEmpty())
+		})
+		t.Run("returns error along with the valid metrics when metric is not a number", func(t *testing.T) {
+			metrics, err := scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{"rows_written": "100", "slot_millis": float64(10)})
+			assert.Equal(t, map[scheduler.MetricName]float64{scheduler.MetricSlotMillis: 10}, metrics.Values)
+			assert.ErrorContains(t, err, "monitoring metric rows_written should be a number, got 100")
+		})
+		t.Run("returns error when metric is negative or not finite", func(t *testing.T) {
+			metrics, err := scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{"cost": -0.5})
+			assert.True(t, metrics.IsEmpty())
+			assert.ErrorContains(t, err, "monitoring metric cost should not be negative, got -0.5")
+
+			metrics, err = scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{"cost": math.NaN()})
+			assert.True(t, metrics.IsEmpty())
+			assert.ErrorContains(t, err, "monitoring metric cost should be finite, got NaN")
+
+			metrics, err = scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{"bytes_processed": math.Inf(1)})
+			assert.True(t, metrics.IsEmpty())
+			assert.ErrorContains(t, err, "monitoring metric bytes_processed should be finite, got +Inf")
+		})
+		t.Run("returns error when count metric is not a whole number", func(t *testing.T) {
+			metrics, err := scheduler.JobRunMetricsFrom(scheduledAt, map[string]any{"slot_millis": 10.5})
+			assert.True(t, metrics.IsEmpty())
+			assert.ErrorContains(t, err, "monitoring metric slot_millis should be a whole number, got 10.5")
+		})
+	})
+	t.Run("MetricSeriesFrom", func(t *testing.T) {
+		t.Run("returns series per metric ordered by scheduled time", func(t *testing.T) {
+			nextScheduledAt := scheduledAt.Add(time.Hour * 24)
+			series := scheduler.MetricSeriesFrom([]*scheduler.JobRunMetrics{
+				{
+					ScheduledAt: nextScheduledAt,
+					Values:      map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 20, scheduler.MetricSlotMillis: 300},
+				},
+				{
+					ScheduledAt: scheduledAt,
+					Values:      map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 10},
+				},
+			})
+			assert.Equal(t, []*scheduler.MetricSeries{
+				{
+					Name: scheduler.MetricRowsWritten,
+					Points: []scheduler.MetricPoint{
+						{ScheduledAt: scheduledAt, Value: 10},
+						{ScheduledAt: nextScheduledAt, Value: 20},
+					},
+				},
+				{
+					Name:   scheduler.MetricSlotMillis,
+					Points: []scheduler.MetricPoint{{ScheduledAt: nextScheduledAt, Value: 300}},
+				},
+			}, series)
+		})
+		t.Run("returns no series when runs have no metrics", func(t *testing.T) {
+			assert.Empty(t, scheduler.MetricSeriesFrom(nil))
+		})
+	})
+}
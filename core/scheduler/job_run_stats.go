@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+// JobRunStatsCriteria scopes the job runs to calculate the stats on, namespace and job name are optional
+type JobRunStatsCriteria struct {
+	ProjectName   tenant.ProjectName
+	NamespaceName tenant.NamespaceName
+	JobName       JobName
+
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+func NewJobRunStatsCriteria(projectName tenant.ProjectName, namespaceName, jobName string, startDate, endDate time.Time) (*JobRunStatsCriteria, error) {
+	if startDate.IsZero() || endDate.IsZero() {
+		return nil, errors.InvalidArgument(EntityJobRun, "start date and end date of job run stats are required")
+	}
+	if endDate.Before(startDate) {
+		return nil, errors.InvalidArgument(EntityJobRun, "end date cannot be before start date")
+	}
+	return &JobRunStatsCriteria{
+		ProjectName:   projectName,
+		NamespaceName: tenant.NamespaceName(namespaceName),
+		JobName:       JobName(jobName),
+		StartDate:     startDate,
+		EndDate:       endDate,
+	}, nil
+}
+
+type JobRunStats struct {
+	TotalRuns   int
+	SuccessRuns int
+	FailedRuns  int
+	SLAMisses   int
+
+	Operators []*OperatorRunStats
+	Trend     []*JobRunStatsTrend
+}
+
+// SuccessRate is the ratio of successful runs among the finished runs
+func (s *JobRunStats) SuccessRate() float64 {
+	finishedRuns := s.SuccessRuns + s.FailedRuns
+	if finishedRuns == 0 {
+		return 0
+	}
+	return float64(s.SuccessRuns) / float64(finishedRuns)
+}
+
+func (s *JobRunStats) Retries() int {
+	retries := 0
+	for _, operator := range s.Operators {
+		retries += operator.Retries
+	}
+	return retries
+}
+
+// OperatorRunStats holds the stats of operators of a type, eg. sensors waiting for upstreams, task or hooks
+type OperatorRunStats struct {
+	OperatorType OperatorType
+	Runs         int
+	Retries      int
+	P50Duration  time.Duration
+	P95Duration  time.Duration
+}
+
+// JobRunStatsTrend holds the stats of job runs scheduled on a day
+type JobRunStatsTrend struct {
+	Date        time.Time
+	TotalRuns   int
+	SuccessRuns int
+	SLAMisses   int
+}
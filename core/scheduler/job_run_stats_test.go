@@ -0,0 +1,56 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestJobRunStats(t *testing.T) {
+	startDate := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.Add(time.Hour * 24 * 7)
+
+	t.Run("NewJobRunStatsCriteria", func(t *testing.T) {
+		t.Run("returns error when dates are not given", func(t *testing.T) {
+			criteria, err := scheduler.NewJobRunStatsCriteria("proj", "", "", time.Time{}, endDate)
+			assert.Nil(t, criteria)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: start date and end date of job run stats are required")
+		})
+		t.Run("returns error when end date is before start date", func(t *testing.T) {
+			criteria, err := scheduler.NewJobRunStatsCriteria("proj", "", "", endDate, startDate)
+			assert.Nil(t, criteria)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: end date cannot be before start date")
+		})
+		t.Run("returns criteria with optional namespace and job", func(t *testing.T) {
+			criteria, err := scheduler.NewJobRunStatsCriteria("proj", "ns", "", startDate, endDate)
+			assert.NoError(t, err)
+			assert.Equal(t, &scheduler.JobRunStatsCriteria{
+				ProjectName:   "proj",
+				NamespaceName: tenant.NamespaceName("ns"),
+				StartDate:     startDate,
+				EndDate:       endDate,
+			}, criteria)
+		})
+	})
+	t.Run("SuccessRate", func(t *testing.T) {
+		t.Run("returns zero when no run is finished", func(t *testing.T) {
+			stats := scheduler.JobRunStats{TotalRuns: 2}
+			assert.Equal(t, float64(0), stats.SuccessRate())
+		})
+		t.Run("returns ratio of successful runs among finished runs", func(t *testing.T) {
+			stats := scheduler.JobRunStats{TotalRuns: 5, SuccessRuns: 3, FailedRuns: 1}
+			assert.Equal(t, 0.75, stats.SuccessRate())
+		})
+	})
+	t.Run("Retries", func(t *testing.T) {
+		stats := scheduler.JobRunStats{Operators: []*scheduler.OperatorRunStats{
+			{OperatorType: scheduler.OperatorSensor, Retries: 1},
+			{OperatorType: scheduler.OperatorTask, Retries: 2},
+		}}
+		assert.Equal(t, 3, stats.Retries())
+	})
+}
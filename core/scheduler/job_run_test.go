@@ -0,0 +1,51 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+)
+
+func TestJobRunStateChange(t *testing.T) {
+	t.Run("NewJobRunStateChange", func(t *testing.T) {
+		t.Run("returns error when state is invalid", func(t *testing.T) {
+			change, err := scheduler.NewJobRunStateChange("unknown", "optimus@example.io", "upstream incident")
+			assert.Nil(t, change)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: invalid state for run unknown")
+		})
+		t.Run("returns error when state is not a terminal state", func(t *testing.T) {
+			change, err := scheduler.NewJobRunStateChange("running", "optimus@example.io", "upstream incident")
+			assert.Nil(t, change)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: job run state can only be changed to success, failed or skipped")
+		})
+		t.Run("returns error when changed by is empty", func(t *testing.T) {
+			change, err := scheduler.NewJobRunStateChange("success", "", "upstream incident")
+			assert.Nil(t, change)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: changed by is empty")
+		})
+		t.Run("returns error when reason is empty", func(t *testing.T) {
+			change, err := scheduler.NewJobRunStateChange("success", "optimus@example.io", "")
+			assert.Nil(t, change)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: reason for changing job run state is empty")
+		})
+		t.Run("returns state change for success, failed and skipped state", func(t *testing.T) {
+			change, err := scheduler.NewJobRunStateChange("SUCCESS", "optimus@example.io", "upstream incident")
+			assert.NoError(t, err)
+			assert.Equal(t, &scheduler.JobRunStateChange{
+				State:     scheduler.StateSuccess,
+				ChangedBy: "optimus@example.io",
+				Reason:    "upstream incident",
+			}, change)
+
+			change, err = scheduler.NewJobRunStateChange("failed", "optimus@example.io", "bad data")
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.StateFailed, change.State)
+
+			change, err = scheduler.NewJobRunStateChange("skipped", "optimus@example.io", "holiday")
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.StateSkipped, change.State)
+		})
+	})
+}
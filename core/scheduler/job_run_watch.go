@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+// JobRunWatchFilter selects the job runs to watch the state changes of, within a project
+type JobRunWatchFilter struct {
+	ProjectName tenant.ProjectName
+
+	// NamespaceName, JobNames and Labels are optional, runs should match all which are given
+	NamespaceName tenant.NamespaceName
+	JobNames      []JobName
+	Labels        map[string]string
+}
+
+func NewJobRunWatchFilter(projectName, namespaceName string, jobNames []string, labels map[string]string) (*JobRunWatchFilter, error) {
+	project, err := tenant.ProjectNameFrom(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]JobName, len(jobNames))
+	for i, jobName := range jobNames {
+		names[i], err = JobNameFrom(jobName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for key := range labels {
+		if key == "" {
+			return nil, errors.InvalidArgument(EntityJobRun, "label key to watch is empty")
+		}
+	}
+
+	return &JobRunWatchFilter{
+		ProjectName:   project,
+		NamespaceName: tenant.NamespaceName(namespaceName),
+		JobNames:      names,
+		Labels:        labels,
+	}, nil
+}
+
+// HasLabels tells whether the labels of the job are needed to match its runs
+func (f *JobRunWatchFilter) HasLabels() bool {
+	return len(f.Labels) > 0
+}
+
+// MatchJobRun matches the job run on project, namespace and job names, labels are matched separately
+func (f *JobRunWatchFilter) MatchJobRun(jobRun *JobRun) bool {
+	if jobRun.Tenant.ProjectName() != f.ProjectName {
+		return false
+	}
+	if f.NamespaceName != "" && jobRun.Tenant.NamespaceName() != f.NamespaceName {
+		return false
+	}
+	if len(f.JobNames) == 0 {
+		return true
+	}
+	for _, jobName := range f.JobNames {
+		if jobRun.JobName == jobName {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchLabels matches when the job has every label of the filter with the same value
+func (f *JobRunWatchFilter) MatchLabels(labels map[string]string) bool {
+	for key, value := range f.Labels {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestJobRunWatchFilter(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("proj", "ns")
+	otherTnnt, _ := tenant.NewTenant("other-proj", "ns")
+
+	t.Run("NewJobRunWatchFilter", func(t *testing.T) {
+		t.Run("returns error when project name is empty", func(t *testing.T) {
+			filter, err := scheduler.NewJobRunWatchFilter("", "ns", nil, nil)
+			assert.Nil(t, filter)
+			assert.EqualError(t, err, "invalid argument for entity project: project name is empty")
+		})
+		t.Run("returns error when a job name is empty", func(t *testing.T) {
+			filter, err := scheduler.NewJobRunWatchFilter("proj", "", []string{"job1", ""}, nil)
+			assert.Nil(t, filter)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: job name is empty")
+		})
+		t.Run("returns error when a label key is empty", func(t *testing.T) {
+			filter, err := scheduler.NewJobRunWatchFilter("proj", "", nil, map[string]string{"": "value"})
+			assert.Nil(t, filter)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: label key to watch is empty")
+		})
+		t.Run("returns the filter", func(t *testing.T) {
+			filter, err := scheduler.NewJobRunWatchFilter("proj", "ns", []string{"job1"}, map[string]string{"team": "data"})
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.ProjectName("proj"), filter.ProjectName)
+			assert.Equal(t, tenant.NamespaceName("ns"), filter.NamespaceName)
+			assert.Equal(t, []scheduler.JobName{"job1"}, filter.JobNames)
+			assert.True(t, filter.HasLabels())
+		})
+	})
+	t.Run("MatchJobRun", func(t *testing.T) {
+		jobRun := &scheduler.JobRun{JobName: "job1", Tenant: tnnt}
+		t.Run("matches every run of the project when only project is given", func(t *testing.T) {
+			filter, _ := scheduler.NewJobRunWatchFilter("proj", "", nil, nil)
+			assert.True(t, filter.MatchJobRun(jobRun))
+			assert.False(t, filter.MatchJobRun(&scheduler.JobRun{JobName: "job1", Tenant: otherTnnt}))
+			assert.False(t, filter.HasLabels())
+		})
+		t.Run("matches the runs of the namespace and jobs", func(t *testing.T) {
+			filter, _ := scheduler.NewJobRunWatchFilter("proj", "ns", []string{"job2", "job1"}, nil)
+			assert.True(t, filter.MatchJobRun(jobRun))
+			assert.False(t, filter.MatchJobRun(&scheduler.JobRun{JobName: "job3", Tenant: tnnt}))
+
+			filter, _ = scheduler.NewJobRunWatchFilter("proj", "other-ns", nil, nil)
+			assert.False(t, filter.MatchJobRun(jobRun))
+		})
+	})
+	t.Run("MatchLabels", func(t *testing.T) {
+		filter, _ := scheduler.NewJobRunWatchFilter("proj", "", nil, map[string]string{"team": "data", "tier": "1"})
+		t.Run("matches when the job has every label", func(t *testing.T) {
+			assert.True(t, filter.MatchLabels(map[string]string{"team": "data", "tier": "1", "owner": "x"}))
+		})
+		t.Run("does not match when a label is missing or different", func(t *testing.T) {
+			assert.False(t, filter.MatchLabels(map[string]string{"team": "data"}))
+			assert.False(t, filter.MatchLabels(map[string]string{"team": "data", "tier": "2"}))
+			assert.False(t, filter.MatchLabels(nil))
+		})
+	})
+}
@@ -2,12 +2,14 @@ package scheduler_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/raystack/optimus/core/scheduler"
 	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
 )
 
 func TestJob(t *testing.T) {
@@ -124,6 +126,57 @@ func TestJob(t *testing.T) {
 			assert.Equal(t, int64(7200), duration)
 		})
 	})
+	t.Run("SLADeadline", func(t *testing.T) {
+		window, _ := models.NewWindow(2, "d", "0", "24h")
+		scheduledAt := time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)
+		t.Run("should return false if deadline is not specified", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: "jobName",
+				Job:  &scheduler.Job{Window: window},
+				Alerts: []scheduler.Alert{
+					{
+						On:     scheduler.EventCategorySLAMiss,
+						Config: map[string]string{"duration": "2h"},
+					},
+				},
+			}
+			deadline, ok, err := jobWithDetails.SLADeadline(scheduledAt)
+			assert.Nil(t, err)
+			assert.False(t, ok)
+			assert.True(t, deadline.IsZero())
+		})
+		t.Run("should return error if deadline is incorrect format", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: "jobName",
+				Job:  &scheduler.Job{Window: window},
+				Alerts: []scheduler.Alert{
+					{
+						On:     scheduler.EventCategorySLADeadlineMiss,
+						Config: map[string]string{"deadline": "7 hours"},
+					},
+				},
+			}
+			_, ok, err := jobWithDetails.SLADeadline(scheduledAt)
+			assert.False(t, ok)
+			assert.ErrorContains(t, err, "failed to parse sla_deadline_miss deadline 7 hours")
+		})
+		t.Run("should get deadline as offset from the window end", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: "jobName",
+				Job:  &scheduler.Job{Window: window},
+				Alerts: []scheduler.Alert{
+					{
+						On:     scheduler.EventCategorySLADeadlineMiss,
+						Config: map[string]string{"deadline": "7h"},
+					},
+				},
+			}
+			deadline, ok, err := jobWithDetails.SLADeadline(scheduledAt)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, time.Date(2023, 1, 2, 7, 0, 0, 0, time.UTC), deadline)
+		})
+	})
 	t.Run("GetLabelsAsString", func(t *testing.T) {
 		jobWithDetails := scheduler.JobWithDetails{
 			Name: "jobName",
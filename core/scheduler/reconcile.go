@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"github.com/raystack/optimus/core/tenant"
+)
+
+type DriftType string
+
+func (d DriftType) String() string {
+	return string(d)
+}
+
+const (
+	// DriftOrphaned is a job deployed on the scheduler which does not exist in the namespace
+	DriftOrphaned DriftType = "orphaned"
+	// DriftMissing is a job of the namespace which is not deployed on the scheduler
+	DriftMissing DriftType = "missing"
+	// DriftStale is a job deployed on the scheduler which differs from the job compiled from the stored job
+	DriftStale DriftType = "stale"
+)
+
+// DeployedJob is a job as found on the scheduler, the tenant is the namespace directory it is deployed under
+type DeployedJob struct {
+	Name     JobName
+	Tenant   tenant.Tenant
+	Checksum string
+}
+
+// JobDrift is a difference between the jobs deployed on the scheduler and the stored jobs
+type JobDrift struct {
+	JobName JobName
+	Tenant  tenant.Tenant
+	Type    DriftType
+}
+
+// DetectDrifts compares the jobs of a tenant with the jobs deployed on the scheduler for that tenant, a deployed job
+// is stale when its checksum differs from the checksum of the job compiled now, jobs without a checksum are not compared
+func DetectDrifts(t tenant.Tenant, jobs []*JobWithDetails, checksums map[JobName]string, deployedJobs []*DeployedJob) []*JobDrift {
+	jobsByName := make(map[JobName]*JobWithDetails, len(jobs))
+	for _, job := range jobs {
+		jobsByName[job.Name] = job
+	}
+
+	var drifts []*JobDrift
+	deployedJobNames := make(map[JobName]struct{}, len(deployedJobs))
+	for _, deployedJob := range deployedJobs {
+		deployedJobNames[deployedJob.Name] = struct{}{}
+
+		job, ok := jobsByName[deployedJob.Name]
+		if !ok {
+			drifts = append(drifts, &JobDrift{JobName: deployedJob.Name, Tenant: t, Type: DriftOrphaned})
+			continue
+		}
+		if checksum, ok := checksums[job.Name]; ok && checksum != deployedJob.Checksum {
+			drifts = append(drifts, &JobDrift{JobName: deployedJob.Name, Tenant: t, Type: DriftStale})
+		}
+	}
+
+	for _, job := range jobs {
+		if _, ok := deployedJobNames[job.Name]; !ok {
+			drifts = append(drifts, &JobDrift{JobName: job.Name, Tenant: t, Type: DriftMissing})
+		}
+	}
+	return drifts
+}
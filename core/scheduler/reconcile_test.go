@@ -0,0 +1,49 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestDetectDrifts(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("test-proj", "test-ns")
+
+	t.Run("returns no drift when deployed jobs are up to date", func(t *testing.T) {
+		jobs := []*scheduler.JobWithDetails{{Name: "job-a"}}
+		checksums := map[scheduler.JobName]string{"job-a": "checksum-a"}
+		deployedJobs := []*scheduler.DeployedJob{{Name: "job-a", Tenant: tnnt, Checksum: "checksum-a"}}
+
+		drifts := scheduler.DetectDrifts(tnnt, jobs, checksums, deployedJobs)
+		assert.Empty(t, drifts)
+	})
+	t.Run("returns orphaned, stale and missing jobs", func(t *testing.T) {
+		jobs := []*scheduler.JobWithDetails{
+			{Name: "job-stale"},
+			{Name: "job-missing"},
+			{Name: "job-ok"},
+			{Name: "job-not-compiled"},
+		}
+		checksums := map[scheduler.JobName]string{
+			"job-stale":   "checksum-updated",
+			"job-missing": "checksum-missing",
+			"job-ok":      "checksum-ok",
+		}
+		deployedJobs := []*scheduler.DeployedJob{
+			{Name: "job-orphaned", Tenant: tnnt, Checksum: "checksum-orphaned"},
+			{Name: "job-stale", Tenant: tnnt, Checksum: "checksum-stale"},
+			{Name: "job-ok", Tenant: tnnt, Checksum: "checksum-ok"},
+			{Name: "job-not-compiled", Tenant: tnnt, Checksum: "checksum-not-compiled"},
+		}
+
+		drifts := scheduler.DetectDrifts(tnnt, jobs, checksums, deployedJobs)
+		assert.Equal(t, []*scheduler.JobDrift{
+			{JobName: "job-orphaned", Tenant: tnnt, Type: scheduler.DriftOrphaned},
+			{JobName: "job-stale", Tenant: tnnt, Type: scheduler.DriftStale},
+			{JobName: "job-missing", Tenant: tnnt, Type: scheduler.DriftMissing},
+		}, drifts)
+	})
+}
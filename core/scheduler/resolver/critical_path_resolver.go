@@ -0,0 +1,300 @@
+package resolver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+const (
+	// minPriorityWeight - is the minimum weight a DAG will be given.
+	minPriorityWeight = 1
+	// minSLAPathPriorityWeight - is the minimum weight of a DAG on the path to a job with SLA,
+	// the weights below it are given to the DAGs without SLA downstream
+	minSLAPathPriorityWeight = 5001
+
+	// priorityBandSteps - is the number of steps the primary and the secondary measure of a node are capped to
+	priorityBandSteps = 49
+	// priorityDurationStep - is the duration counted as a single step of path duration
+	priorityDurationStep = time.Minute * 10
+
+	EntityPriorityResolver = "priorityResolver"
+)
+
+type JobRepository interface {
+	GetDownstreams(ctx context.Context, projectName tenant.ProjectName, jobNames []string) ([]*scheduler.JobWithDetails, error)
+}
+
+type JobRunDurationRepository interface {
+	GetJobDurations(ctx context.Context, projectName tenant.ProjectName, jobNames []string, since time.Time) (map[scheduler.JobName]time.Duration, error)
+}
+
+// CriticalPathResolver gives higher priority to the jobs which are on the longest path to the jobs with SLA,
+// followed by the jobs having deeper downstream chain and longer historical duration of their longest path.
+// Only the jobs depending on the jobs being resolved, directly or not and in any project, are loaded to build the graph.
+// The priority of a job depends only on its downstream chain, so it is the same whichever jobs are resolved together,
+// except on cyclic dependencies where the edge ignored to break the cycle depends on the jobs loaded into the graph.
+// As the durations change with every run, the priority is only refreshed when the job is deployed, the reconciler
+// does not count a change of priority alone as a stale job.
+type CriticalPathResolver struct {
+	jobRepo      JobRepository
+	durationRepo JobRunDurationRepository
+
+	lookback time.Duration
+	now      func() time.Time
+}
+
+type jobNode struct {
+	key         string
+	projectName tenant.ProjectName
+	jobName     scheduler.JobName
+	duration    time.Duration
+	hasSLA      bool
+
+	downstreams []jobEdge
+
+	resolved           bool
+	visiting           bool
+	depth              int
+	pathDuration       time.Duration
+	onSLAPath          bool
+	slaPathDuration    time.Duration
+	feedsOtherProjects bool
+}
+
+// jobEdge points to a downstream job, crossProject is set when the downstream belongs to another project
+type jobEdge struct {
+	node         *jobNode
+	crossProject bool
+}
+
+func (r CriticalPathResolver) Resolve(ctx context.Context, details []*scheduler.JobWithDetails) error {
+	nodes := map[string]*jobNode{}
+	jobs := map[string]*scheduler.JobWithDetails{}
+	var frontier []*scheduler.JobWithDetails
+	for _, job := range details {
+		key := jobKey(job.Job.Tenant.ProjectName(), job.Name.String())
+		if _, ok := nodes[key]; ok {
+			continue
+		}
+		nodes[key] = newJobNode(job)
+		jobs[key] = job
+		frontier = append(frontier, job)
+	}
+
+	// load the downstream closure of the jobs being resolved, one level at a time
+	for len(frontier) > 0 {
+		var next []*scheduler.JobWithDetails
+		for _, projectName := range sortedProjectNames(frontier) {
+			downstreams, err := r.jobRepo.GetDownstreams(ctx, projectName, jobNamesOf(frontier, projectName))
+			if err != nil {
+				return errors.AddErrContext(err, EntityPriorityResolver, "unable to get downstream jobs of project "+projectName.String())
+			}
+			for _, downstream := range downstreams {
+				key := jobKey(downstream.Job.Tenant.ProjectName(), downstream.Name.String())
+				if _, ok := nodes[key]; ok {
+					continue
+				}
+				nodes[key] = newJobNode(downstream)
+				jobs[key] = downstream
+				next = append(next, downstream)
+			}
+		}
+		frontier = next
+	}
+
+	if err := r.loadDurations(ctx, nodes); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(jobs))
+	for key := range jobs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		job, node := jobs[key], nodes[key]
+		for _, upstream := range job.Upstreams.UpstreamJobs {
+			if upstream.External {
+				continue
+			}
+			upstreamNode, ok := nodes[jobKey(upstream.Tenant.ProjectName(), upstream.JobName)]
+			if !ok || upstreamNode == node {
+				continue
+			}
+			upstreamNode.downstreams = append(upstreamNode.downstreams, jobEdge{
+				node:         node,
+				crossProject: upstreamNode.projectName != node.projectName,
+			})
+		}
+	}
+
+	priorities := assignPriorities(nodes)
+	for _, job := range details {
+		job.Priority = priorities[jobKey(job.Job.Tenant.ProjectName(), job.Name.String())]
+	}
+	return nil
+}
+
+// loadDurations sets the historical duration of every node, querying only the jobs of the graph
+func (r CriticalPathResolver) loadDurations(ctx context.Context, nodes map[string]*jobNode) error {
+	jobNamesByProject := map[tenant.ProjectName][]string{}
+	for _, node := range nodes {
+		jobNamesByProject[node.projectName] = append(jobNamesByProject[node.projectName], node.jobName.String())
+	}
+	since := r.now().Add(-r.lookback)
+	for projectName, jobNames := range jobNamesByProject {
+		sort.Strings(jobNames)
+		durations, err := r.durationRepo.GetJobDurations(ctx, projectName, jobNames, since)
+		if err != nil {
+			return errors.AddErrContext(err, EntityPriorityResolver, "unable to get job durations of project "+projectName.String())
+		}
+		for _, jobName := range jobNames {
+			nodes[jobKey(projectName, jobName)].duration = durations[scheduler.JobName(jobName)]
+		}
+	}
+	return nil
+}
+
+// assignPriorities resolves every node and gives it a weight from its own measures, so the weight does not depend on
+// the other nodes of the graph. The jobs on the path to a job with SLA get the upper half of the weights, ordered by
+// the duration of that path and then by their downstream depth. The others are ordered by their downstream depth and
+// then by the duration of their longest path. Jobs feeding other projects come first among the jobs with same measures.
+func assignPriorities(nodes map[string]*jobNode) map[string]int {
+	sortedNodes := make([]*jobNode, 0, len(nodes))
+	for _, node := range nodes {
+		sortedNodes = append(sortedNodes, node)
+	}
+	// resolve in the order of the job names, so the edges ignored on cyclic dependencies are the same for the same graph
+	sort.Slice(sortedNodes, func(i, j int) bool {
+		return sortedNodes[i].key < sortedNodes[j].key
+	})
+
+	priorities := make(map[string]int, len(sortedNodes))
+	for _, node := range sortedNodes {
+		node.resolve()
+		priorities[node.key] = node.priorityWeight()
+	}
+	return priorities
+}
+
+func (n *jobNode) priorityWeight() int {
+	weight := minPriorityWeight
+	primary, secondary := n.depth, durationSteps(n.pathDuration)
+	if n.onSLAPath {
+		weight = minSLAPathPriorityWeight
+		primary, secondary = durationSteps(n.slaPathDuration), n.depth
+	}
+	weight += capSteps(primary)*(priorityBandSteps+1)*2 + capSteps(secondary)*2
+	if n.feedsOtherProjects {
+		weight++
+	}
+	return weight
+}
+
+func durationSteps(duration time.Duration) int {
+	return int(duration / priorityDurationStep)
+}
+
+func capSteps(steps int) int {
+	if steps > priorityBandSteps {
+		return priorityBandSteps
+	}
+	return steps
+}
+
+// resolve calculates the longest downstream chain of the node, edges causing a cycle are ignored
+func (n *jobNode) resolve() {
+	if n.resolved || n.visiting {
+		return
+	}
+	n.visiting = true
+	n.onSLAPath = n.hasSLA
+	if n.hasSLA {
+		n.slaPathDuration = n.duration
+	}
+	n.pathDuration = n.duration
+	for _, edge := range n.downstreams {
+		downstream := edge.node
+		downstream.resolve()
+		if !downstream.resolved {
+			continue
+		}
+		if edge.crossProject || downstream.feedsOtherProjects {
+			n.feedsOtherProjects = true
+		}
+		if downstream.depth+1 > n.depth {
+			n.depth = downstream.depth + 1
+		}
+		if n.duration+downstream.pathDuration > n.pathDuration {
+			n.pathDuration = n.duration + downstream.pathDuration
+		}
+		if downstream.onSLAPath {
+			n.onSLAPath = true
+			if n.duration+downstream.slaPathDuration > n.slaPathDuration {
+				n.slaPathDuration = n.duration + downstream.slaPathDuration
+			}
+		}
+	}
+	n.visiting = false
+	n.resolved = true
+}
+
+func hasSLA(job *scheduler.JobWithDetails) bool {
+	slaDuration, err := job.SLADuration()
+	return err == nil && slaDuration > 0
+}
+
+func newJobNode(job *scheduler.JobWithDetails) *jobNode {
+	projectName := job.Job.Tenant.ProjectName()
+	return &jobNode{
+		key:         jobKey(projectName, job.Name.String()),
+		projectName: projectName,
+		jobName:     job.Name,
+		hasSLA:      hasSLA(job),
+	}
+}
+
+func sortedProjectNames(jobs []*scheduler.JobWithDetails) []tenant.ProjectName {
+	seen := map[tenant.ProjectName]bool{}
+	var projectNames []tenant.ProjectName
+	for _, job := range jobs {
+		projectName := job.Job.Tenant.ProjectName()
+		if !seen[projectName] {
+			seen[projectName] = true
+			projectNames = append(projectNames, projectName)
+		}
+	}
+	sort.Slice(projectNames, func(i, j int) bool {
+		return projectNames[i] < projectNames[j]
+	})
+	return projectNames
+}
+
+func jobNamesOf(jobs []*scheduler.JobWithDetails, projectName tenant.ProjectName) []string {
+	var jobNames []string
+	for _, job := range jobs {
+		if job.Job.Tenant.ProjectName() == projectName {
+			jobNames = append(jobNames, job.Name.String())
+		}
+	}
+	sort.Strings(jobNames)
+	return jobNames
+}
+
+func jobKey(projectName tenant.ProjectName, jobName string) string {
+	return projectName.String() + "/" + jobName
+}
+
+func NewCriticalPathResolver(jobRepo JobRepository, durationRepo JobRunDurationRepository, lookback time.Duration, now func() time.Time) *CriticalPathResolver {
+	return &CriticalPathResolver{
+		jobRepo:      jobRepo,
+		durationRepo: durationRepo,
+		lookback:     lookback,
+		now:          now,
+	}
+}
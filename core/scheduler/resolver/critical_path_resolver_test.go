@@ -0,0 +1,268 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/resolver"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestCriticalPathResolver(t *testing.T) {
+	ctx := context.Background()
+	tnnt1, _ := tenant.NewTenant("test-proj", "test-ns")
+	tnnt2, _ := tenant.NewTenant("test-proj", "other-ns")
+	now := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	lookback := time.Hour * 24 * 30
+	since := now.Add(-lookback)
+	nowFn := func() time.Time { return now }
+
+	newJob := func(name string, tnnt tenant.Tenant, upstreams ...*scheduler.JobWithDetails) *scheduler.JobWithDetails {
+		var upstreamJobs []*scheduler.JobUpstream
+		for _, u := range upstreams {
+			upstreamJobs = append(upstreamJobs, &scheduler.JobUpstream{
+				JobName: u.Name.String(),
+				Tenant:  u.Job.Tenant,
+			})
+		}
+		return &scheduler.JobWithDetails{
+			Name:      scheduler.JobName(name),
+			Job:       &scheduler.Job{Name: scheduler.JobName(name), Tenant: tnnt},
+			Upstreams: scheduler.Upstreams{UpstreamJobs: upstreamJobs},
+		}
+	}
+	withSLA := func(job *scheduler.JobWithDetails) *scheduler.JobWithDetails {
+		job.Alerts = []scheduler.Alert{{
+			On:     scheduler.EventCategorySLAMiss,
+			Config: map[string]string{"duration": "2h"},
+		}}
+		return job
+	}
+
+	t.Run("returns error when unable to get downstream jobs", func(t *testing.T) {
+		jobA := newJob("job-a", tnnt1)
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), []string{"job-a"}).Return(nil, errors.New("some error"))
+		defer jobRepo.AssertExpectations(t)
+
+		r := resolver.NewCriticalPathResolver(jobRepo, nil, lookback, nowFn)
+		err := r.Resolve(ctx, []*scheduler.JobWithDetails{jobA})
+		assert.ErrorContains(t, err, "unable to get downstream jobs of project test-proj")
+	})
+	t.Run("returns error when unable to get job durations", func(t *testing.T) {
+		jobA := newJob("job-a", tnnt1)
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), []string{"job-a"}).Return([]*scheduler.JobWithDetails{}, nil)
+		defer jobRepo.AssertExpectations(t)
+
+		durationRepo := new(mockJobRunDurationRepository)
+		durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), []string{"job-a"}, since).Return(nil, errors.New("some error"))
+		defer durationRepo.AssertExpectations(t)
+
+		r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+		err := r.Resolve(ctx, []*scheduler.JobWithDetails{jobA})
+		assert.ErrorContains(t, err, "unable to get job durations of project test-proj")
+	})
+	t.Run("gives higher priority to jobs with deeper downstream across namespaces", func(t *testing.T) {
+		jobA := newJob("job-a", tnnt1)
+		jobB := newJob("job-b", tnnt2, jobA)
+		jobC := newJob("job-c", tnnt1, jobB)
+		jobD := newJob("job-d", tnnt2)
+		jobs := []*scheduler.JobWithDetails{jobD, jobC, jobB, jobA}
+		jobNames := []string{"job-a", "job-b", "job-c", "job-d"}
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), jobNames).Return([]*scheduler.JobWithDetails{jobB, jobC}, nil)
+		defer jobRepo.AssertExpectations(t)
+
+		durationRepo := new(mockJobRunDurationRepository)
+		durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), jobNames, since).Return(map[scheduler.JobName]time.Duration{}, nil)
+		defer durationRepo.AssertExpectations(t)
+
+		r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+		err := r.Resolve(ctx, jobs)
+		assert.NoError(t, err)
+		assert.Equal(t, 201, jobA.Priority)
+		assert.Equal(t, 101, jobB.Priority)
+		assert.Equal(t, 1, jobC.Priority)
+		assert.Equal(t, 1, jobD.Priority)
+	})
+	t.Run("gives higher priority to jobs on the longest path to jobs with sla", func(t *testing.T) {
+		jobSLA := withSLA(newJob("job-sla", tnnt1))
+		jobShort := newJob("job-short", tnnt1)
+		jobLong := newJob("job-long", tnnt1)
+		jobSLA.Upstreams.UpstreamJobs = []*scheduler.JobUpstream{
+			{JobName: "job-short", Tenant: tnnt1},
+			{JobName: "job-long", Tenant: tnnt1},
+		}
+		jobDeep := newJob("job-deep", tnnt1)
+		jobDeepChild := newJob("job-deep-child", tnnt1, jobDeep)
+		jobDeepGrandChild := newJob("job-deep-grand-child", tnnt1, jobDeepChild)
+		jobs := []*scheduler.JobWithDetails{jobSLA, jobShort, jobLong, jobDeep, jobDeepChild, jobDeepGrandChild}
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), mock.Anything).Return(jobs, nil)
+		defer jobRepo.AssertExpectations(t)
+
+		durationRepo := new(mockJobRunDurationRepository)
+		durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), mock.Anything, since).Return(map[scheduler.JobName]time.Duration{
+			"job-sla":   time.Minute * 10,
+			"job-short": time.Minute * 5,
+			"job-long":  time.Hour,
+			"job-deep":  time.Hour * 5,
+		}, nil)
+		defer durationRepo.AssertExpectations(t)
+
+		r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+		err := r.Resolve(ctx, jobs)
+		assert.NoError(t, err)
+		assert.Equal(t, 5703, jobLong.Priority)
+		assert.Equal(t, 5103, jobShort.Priority)
+		assert.Equal(t, 5101, jobSLA.Priority)
+		assert.Equal(t, 261, jobDeep.Priority)
+		assert.Equal(t, 101, jobDeepChild.Priority)
+		assert.Equal(t, 1, jobDeepGrandChild.Priority)
+	})
+	t.Run("uses duration of the longest path when downstream depth is the same", func(t *testing.T) {
+		jobA := newJob("job-a", tnnt1)
+		jobB := newJob("job-b", tnnt1)
+		jobAChild := newJob("job-a-child", tnnt1, jobA)
+		jobBChild := newJob("job-b-child", tnnt1, jobB)
+		jobs := []*scheduler.JobWithDetails{jobA, jobB, jobAChild, jobBChild}
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), mock.Anything).Return([]*scheduler.JobWithDetails{jobAChild, jobBChild}, nil)
+		defer jobRepo.AssertExpectations(t)
+
+		durationRepo := new(mockJobRunDurationRepository)
+		durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), mock.Anything, since).Return(map[scheduler.JobName]time.Duration{
+			"job-a":       time.Minute,
+			"job-b":       time.Minute,
+			"job-b-child": time.Hour,
+		}, nil)
+		defer durationRepo.AssertExpectations(t)
+
+		r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+		err := r.Resolve(ctx, jobs)
+		assert.NoError(t, err)
+		assert.Equal(t, 113, jobB.Priority)
+		assert.Equal(t, 101, jobA.Priority)
+		assert.Equal(t, 13, jobBChild.Priority)
+		assert.Equal(t, 1, jobAChild.Priority)
+	})
+	t.Run("loads only the downstream closure of the given jobs and resolves the same priority as with all jobs", func(t *testing.T) {
+		jobA := newJob("job-a", tnnt1)
+		jobC := newJob("job-c", tnnt1, newJob("job-b", tnnt2))
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), []string{"job-b"}).Return([]*scheduler.JobWithDetails{jobC}, nil).Once()
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), []string{"job-c"}).Return([]*scheduler.JobWithDetails{}, nil).Once()
+		defer jobRepo.AssertExpectations(t)
+
+		durationRepo := new(mockJobRunDurationRepository)
+		durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), []string{"job-b", "job-c"}, since).Return(map[scheduler.JobName]time.Duration{}, nil)
+		defer durationRepo.AssertExpectations(t)
+
+		jobToResolve := newJob("job-b", tnnt2, jobA)
+		r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+		err := r.Resolve(ctx, []*scheduler.JobWithDetails{jobToResolve})
+		assert.NoError(t, err)
+		assert.Equal(t, 101, jobToResolve.Priority)
+		assert.Equal(t, 0, jobA.Priority)
+		assert.Equal(t, 0, jobC.Priority)
+	})
+	t.Run("keeps the downstreams of other projects and prefers the jobs feeding them", func(t *testing.T) {
+		otherProjectTnnt, _ := tenant.NewTenant("other-proj", "test-ns")
+		jobA := newJob("job-a", tnnt1)
+		jobB := newJob("job-b", tnnt1)
+		jobX := newJob("job-x", otherProjectTnnt, jobA)
+		jobBChild := newJob("job-b-child", tnnt1, jobB)
+		jobY := newJob("job-y", otherProjectTnnt, jobX)
+
+		jobRepo := new(mockJobRepository)
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), []string{"job-a", "job-b"}).Return([]*scheduler.JobWithDetails{jobX, jobBChild}, nil).Once()
+		jobRepo.On("GetDownstreams", ctx, otherProjectTnnt.ProjectName(), []string{"job-x"}).Return([]*scheduler.JobWithDetails{jobY}, nil).Once()
+		jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), []string{"job-b-child"}).Return([]*scheduler.JobWithDetails{}, nil).Once()
+		jobRepo.On("GetDownstreams", ctx, otherProjectTnnt.ProjectName(), []string{"job-y"}).Return([]*scheduler.JobWithDetails{}, nil).Once()
+		defer jobRepo.AssertExpectations(t)
+
+		durationRepo := new(mockJobRunDurationRepository)
+		durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), []string{"job-a", "job-b", "job-b-child"}, since).
+			Return(map[scheduler.JobName]time.Duration{}, nil)
+		durationRepo.On("GetJobDurations", ctx, otherProjectTnnt.ProjectName(), []string{"job-x", "job-y"}, since).
+			Return(map[scheduler.JobName]time.Duration{}, nil)
+		defer durationRepo.AssertExpectations(t)
+
+		r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+		err := r.Resolve(ctx, []*scheduler.JobWithDetails{jobA, jobB})
+		assert.NoError(t, err)
+		assert.Equal(t, 202, jobA.Priority)
+		assert.Equal(t, 101, jobB.Priority)
+	})
+	t.Run("resolves the same priority regardless of job order and cyclic dependency", func(t *testing.T) {
+		resolve := func(order []string) map[string]int {
+			jobA := newJob("job-a", tnnt1)
+			jobB := newJob("job-b", tnnt1, jobA)
+			jobC := newJob("job-c", tnnt1, jobB)
+			jobA.Upstreams.UpstreamJobs = []*scheduler.JobUpstream{{JobName: "job-c", Tenant: tnnt1}}
+			jobD := newJob("job-d", tnnt1, jobC)
+			byName := map[string]*scheduler.JobWithDetails{"job-a": jobA, "job-b": jobB, "job-c": jobC, "job-d": jobD}
+
+			var jobs []*scheduler.JobWithDetails
+			for _, name := range order {
+				jobs = append(jobs, byName[name])
+			}
+			jobRepo := new(mockJobRepository)
+			jobRepo.On("GetDownstreams", ctx, tnnt1.ProjectName(), mock.Anything).Return(jobs, nil)
+			durationRepo := new(mockJobRunDurationRepository)
+			durationRepo.On("GetJobDurations", ctx, tnnt1.ProjectName(), mock.Anything, since).Return(map[scheduler.JobName]time.Duration{}, nil)
+
+			r := resolver.NewCriticalPathResolver(jobRepo, durationRepo, lookback, nowFn)
+			err := r.Resolve(ctx, jobs)
+			assert.NoError(t, err)
+
+			priorities := map[string]int{}
+			for name, job := range byName {
+				priorities[name] = job.Priority
+			}
+			return priorities
+		}
+
+		expected := resolve([]string{"job-a", "job-b", "job-c", "job-d"})
+		assert.Equal(t, expected, resolve([]string{"job-d", "job-c", "job-b", "job-a"}))
+		assert.Equal(t, expected, resolve([]string{"job-c", "job-a", "job-d", "job-b"}))
+		assert.Equal(t, 1, expected["job-d"])
+	})
+}
+
+type mockJobRepository struct {
+	mock.Mock
+}
+
+func (m *mockJobRepository) GetDownstreams(ctx context.Context, projectName tenant.ProjectName, jobNames []string) ([]*scheduler.JobWithDetails, error) {
+	args := m.Called(ctx, projectName, jobNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobWithDetails), args.Error(1)
+}
+
+type mockJobRunDurationRepository struct {
+	mock.Mock
+}
+
+func (m *mockJobRunDurationRepository) GetJobDurations(ctx context.Context, projectName tenant.ProjectName, jobNames []string, since time.Time) (map[scheduler.JobName]time.Duration, error) {
+	args := m.Called(ctx, projectName, jobNames, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[scheduler.JobName]time.Duration), args.Error(1)
+}
@@ -32,6 +32,12 @@ func (s *JobRunService) UploadToScheduler(ctx context.Context, projectName tenan
 
 	jobGroupByTenant := scheduler.GroupJobsByTenant(allJobsWithDetails)
 	for t, jobs := range jobGroupByTenant {
+		span.AddEvent("syncing scheduler pools")
+		if err = s.scheduler.SyncPools(spanCtx, t); err != nil {
+			s.l.Error("error syncing pools under project [%s] namespace [%s]: %s", t.ProjectName().String(), t.NamespaceName().String(), err)
+			me.Append(err)
+		}
+
 		span.AddEvent("uploading job specs")
 		if err = s.deployJobsPerNamespace(spanCtx, t, jobs); err == nil {
 			s.l.Info("[success] namespace: %s, project: %s, deployed", t.NamespaceName().String(), t.ProjectName().String())
@@ -107,3 +113,19 @@ func (s *JobRunService) resolveAndDeployJobs(ctx context.Context, tnnt tenant.Te
 
 	return s.scheduler.DeployJobs(ctx, tnnt, allJobsWithDetails)
 }
+
+// GetCompiledJob returns the job compiled the same way as it is deployed on the scheduler
+func (s *JobRunService) GetCompiledJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) ([]byte, error) {
+	jobWithDetails, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job details [%s]: %s", jobName, err)
+		return nil, err
+	}
+
+	if err := s.priorityResolver.Resolve(ctx, []*scheduler.JobWithDetails{jobWithDetails}); err != nil {
+		s.l.Error("error resolving priority of job [%s]: %s", jobName, err)
+		return nil, err
+	}
+
+	return s.scheduler.CompileJob(ctx, jobWithDetails)
+}
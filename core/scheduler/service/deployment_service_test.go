@@ -110,6 +110,7 @@ func TestDeploymentService(t *testing.T) {
 			defer priorityResolver.AssertExpectations(t)
 
 			mScheduler := new(mockScheduler)
+			mScheduler.On("SyncPools", mock.Anything, tnnt1).Return(nil)
 			mScheduler.On("DeployJobs", mock.Anything, tnnt1, []*scheduler.JobWithDetails{jobsWithDetails[0], jobsWithDetails[2]}).
 				Return(fmt.Errorf("DeployJobs tnnt1 error"))
 			defer mScheduler.AssertExpectations(t)
@@ -131,6 +132,8 @@ func TestDeploymentService(t *testing.T) {
 			defer priorityResolver.AssertExpectations(t)
 
 			mScheduler := new(mockScheduler)
+			mScheduler.On("SyncPools", mock.Anything, tnnt1).Return(nil)
+			mScheduler.On("SyncPools", mock.Anything, tnnt2).Return(nil)
 			mScheduler.On("DeployJobs", mock.Anything, tnnt1, []*scheduler.JobWithDetails{jobsWithDetails[0], jobsWithDetails[2]}).
 				Return(nil)
 			mScheduler.On("DeployJobs", mock.Anything, tnnt2, []*scheduler.JobWithDetails{jobsWithDetails[1]}).
@@ -158,6 +161,8 @@ func TestDeploymentService(t *testing.T) {
 			defer priorityResolver.AssertExpectations(t)
 
 			mScheduler := new(mockScheduler)
+			mScheduler.On("SyncPools", mock.Anything, tnnt1).Return(nil)
+			mScheduler.On("SyncPools", mock.Anything, tnnt2).Return(nil)
 			mScheduler.On("DeployJobs", mock.Anything, tnnt1, []*scheduler.JobWithDetails{jobsWithDetails[0], jobsWithDetails[2]}).
 				Return(nil)
 			mScheduler.On("DeployJobs", mock.Anything, tnnt2, []*scheduler.JobWithDetails{jobsWithDetails[1]}).
@@ -174,6 +179,30 @@ func TestDeploymentService(t *testing.T) {
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "errorInUploadToScheduler:\n listJobs error")
 		})
+		t.Run("should deploy Jobs Per Namespace even when unable to sync pools, returning error", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", mock.Anything, proj1Name).Return([]*scheduler.JobWithDetails{jobsWithDetails[1]}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", mock.Anything, []*scheduler.JobWithDetails{jobsWithDetails[1]}).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			mScheduler := new(mockScheduler)
+			mScheduler.On("SyncPools", mock.Anything, tnnt2).Return(fmt.Errorf("SyncPools tnnt2 error"))
+			mScheduler.On("DeployJobs", mock.Anything, tnnt2, []*scheduler.JobWithDetails{jobsWithDetails[1]}).
+				Return(nil)
+			mScheduler.On("ListJobs", mock.Anything, tnnt2).Return([]string{"job2"}, nil)
+			var jobsToDelete []string
+			mScheduler.On("DeleteJobs", mock.Anything, tnnt2, jobsToDelete).Return(nil)
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, nil, nil)
+
+			err := runService.UploadToScheduler(ctx, proj1Name)
+			assert.EqualError(t, err, "errorInUploadToScheduler:\n SyncPools tnnt2 error")
+		})
 	})
 
 	t.Run("UploadJobs", func(t *testing.T) {
@@ -318,6 +347,77 @@ func TestDeploymentService(t *testing.T) {
 			assert.Nil(t, err)
 		})
 	})
+
+	t.Run("GetCompiledJob", func(t *testing.T) {
+		t.Run("should return error if unable to get job details", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, proj1Name, scheduler.JobName("job1")).Return(nil, fmt.Errorf("GetJobDetails error"))
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				nil, nil, nil, nil)
+
+			compiledJob, err := runService.GetCompiledJob(ctx, proj1Name, "job1")
+			assert.Nil(t, compiledJob)
+			assert.EqualError(t, err, "GetJobDetails error")
+		})
+		t.Run("should return error if error in priority resolution", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, proj1Name, scheduler.JobName("job1")).Return(jobsWithDetails[0], nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, []*scheduler.JobWithDetails{jobsWithDetails[0]}).Return(fmt.Errorf("priority resolution error"))
+			defer priorityResolver.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				nil, priorityResolver, nil, nil)
+
+			compiledJob, err := runService.GetCompiledJob(ctx, proj1Name, "job1")
+			assert.Nil(t, compiledJob)
+			assert.EqualError(t, err, "priority resolution error")
+		})
+		t.Run("should return error if unable to compile the job", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, proj1Name, scheduler.JobName("job1")).Return(jobsWithDetails[0], nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, []*scheduler.JobWithDetails{jobsWithDetails[0]}).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			mScheduler := new(mockScheduler)
+			mScheduler.On("CompileJob", ctx, jobsWithDetails[0]).Return(nil, fmt.Errorf("CompileJob error"))
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, nil, nil)
+
+			compiledJob, err := runService.GetCompiledJob(ctx, proj1Name, "job1")
+			assert.Nil(t, compiledJob)
+			assert.EqualError(t, err, "CompileJob error")
+		})
+		t.Run("should return the compiled job", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, proj1Name, scheduler.JobName("job1")).Return(jobsWithDetails[0], nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, []*scheduler.JobWithDetails{jobsWithDetails[0]}).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			mScheduler := new(mockScheduler)
+			mScheduler.On("CompileJob", ctx, jobsWithDetails[0]).Return([]byte("compiled dag"), nil)
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, nil, nil)
+
+			compiledJob, err := runService.GetCompiledJob(ctx, proj1Name, "job1")
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("compiled dag"), compiledJob)
+		})
+	})
 }
 
 type mockPriorityResolver struct {
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/raystack/optimus/core/scheduler"
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/compiler"
+	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/utils"
 )
 
@@ -36,6 +38,8 @@ const (
 	configDend          = "DEND"
 	configExecutionTime = "EXECUTION_TIME"
 	configDestination   = "JOB_DESTINATION"
+
+	hookRunIfKey = "run_if"
 )
 
 type TenantService interface {
@@ -119,13 +123,37 @@ func (i InputCompiler) Compile(ctx context.Context, job *scheduler.Job, config s
 		return nil, err
 	}
 
+	configs := utils.MergeMaps(hookConfs, systemDefinedVars)
+	if hook.IsRunIfExpression() {
+		shouldRun, err := i.evaluateRunIf(hook, mergedContext)
+		if err != nil {
+			i.logger.Error("error evaluating run_if of hook [%s]: %s", hook.Name, err)
+			return nil, err
+		}
+		if !shouldRun {
+			configs[scheduler.HookSkipConfig] = "true"
+		}
+	}
+
 	return &scheduler.ExecutorInput{
-		Configs: utils.MergeMaps(hookConfs, systemDefinedVars),
+		Configs: configs,
 		Secrets: hookSecrets,
 		Files:   fileMap,
 	}, nil
 }
 
+func (i InputCompiler) evaluateRunIf(hook *scheduler.Hook, templateCtx map[string]any) (bool, error) {
+	compiled, err := i.compiler.Compile(map[string]string{hookRunIfKey: hook.RunIf}, templateCtx)
+	if err != nil {
+		return false, err
+	}
+	shouldRun, err := strconv.ParseBool(strings.TrimSpace(compiled[hookRunIfKey]))
+	if err != nil {
+		return false, errors.InvalidArgument(scheduler.EntityJobRun, "run_if of hook "+hook.Name+" should evaluate to true or false, got "+compiled[hookRunIfKey])
+	}
+	return shouldRun, nil
+}
+
 func (i InputCompiler) compileConfigs(configs map[string]string, templateCtx map[string]any) (map[string]string, map[string]string, error) {
 	conf, secretsConfig := splitConfigWithSecrets(configs)
 
@@ -319,6 +319,170 @@ func TestExecutorCompiler(t *testing.T) {
 			}
 			assert.Equal(t, expectedInputExecutor, inputExecutorResp)
 		})
+		t.Run("compileConfigs for Executor type Hook should skip the hook if run_if evaluates to false", func(t *testing.T) {
+			window, _ := models.NewWindow(2, "d", "1h", "24h")
+			job := scheduler.Job{
+				Name:        "job1",
+				Tenant:      tnnt,
+				Destination: "some_destination_table_name",
+				Task: &scheduler.Task{
+					Name: "bq2bq",
+					Config: map[string]string{
+						"secret.config": "a.secret.val",
+						"some.config":   "val",
+					},
+				},
+				Hooks: []*scheduler.Hook{
+					{
+						Name:  "predator",
+						RunIf: `{{ eq .DSTART "never" }}`,
+						Config: map[string]string{
+							"hook_secret":      "a.secret.val",
+							"hook_some_config": "val",
+						},
+					},
+				},
+				Window: window,
+				Assets: nil,
+			}
+			config := scheduler.RunConfig{
+				Executor: scheduler.Executor{
+					Name: "predator",
+					Type: scheduler.ExecutorHook,
+				},
+				ScheduledAt: currentTime.Add(-time.Hour),
+				JobRunID:    scheduler.JobRunID{},
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			startTime, _ := job.Window.GetStartTime(config.ScheduledAt)
+			endTime, _ := job.Window.GetEndTime(config.ScheduledAt)
+			executedAt := currentTime.Add(time.Hour)
+			systemDefinedVars := map[string]string{
+				"DSTART":          startTime.Format(time.RFC3339),
+				"DEND":            endTime.Format(time.RFC3339),
+				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
+				"JOB_DESTINATION": job.Destination,
+			}
+			taskContext := mock.Anything
+
+			compiledFile := map[string]string{
+				"someFileName": "fileContents",
+			}
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, scheduleTime, taskContext).Return(compiledFile, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			templateCompiler := new(mockTemplateCompiler)
+			templateCompiler.On("Compile", map[string]string{"some.config": "val"}, taskContext).
+				Return(map[string]string{"some.config.compiled": "val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"secret.config": "a.secret.val"}, taskContext).
+				Return(map[string]string{"secret.config.compiled": "a.secret.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"hook_some_config": "val"}, taskContext).
+				Return(map[string]string{"hook.compiled": "hook.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"hook_secret": "a.secret.val"}, taskContext).
+				Return(map[string]string{"secret.hook.compiled": "hook.s.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"run_if": `{{ eq .DSTART "never" }}`}, taskContext).
+				Return(map[string]string{"run_if": "false"}, nil)
+			defer templateCompiler.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &job, config, executedAt)
+
+			assert.Nil(t, err)
+			expectedInputExecutor := &scheduler.ExecutorInput{
+				Configs: map[string]string{
+					"DSTART":            startTime.Format(time.RFC3339),
+					"DEND":              endTime.Format(time.RFC3339),
+					"EXECUTION_TIME":    executedAt.Format(time.RFC3339),
+					"JOB_DESTINATION":   job.Destination,
+					"hook.compiled":     "hook.val.compiled",
+					"OPTIMUS_SKIP_HOOK": "true",
+				},
+				Secrets: map[string]string{"secret.hook.compiled": "hook.s.val.compiled"},
+				Files:   compiledFile,
+			}
+			assert.Equal(t, expectedInputExecutor, inputExecutorResp)
+		})
+		t.Run("compileConfigs for Executor type Hook should return error if run_if does not evaluate to a boolean", func(t *testing.T) {
+			window, _ := models.NewWindow(2, "d", "1h", "24h")
+			job := scheduler.Job{
+				Name:        "job1",
+				Tenant:      tnnt,
+				Destination: "some_destination_table_name",
+				Task: &scheduler.Task{
+					Name: "bq2bq",
+					Config: map[string]string{
+						"secret.config": "a.secret.val",
+						"some.config":   "val",
+					},
+				},
+				Hooks: []*scheduler.Hook{
+					{
+						Name:  "predator",
+						RunIf: `{{ eq .DSTART "never" }}`,
+						Config: map[string]string{
+							"hook_secret":      "a.secret.val",
+							"hook_some_config": "val",
+						},
+					},
+				},
+				Window: window,
+				Assets: nil,
+			}
+			config := scheduler.RunConfig{
+				Executor: scheduler.Executor{
+					Name: "predator",
+					Type: scheduler.ExecutorHook,
+				},
+				ScheduledAt: currentTime.Add(-time.Hour),
+				JobRunID:    scheduler.JobRunID{},
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			startTime, _ := job.Window.GetStartTime(config.ScheduledAt)
+			endTime, _ := job.Window.GetEndTime(config.ScheduledAt)
+			executedAt := currentTime.Add(time.Hour)
+			systemDefinedVars := map[string]string{
+				"DSTART":          startTime.Format(time.RFC3339),
+				"DEND":            endTime.Format(time.RFC3339),
+				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
+				"JOB_DESTINATION": job.Destination,
+			}
+			taskContext := mock.Anything
+
+			compiledFile := map[string]string{
+				"someFileName": "fileContents",
+			}
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, scheduleTime, taskContext).Return(compiledFile, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			templateCompiler := new(mockTemplateCompiler)
+			templateCompiler.On("Compile", map[string]string{"some.config": "val"}, taskContext).
+				Return(map[string]string{"some.config.compiled": "val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"secret.config": "a.secret.val"}, taskContext).
+				Return(map[string]string{"secret.config.compiled": "a.secret.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"hook_some_config": "val"}, taskContext).
+				Return(map[string]string{"hook.compiled": "hook.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"hook_secret": "a.secret.val"}, taskContext).
+				Return(map[string]string{"secret.hook.compiled": "hook.s.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"run_if": `{{ eq .DSTART "never" }}`}, taskContext).
+				Return(map[string]string{"run_if": "maybe"}, nil)
+			defer templateCompiler.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &job, config, executedAt)
+
+			assert.Nil(t, inputExecutorResp)
+			assert.ErrorContains(t, err, "run_if of hook predator should evaluate to true or false, got maybe")
+		})
 		t.Run("compileConfigs for Executor type Hook should fail if error in hook compilation", func(t *testing.T) {
 			window, _ := models.NewWindow(2, "d", "1h", "24h")
 			job := scheduler.Job{
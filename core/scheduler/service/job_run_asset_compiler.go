@@ -8,6 +8,8 @@ import (
 	"github.com/raystack/salt/log"
 
 	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/compiler"
 	"github.com/raystack/optimus/sdk/plugin"
 )
 
@@ -17,6 +19,12 @@ const (
 
 type FilesCompiler interface {
 	Compile(fileMap map[string]string, context map[string]any) (map[string]string, error)
+	CompileWithLibraries(fileMap, libraries map[string]string, context map[string]any) (map[string]string, error)
+}
+
+type TemplateLibraryRepo interface {
+	// GetTemplateLibraries returns the content of the latest libraries available to the tenant mapped by library name
+	GetTemplateLibraries(ctx context.Context, tnnt tenant.Tenant) (map[string]string, error)
 }
 
 type PluginRepo interface {
@@ -24,17 +32,19 @@ type PluginRepo interface {
 }
 
 type JobRunAssetsCompiler struct {
-	compiler   FilesCompiler
-	pluginRepo PluginRepo
+	compiler    FilesCompiler
+	pluginRepo  PluginRepo
+	libraryRepo TemplateLibraryRepo
 
 	logger log.Logger
 }
 
-func NewJobAssetsCompiler(engine FilesCompiler, pluginRepo PluginRepo, logger log.Logger) *JobRunAssetsCompiler {
+func NewJobAssetsCompiler(engine FilesCompiler, pluginRepo PluginRepo, libraryRepo TemplateLibraryRepo, logger log.Logger) *JobRunAssetsCompiler {
 	return &JobRunAssetsCompiler{
-		compiler:   engine,
-		pluginRepo: pluginRepo,
-		logger:     logger,
+		compiler:    engine,
+		pluginRepo:  pluginRepo,
+		libraryRepo: libraryRepo,
+		logger:      logger,
 	}
 }
 
@@ -74,6 +84,20 @@ func (c *JobRunAssetsCompiler) CompileJobRunAssets(ctx context.Context, job *sch
 		inputFiles = compiledAssetResponse.Assets.ToMap()
 	}
 
+	if len(compiler.LibrariesUsedBy(inputFiles)) > 0 {
+		libraries, err := c.libraryRepo.GetTemplateLibraries(ctx, job.Tenant)
+		if err != nil {
+			c.logger.Error("error getting template libraries: %s", err)
+			return nil, err
+		}
+		fileMap, err := c.compiler.CompileWithLibraries(inputFiles, libraries, contextForTask)
+		if err != nil {
+			c.logger.Error("error compiling assets with template libraries: %s", err)
+			return nil, err
+		}
+		return fileMap, nil
+	}
+
 	fileMap, err := c.compiler.Compile(inputFiles, contextForTask)
 	if err != nil {
 		c.logger.Error("error compiling assets: %s", err)
@@ -65,7 +65,7 @@ func TestJobAssetsCompiler(t *testing.T) {
 
 			contextForTask := map[string]any{}
 
-			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, logger)
+			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, nil, logger)
 			assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, scheduleTime, contextForTask)
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "error in getting plugin by name")
@@ -93,7 +93,7 @@ func TestJobAssetsCompiler(t *testing.T) {
 				},
 			}
 
-			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, logger)
+			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, nil, logger)
 
 			contextForTask := map[string]any{}
 			assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job1, systemEnvVars, scheduleTime, contextForTask)
@@ -114,7 +114,7 @@ func TestJobAssetsCompiler(t *testing.T) {
 				YamlMod:       yamlMod,
 			}, nil)
 			defer pluginRepo.AssertExpectations(t)
-			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, logger)
+			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, nil, logger)
 
 			contextForTask := map[string]any{}
 			assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, scheduleTime, contextForTask)
@@ -153,7 +153,7 @@ func TestJobAssetsCompiler(t *testing.T) {
 					Return(nil, fmt.Errorf("error in compiling"))
 				defer filesCompiler.AssertExpectations(t)
 
-				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, logger)
+				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, nil, logger)
 				assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, scheduleTime, contextForTask)
 
 				assert.NotNil(t, err)
@@ -170,16 +170,53 @@ func TestJobAssetsCompiler(t *testing.T) {
 					Return(expectedFileMap, nil)
 				defer filesCompiler.AssertExpectations(t)
 
-				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, logger)
+				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, nil, logger)
 				assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, scheduleTime, contextForTask)
 
 				assert.Nil(t, err)
 				assert.Equal(t, expectedFileMap, assets)
 			})
 		})
+		t.Run("compiles assets with the template libraries of the tenant when assets refer to a library", func(t *testing.T) {
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByName", taskName).Return(&plugin.Plugin{}, nil)
+			defer pluginRepo.AssertExpectations(t)
+
+			jobWithLibrary := *job
+			jobWithLibrary.Assets = map[string]string{"query.sql": `SELECT * FROM {{ template "lib/source" . }}`}
+			libraries := map[string]string{"source": "project.dataset.source"}
+			contextForTask := map[string]any{}
+
+			libraryRepo := new(mockTemplateLibraryRepo)
+			libraryRepo.On("GetTemplateLibraries", ctx, tnnt).Return(libraries, nil)
+			defer libraryRepo.AssertExpectations(t)
+
+			expectedFileMap := map[string]string{"query.sql": "SELECT * FROM project.dataset.source"}
+			filesCompiler := new(mockFilesCompiler)
+			filesCompiler.On("CompileWithLibraries", jobWithLibrary.Assets, libraries, contextForTask).Return(expectedFileMap, nil)
+			defer filesCompiler.AssertExpectations(t)
+
+			jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, libraryRepo, logger)
+			assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, &jobWithLibrary, systemEnvVars, scheduleTime, contextForTask)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedFileMap, assets)
+		})
 	})
 }
 
+type mockTemplateLibraryRepo struct {
+	mock.Mock
+}
+
+func (m *mockTemplateLibraryRepo) GetTemplateLibraries(ctx context.Context, tnnt tenant.Tenant) (map[string]string, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
 type mockPluginRepo struct {
 	mock.Mock
 }
@@ -203,3 +240,11 @@ func (m *mockFilesCompiler) Compile(fileMap map[string]string, context map[strin
 	}
 	return args.Get(0).(map[string]string), args.Error(1)
 }
+
+func (m *mockFilesCompiler) CompileWithLibraries(fileMap, libraries map[string]string, context map[string]any) (map[string]string, error) {
+	args := m.Called(fileMap, libraries, context)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/robfig/cron/v3"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/telemetry"
+)
+
+const (
+	metricJobRunMissing = "jobrun_missing_runs"
+)
+
+type JobRunGapFinder interface {
+	GetJobRunGaps(ctx context.Context, projectName tenant.ProjectName, namespaceName string, startTime, endTime time.Time) ([]*scheduler.JobRunGap, error)
+}
+
+// JobRunGapManager periodically reports the scheduled times of the enabled jobs without a successful run as metrics,
+// and notifies the ones which went missing since the previous check
+type JobRunGapManager struct {
+	l log.Logger
+
+	projectGetter ProjectGetter
+	gapFinder     JobRunGapFinder
+	notifier      EventPusher
+
+	schedule *cron.Cron
+	interval time.Duration
+
+	// lookback is how far back the job runs are checked for gaps
+	lookback time.Duration
+	// gracePeriod is the time given to a scheduled run to succeed before it is considered missing
+	gracePeriod time.Duration
+
+	// tenants having gaps in the last report, their metrics are reset once the gaps are filled
+	gapTenants map[tenant.Tenant]struct{}
+}
+
+func NewJobRunGapManager(l log.Logger, projectGetter ProjectGetter, gapFinder JobRunGapFinder, notifier EventPusher, interval, lookback, gracePeriod time.Duration) *JobRunGapManager {
+	return &JobRunGapManager{
+		l:             l,
+		projectGetter: projectGetter,
+		gapFinder:     gapFinder,
+		notifier:      notifier,
+		interval:      interval,
+		lookback:      lookback,
+		gracePeriod:   gracePeriod,
+		gapTenants:    map[tenant.Tenant]struct{}{},
+		schedule: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
+
+func (m *JobRunGapManager) Initialize() {
+	if m.interval <= 0 {
+		return
+	}
+	m.schedule.Schedule(cron.Every(m.interval), cron.FuncJob(func() {
+		m.Check(context.Background(), time.Now())
+	}))
+	m.schedule.Start()
+}
+
+func (m *JobRunGapManager) Close() {
+	if m.interval > 0 {
+		m.schedule.Stop()
+	}
+}
+
+// Check reports the gaps of the job runs as of the given time, the scheduled times which passed the grace period
+// within the last interval are notified, so every missing run is notified once
+func (m *JobRunGapManager) Check(ctx context.Context, now time.Time) {
+	projects, err := m.projectGetter.GetAll(ctx)
+	if err != nil {
+		m.l.Error("error getting projects to check job run gaps: %s", err)
+		return
+	}
+
+	endTime := now.Add(-m.gracePeriod)
+	startTime := endTime.Add(-m.lookback)
+	notifyAfter := endTime.Add(-m.interval)
+
+	missingCounts := map[tenant.Tenant]int{}
+	for t := range m.gapTenants {
+		missingCounts[t] = 0
+	}
+	for _, project := range projects {
+		gaps, err := m.gapFinder.GetJobRunGaps(ctx, project.Name(), "", startTime, endTime)
+		if err != nil {
+			m.l.Error("error getting job run gaps of project [%s]: %s", project.Name(), err)
+		}
+		for _, gap := range gaps {
+			missingCounts[gap.Tenant] += len(gap.ScheduledAt)
+
+			var newlyMissing []time.Time
+			for _, scheduledAt := range gap.ScheduledAt {
+				if scheduledAt.After(notifyAfter) {
+					newlyMissing = append(newlyMissing, scheduledAt)
+				}
+			}
+			if len(newlyMissing) == 0 {
+				continue
+			}
+			newGap := &scheduler.JobRunGap{JobName: gap.JobName, Tenant: gap.Tenant, ScheduledAt: newlyMissing}
+			if err := m.notifier.Push(ctx, scheduler.NewMissingRunEvent(newGap, now)); err != nil {
+				m.l.Error("error notifying missing runs of job [%s]: %s", gap.JobName, err)
+			}
+		}
+	}
+
+	m.gapTenants = map[tenant.Tenant]struct{}{}
+	for t, count := range missingCounts {
+		telemetry.NewGauge(metricJobRunMissing, map[string]string{
+			"project":   t.ProjectName().String(),
+			"namespace": t.NamespaceName().String(),
+		}).Set(float64(count))
+		if count > 0 {
+			m.gapTenants[t] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/telemetry"
+)
+
+func TestJobRunGapManager(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+
+	project1, _ := tenant.NewProject("gap-proj1", map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	project2, _ := tenant.NewProject("gap-proj2", map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	tnnt1, _ := tenant.NewTenant(project1.Name().String(), "ns1")
+	tnnt2, _ := tenant.NewTenant(project2.Name().String(), "ns1")
+
+	interval := time.Hour
+	lookback := time.Hour * 24 * 7
+	gracePeriod := time.Hour * 3
+	now := time.Date(2023, 1, 10, 6, 30, 0, 0, time.UTC)
+	endTime := now.Add(-gracePeriod)
+	startTime := endTime.Add(-lookback)
+
+	missingGauge := func(t tenant.Tenant) float64 {
+		return testutil.ToFloat64(telemetry.NewGauge("jobrun_missing_runs", map[string]string{
+			"project":   t.ProjectName().String(),
+			"namespace": t.NamespaceName().String(),
+		}))
+	}
+
+	t.Run("Check", func(t *testing.T) {
+		t.Run("does not check when unable to get projects", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return(nil, fmt.Errorf("some error"))
+			defer projectGetter.AssertExpectations(t)
+
+			gapFinder := new(mockJobRunGapFinder)
+			defer gapFinder.AssertExpectations(t)
+
+			manager := service.NewJobRunGapManager(logger, projectGetter, gapFinder, nil, interval, lookback, gracePeriod)
+			manager.Check(ctx, now)
+		})
+		t.Run("reports missing runs, notifies the newly missing ones and resets once the gaps are filled", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project1, project2}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			olderMissing := time.Date(2023, 1, 9, 2, 0, 0, 0, time.UTC)
+			newlyMissing := time.Date(2023, 1, 10, 3, 0, 0, 0, time.UTC)
+			gapFinder := new(mockJobRunGapFinder)
+			gapFinder.On("GetJobRunGaps", ctx, project1.Name(), "", startTime, endTime).Return([]*scheduler.JobRunGap{
+				{JobName: "job-1", Tenant: tnnt1, ScheduledAt: []time.Time{olderMissing, newlyMissing}},
+				{JobName: "job-2", Tenant: tnnt1, ScheduledAt: []time.Time{olderMissing}},
+			}, nil).Once()
+			gapFinder.On("GetJobRunGaps", ctx, project2.Name(), "", startTime, endTime).Return(nil, fmt.Errorf("some error")).Once()
+			defer gapFinder.AssertExpectations(t)
+
+			notifier := new(mockEventPusher)
+			notifier.On("Push", ctx, scheduler.NewMissingRunEvent(&scheduler.JobRunGap{
+				JobName: "job-1", Tenant: tnnt1, ScheduledAt: []time.Time{newlyMissing},
+			}, now)).Return(fmt.Errorf("some error"))
+			defer notifier.AssertExpectations(t)
+
+			manager := service.NewJobRunGapManager(logger, projectGetter, gapFinder, notifier, interval, lookback, gracePeriod)
+			manager.Check(ctx, now)
+
+			assert.Equal(t, float64(3), missingGauge(tnnt1))
+
+			gapFinder.On("GetJobRunGaps", ctx, project1.Name(), "", startTime, endTime).Return(nil, nil).Once()
+			gapFinder.On("GetJobRunGaps", ctx, project2.Name(), "", startTime, endTime).Return([]*scheduler.JobRunGap{
+				{JobName: "job-3", Tenant: tnnt2, ScheduledAt: []time.Time{olderMissing}},
+			}, nil).Once()
+			manager.Check(ctx, now)
+
+			assert.Equal(t, float64(0), missingGauge(tnnt1))
+			assert.Equal(t, float64(1), missingGauge(tnnt2))
+		})
+	})
+}
+
+type mockJobRunGapFinder struct {
+	mock.Mock
+}
+
+func (m *mockJobRunGapFinder) GetJobRunGaps(ctx context.Context, projectName tenant.ProjectName, namespaceName string, startTime, endTime time.Time) ([]*scheduler.JobRunGap, error) {
+	args := m.Called(ctx, projectName, namespaceName, startTime, endTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRunGap), args.Error(1)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/lib/cron"
+	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/internal/telemetry"
 )
 
@@ -29,6 +30,11 @@ const (
 	scheduleDelay metricType = "schedule_delay"
 
 	metricJobRunEvents = "jobrun_events_total"
+	// metricJobRunSkippedUpstreams counts the job runs which proceeded without an optional upstream
+	metricJobRunSkippedUpstreams = "jobrun_skipped_upstreams_total"
+
+	// metricJobRunMonitoringPrefix prefixes the gauges of the metrics reported by the job runs, e.g. jobrun_rows_written
+	metricJobRunMonitoringPrefix = "jobrun_"
 )
 
 type JobRepository interface {
@@ -44,8 +50,19 @@ type JobRunRepository interface {
 	Create(ctx context.Context, tenant tenant.Tenant, name scheduler.JobName, scheduledAt time.Time, slaDefinitionInSec int64) error
 	Update(ctx context.Context, jobRunID uuid.UUID, endTime time.Time, jobRunStatus scheduler.State) error
 	UpdateState(ctx context.Context, jobRunID uuid.UUID, jobRunStatus scheduler.State) error
+	UpdateStateManually(ctx context.Context, jobRunID uuid.UUID, change *scheduler.JobRunStateChange) error
+	RestoreState(ctx context.Context, previous *scheduler.JobRun) error
+	GetRunsScheduledBetween(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.JobRun, error)
 	UpdateSLA(ctx context.Context, slaObjects []*scheduler.SLAObject) error
 	UpdateMonitoring(ctx context.Context, jobRunID uuid.UUID, monitoring map[string]any) error
+	GetJobRunStats(ctx context.Context, criteria *scheduler.JobRunStatsCriteria) (*scheduler.JobRunStats, error)
+	GetSuccessfulRuns(ctx context.Context, projectName tenant.ProjectName, startTime, endTime time.Time) (map[scheduler.JobName][]time.Time, error)
+	AddUpstreamRuns(ctx context.Context, jobRunID uuid.UUID, upstreamRuns []*scheduler.JobRunRef) error
+	AddSkippedUpstream(ctx context.Context, jobRunID uuid.UUID, skipped *scheduler.SkippedUpstream) error
+	GetUpstreamRuns(ctx context.Context, jobRunID uuid.UUID) ([]*scheduler.JobRunRef, error)
+	GetDownstreamRuns(ctx context.Context, upstreamRun *scheduler.JobRunRef) ([]*scheduler.JobRun, error)
+	UpsertMetrics(ctx context.Context, jobRunID uuid.UUID, metrics *scheduler.JobRunMetrics) error
+	GetMetrics(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.JobRunMetrics, error)
 }
 
 type JobReplayRepository interface {
@@ -71,6 +88,9 @@ type Scheduler interface {
 	DeployJobs(ctx context.Context, t tenant.Tenant, jobs []*scheduler.JobWithDetails) error
 	ListJobs(ctx context.Context, t tenant.Tenant) ([]string, error)
 	DeleteJobs(ctx context.Context, t tenant.Tenant, jobsToDelete []string) error
+	UpdateJobRunState(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, jobCron *cron.ScheduleSpec, scheduledAts []time.Time, state scheduler.State) error
+	CompileJob(ctx context.Context, job *scheduler.JobWithDetails) ([]byte, error)
+	SyncPools(ctx context.Context, t tenant.Tenant) error
 }
 
 type EventHandler interface {
@@ -147,14 +167,22 @@ func (s *JobRunService) GetJobRuns(ctx context.Context, projectName tenant.Proje
 
 	if criteria.OnlyLastRun {
 		s.l.Warn("getting last run only")
-		return s.scheduler.GetJobRuns(ctx, jobWithDetails.Job.Tenant, criteria, jobCron)
+		lastRuns, err := s.scheduler.GetJobRuns(ctx, jobWithDetails.Job.Tenant, criteria, jobCron)
+		if err != nil {
+			return nil, err
+		}
+		s.addStateChanges(ctx, jobWithDetails.Job.Tenant, jobName, lastRuns)
+		return lastRuns, nil
+	}
+	if criteria.RunOffset != 0 {
+		criteria = criteria.WithRunOffsetApplied()
 	}
 	err = validateJobQuery(criteria, jobWithDetails)
 	if err != nil {
 		s.l.Error("invalid job query: %s", err)
 		return nil, err
 	}
-	expectedRuns := getExpectedRuns(jobCron, criteria.StartDate, criteria.EndDate)
+	expectedRuns := getExpectedRuns(jobCron, criteria.StartDate, criteria.EndDate, jobWithDetails.Job.Window)
 
 	actualRuns, err := s.scheduler.GetJobRuns(ctx, jobWithDetails.Job.Tenant, criteria, jobCron)
 	if err != nil {
@@ -164,16 +192,300 @@ func (s *JobRunService) GetJobRuns(ctx context.Context, projectName tenant.Proje
 	totalRuns := mergeRuns(expectedRuns, actualRuns)
 
 	result := filterRuns(totalRuns, createFilterSet(criteria.Filter))
+	s.addStateChanges(ctx, jobWithDetails.Job.Tenant, jobName, result)
 
 	return result, nil
 }
 
-func getExpectedRuns(spec *cron.ScheduleSpec, startTime, endTime time.Time) []*scheduler.JobRunStatus {
+// addStateChanges sets who changed the state of the runs manually and why, as recorded in optimus.
+// The runs are returned without it when the state changes can not be fetched.
+func (s *JobRunService) addStateChanges(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, runs []*scheduler.JobRunStatus) {
+	if len(runs) == 0 {
+		return
+	}
+	startDate, endDate := runs[0].ScheduledAt, runs[0].ScheduledAt
+	for _, run := range runs {
+		if run.ScheduledAt.Before(startDate) {
+			startDate = run.ScheduledAt
+		}
+		if run.ScheduledAt.After(endDate) {
+			endDate = run.ScheduledAt
+		}
+	}
+	storedRuns, err := s.repo.GetRunsScheduledBetween(ctx, t, jobName, startDate, endDate)
+	if err != nil {
+		s.l.Error("unable to get the state changes of job runs: %s", err)
+		return
+	}
+	changedRuns := map[string]*scheduler.JobRun{}
+	for _, storedRun := range storedRuns {
+		if storedRun.StateChangedBy != "" {
+			changedRuns[storedRun.ScheduledAt.UTC().String()] = storedRun
+		}
+	}
+	for _, run := range runs {
+		if changedRun, ok := changedRuns[run.ScheduledAt.UTC().String()]; ok {
+			run.StateChangedBy = changedRun.StateChangedBy
+			run.StateChangeReason = changedRun.StateChangeReason
+		}
+	}
+}
+
+// GetJobRunLineage walks the links between the job run scheduled at the given time and the runs of its upstreams
+// or downstreams, up to the given depth
+func (s *JobRunService) GetJobRunLineage(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time,
+	direction scheduler.LineageDirection, depth int,
+) (*scheduler.JobRunLineage, error) {
+	job, err := s.jobRepo.GetJob(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	jobRun, err := s.repo.GetByScheduledAt(ctx, job.Tenant, jobName, scheduledAt)
+	if err != nil {
+		s.l.Error("error getting job run of job [%s] scheduled at [%s]: %s", jobName, scheduledAt, err)
+		return nil, err
+	}
+
+	root := scheduler.NewJobRunLineage(jobRun)
+	visited := map[uuid.UUID]struct{}{root.ID: {}}
+	current := []*scheduler.JobRunLineage{root}
+	for level := 0; level < depth && len(current) > 0; level++ {
+		var next []*scheduler.JobRunLineage
+		for _, node := range current {
+			links, err := s.getLineageLinks(ctx, node, direction)
+			if err != nil {
+				s.l.Error("error getting %s runs of job [%s] scheduled at [%s]: %s", direction, node.JobName, node.ScheduledAt, err)
+				return nil, err
+			}
+			node.Links = links
+			for _, link := range links {
+				// runs not known by this server can not be walked further
+				if link.ID == uuid.Nil {
+					continue
+				}
+				if _, ok := visited[link.ID]; ok {
+					continue
+				}
+				visited[link.ID] = struct{}{}
+				next = append(next, link)
+			}
+		}
+		current = next
+	}
+	return root, nil
+}
+
+func (s *JobRunService) getLineageLinks(ctx context.Context, node *scheduler.JobRunLineage, direction scheduler.LineageDirection) ([]*scheduler.JobRunLineage, error) {
+	var links []*scheduler.JobRunLineage
+	if direction == scheduler.LineageDirectionDownstream {
+		downstreamRuns, err := s.repo.GetDownstreamRuns(ctx, &node.JobRunRef)
+		if err != nil {
+			return nil, err
+		}
+		for _, downstreamRun := range downstreamRuns {
+			links = append(links, scheduler.NewJobRunLineage(downstreamRun))
+		}
+		return links, nil
+	}
+
+	upstreamRuns, err := s.repo.GetUpstreamRuns(ctx, node.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, upstreamRun := range upstreamRuns {
+		jobRun, err := s.repo.GetByScheduledAt(ctx, upstreamRun.Tenant, upstreamRun.JobName, upstreamRun.ScheduledAt)
+		if err != nil {
+			if !errors.IsErrorType(err, errors.ErrNotFound) {
+				return nil, err
+			}
+			links = append(links, &scheduler.JobRunLineage{JobRunRef: *upstreamRun})
+			continue
+		}
+		links = append(links, scheduler.NewJobRunLineage(jobRun))
+	}
+	return links, nil
+}
+
+// GetJobRunMetrics returns the metrics reported by the runs of the job scheduled within the range, per metric
+func (s *JobRunService) GetJobRunMetrics(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.MetricSeries, error) {
+	if _, err := s.jobRepo.GetJob(ctx, projectName, jobName); err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	runMetrics, err := s.repo.GetMetrics(ctx, projectName, jobName, startDate, endDate)
+	if err != nil {
+		s.l.Error("error getting metrics of job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	return scheduler.MetricSeriesFrom(runMetrics), nil
+}
+
+// GetJobRunGaps returns the scheduled times within the range without a successful run, for every enabled job of the
+// project, or of the namespace when given
+func (s *JobRunService) GetJobRunGaps(ctx context.Context, projectName tenant.ProjectName, namespaceName string, startTime, endTime time.Time) ([]*scheduler.JobRunGap, error) {
+	jobs, err := s.jobRepo.GetAll(ctx, projectName)
+	if err != nil {
+		if errors.IsErrorType(err, errors.ErrNotFound) {
+			return nil, nil
+		}
+		s.l.Error("error getting jobs of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+
+	successfulRuns, err := s.repo.GetSuccessfulRuns(ctx, projectName, startTime, endTime)
+	if err != nil {
+		s.l.Error("error getting successful runs of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+
+	var gaps []*scheduler.JobRunGap
+	me := errors.NewMultiError("errors in GetJobRunGaps")
+	for _, job := range jobs {
+		if job.Disabled || (namespaceName != "" && job.Job.Tenant.NamespaceName().String() != namespaceName) {
+			continue
+		}
+		missing, err := scheduler.MissingScheduledTimes(job, successfulRuns[job.Name], startTime, endTime)
+		if err != nil {
+			s.l.Error("error getting missing runs of job [%s]: %s", job.Name, err)
+			me.Append(errors.AddErrContext(err, scheduler.EntityJobRun, "job "+job.Name.String()))
+			continue
+		}
+		if len(missing) > 0 {
+			gaps = append(gaps, &scheduler.JobRunGap{
+				JobName:     job.Name,
+				Tenant:      job.Job.Tenant,
+				ScheduledAt: missing,
+			})
+		}
+	}
+	return gaps, me.ToErr()
+}
+
+// GetJobRunStats returns the stats of the runs of a job, or of every job in the namespace or project when job name is not given
+func (s *JobRunService) GetJobRunStats(ctx context.Context, criteria *scheduler.JobRunStatsCriteria) (*scheduler.JobRunStats, error) {
+	if criteria.JobName != "" {
+		if _, err := s.jobRepo.GetJob(ctx, criteria.ProjectName, criteria.JobName); err != nil {
+			s.l.Error("error getting job [%s]: %s", criteria.JobName, err)
+			return nil, err
+		}
+	}
+
+	stats, err := s.repo.GetJobRunStats(ctx, criteria)
+	if err != nil {
+		s.l.Error("error getting job run stats: %s", err)
+		return nil, err
+	}
+	return stats, nil
+}
+
+// UpdateJobRunState sets the state of the runs scheduled between startDate and endDate, both on the scheduler and optimus
+func (s *JobRunService) UpdateJobRunState(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time, change *scheduler.JobRunStateChange) ([]*scheduler.JobRunStatus, error) {
+	if endDate.Before(startDate) {
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "end date cannot be before start date")
+	}
+	jobWithDetails, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		msg := fmt.Sprintf("unable to get job details for jobName: %s, project:%s", jobName, projectName)
+		s.l.Error(msg)
+		return nil, errors.AddErrContext(err, scheduler.EntityJobRun, msg)
+	}
+	interval := jobWithDetails.Schedule.Interval
+	if interval == "" {
+		s.l.Error("job schedule interval is empty")
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "cannot update job run state, job interval is empty")
+	}
+	jobCron, err := cron.ParseCronSchedule(interval)
+	if err != nil {
+		msg := fmt.Sprintf("unable to parse job cron interval: %s", err)
+		s.l.Error(msg)
+		return nil, errors.InternalError(scheduler.EntityJobRun, msg, nil)
+	}
+	criteria := &scheduler.JobRunsCriteria{Name: jobName.String(), StartDate: startDate, EndDate: endDate}
+	if err := validateJobQuery(criteria, jobWithDetails); err != nil {
+		s.l.Error("invalid job query: %s", err)
+		return nil, err
+	}
+
+	expectedRuns := getExpectedRuns(jobCron, startDate, endDate, jobWithDetails.Job.Window)
+	if len(expectedRuns) == 0 {
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "no job run is scheduled in the given range")
+	}
+	scheduledAts := make([]time.Time, len(expectedRuns))
+	for i, run := range expectedRuns {
+		scheduledAts[i] = run.ScheduledAt
+	}
+
+	// the state is recorded in optimus first and restored when the scheduler can not be updated, only the runs
+	// already known by optimus are updated as the state of a run can not be changed before it is scheduled
+	jobTenant := jobWithDetails.Job.Tenant
+	me := errors.NewMultiError("errors on updating job run state")
+	var previousRuns []*scheduler.JobRun
+	var updatedScheduledAts []time.Time
+	for _, scheduledAt := range scheduledAts {
+		jobRun, err := s.repo.GetByScheduledAt(ctx, jobTenant, jobName, scheduledAt)
+		if err != nil {
+			s.l.Error("error getting job run by schedule time [%s]: %s", scheduledAt, err)
+			me.Append(err)
+			continue
+		}
+		if err := s.repo.UpdateStateManually(ctx, jobRun.ID, change); err != nil {
+			s.l.Error("error updating state for job run id [%s] to [%s]: %s", jobRun.ID, change.State, err)
+			me.Append(err)
+			continue
+		}
+		previousRuns = append(previousRuns, jobRun)
+		updatedScheduledAts = append(updatedScheduledAts, scheduledAt)
+	}
+	if len(previousRuns) == 0 {
+		return nil, me.ToErr()
+	}
+
+	if err := s.scheduler.UpdateJobRunState(ctx, jobTenant, jobName, jobCron, updatedScheduledAts, change.State); err != nil {
+		s.l.Error("error updating job run state on scheduler: %s", err)
+		me.Append(err)
+		for _, previousRun := range previousRuns {
+			if err := s.repo.RestoreState(ctx, previousRun); err != nil {
+				s.l.Error("error restoring state of job run id [%s] to [%s]: %s", previousRun.ID, previousRun.State, err)
+				me.Append(err)
+			}
+		}
+		return nil, me.ToErr()
+	}
+
+	var updatedRuns []*scheduler.JobRunStatus
+	for i, previousRun := range previousRuns {
+		scheduledAt := updatedScheduledAts[i]
+		s.l.Info("job run [%s] scheduled at [%s] is marked as %s by %s: %s", jobName, scheduledAt, change.State, change.ChangedBy, change.Reason)
+		updatedRun := *previousRun
+		updatedRun.State = change.State
+		updatedRun.StateChangedBy = change.ChangedBy
+		updatedRun.StateChangeReason = change.Reason
+		s.raiseJobRunStateChangeEvent(&updatedRun)
+		updatedRuns = append(updatedRuns, &scheduler.JobRunStatus{
+			ScheduledAt:       scheduledAt,
+			State:             change.State,
+			StateChangedBy:    change.ChangedBy,
+			StateChangeReason: change.Reason,
+		})
+	}
+	return updatedRuns, me.ToErr()
+}
+
+// getExpectedRuns returns the runs scheduled in the given range, skipping the runs which are not scheduled
+// on a business day when the window of the job is computed over a calendar
+func getExpectedRuns(spec *cron.ScheduleSpec, startTime, endTime time.Time, window models.Window) []*scheduler.JobRunStatus {
+	calendarWindow, skipNonBusinessDays := window.(models.CalendarWindow)
+
 	var jobRuns []*scheduler.JobRunStatus
 	start := spec.Next(startTime.Add(-time.Second * 1))
 	end := endTime
 	exit := spec.Next(end)
 	for !start.Equal(exit) {
+		if skipNonBusinessDays && !calendarWindow.IsBusinessDay(start) {
+			start = spec.Next(start)
+			continue
+		}
 		jobRuns = append(jobRuns, &scheduler.JobRunStatus{
 			State:       scheduler.StatePending,
 			ScheduledAt: start,
@@ -298,16 +610,36 @@ func (s *JobRunService) updateJobRun(ctx context.Context, event *scheduler.Event
 	}
 	jobRun.State = event.Status
 	s.raiseJobRunStateChangeEvent(jobRun)
-	monitoringValues := s.getMonitoringValues(event)
-	return s.repo.UpdateMonitoring(ctx, jobRun.ID, monitoringValues)
+	monitoringValues := event.MonitoringValues()
+	if err := s.repo.UpdateMonitoring(ctx, jobRun.ID, monitoringValues); err != nil {
+		s.l.Error("error updating monitoring of job run with id [%s]: %s", jobRun.ID, err)
+		return err
+	}
+	return s.updateJobRunMetrics(ctx, jobRun, monitoringValues)
 }
 
-func (*JobRunService) getMonitoringValues(event *scheduler.Event) map[string]any {
-	var output map[string]any
-	if value, ok := event.Values["monitoring"]; ok && value != nil {
-		output, _ = value.(map[string]any)
+// updateJobRunMetrics stores the metrics of the schema from the monitoring values and exports them as gauges
+func (s *JobRunService) updateJobRunMetrics(ctx context.Context, jobRun *scheduler.JobRun, monitoringValues map[string]any) error {
+	// the metrics are reported by the tasks, invalid ones are dropped rather than failing the event of the run
+	metrics, err := scheduler.JobRunMetricsFrom(jobRun.ScheduledAt, monitoringValues)
+	if err != nil {
+		s.l.Warn("dropping invalid monitoring metrics of job [%s] run scheduled at [%s] with id [%s]: %s", jobRun.JobName, jobRun.ScheduledAt, jobRun.ID, err)
+	}
+	if metrics.IsEmpty() {
+		return nil
 	}
-	return output
+	if err := s.repo.UpsertMetrics(ctx, jobRun.ID, metrics); err != nil {
+		s.l.Error("error storing metrics of job run with id [%s]: %s", jobRun.ID, err)
+		return err
+	}
+	for name, value := range metrics.Values {
+		telemetry.NewGauge(metricJobRunMonitoringPrefix+name.String(), map[string]string{
+			"project":   jobRun.Tenant.ProjectName().String(),
+			"namespace": jobRun.Tenant.NamespaceName().String(),
+			"name":      jobRun.JobName.String(),
+		}).Set(value)
+	}
+	return nil
 }
 
 func (s *JobRunService) updateJobRunSLA(ctx context.Context, event *scheduler.Event) error {
@@ -353,7 +685,10 @@ func (s *JobRunService) raiseJobRunStateChangeEvent(jobRun *scheduler.JobRun) {
 		s.l.Error("error creating event for job run state change : %s", err)
 		return
 	}
-	s.eventHandler.HandleEvent(schedulerEvent)
+	// no change event is published for the runs skipped manually, they are only counted
+	if jobRun.State != scheduler.StateSkipped {
+		s.eventHandler.HandleEvent(schedulerEvent)
+	}
 	telemetry.NewCounter(metricJobRunEvents, map[string]string{
 		"project":   jobRun.Tenant.ProjectName().String(),
 		"namespace": jobRun.Tenant.NamespaceName().String(),
@@ -426,6 +761,26 @@ func (s *JobRunService) updateOperatorRun(ctx context.Context, event *scheduler.
 		s.l.Error("error updating operator run id [%s]: %s", operatorRun.ID, err)
 		return err
 	}
+	if len(event.UpstreamRuns) > 0 {
+		if err := s.repo.AddUpstreamRuns(ctx, jobRun.ID, event.UpstreamRuns); err != nil {
+			s.l.Error("error adding upstream runs of job run id [%s]: %s", jobRun.ID, err)
+			return err
+		}
+	}
+	if skipped := event.SkippedUpstream; skipped != nil {
+		s.l.Warn("job run id [%s] proceeded without optional upstream [%s/%s]: %s", jobRun.ID,
+			skipped.Tenant.ProjectName(), skipped.JobName, skipped.Reason)
+		if err := s.repo.AddSkippedUpstream(ctx, jobRun.ID, skipped); err != nil {
+			s.l.Error("error adding skipped upstream of job run id [%s]: %s", jobRun.ID, err)
+			return err
+		}
+		telemetry.NewCounter(metricJobRunSkippedUpstreams, map[string]string{
+			"project":   event.Tenant.ProjectName().String(),
+			"namespace": event.Tenant.NamespaceName().String(),
+			"name":      event.JobName.String(),
+			"upstream":  skipped.JobName.String(),
+		}).Inc()
+	}
 	telemetry.NewGauge("jobrun_durations_breakdown_seconds", map[string]string{
 		"project":   event.Tenant.ProjectName().String(),
 		"namespace": event.Tenant.NamespaceName().String(),
@@ -17,6 +17,7 @@ import (
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/lib/cron"
+	"github.com/raystack/optimus/internal/models"
 )
 
 func TestJobRunService(t *testing.T) {
@@ -175,6 +176,7 @@ func TestJobRunService(t *testing.T) {
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(jobRun, nil).Once()
 				jobRunRepo.On("Update", ctx, jobRun.ID, event.EventTime, scheduler.StateSuccess).Return(nil)
 				jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
+				jobRunRepo.On("UpsertMetrics", ctx, jobRun.ID, &scheduler.JobRunMetrics{ScheduledAt: jobRun.ScheduledAt, Values: map[scheduler.MetricName]float64{scheduler.MetricSlotMillis: 5000}}).Return(nil)
 				defer jobRunRepo.AssertExpectations(t)
 
 				operatorRunRepo := new(mockOperatorRunRepository)
@@ -221,6 +223,50 @@ func TestJobRunService(t *testing.T) {
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
 				jobRunRepo.On("Update", ctx, jobRun.ID, endTime, scheduler.StateSuccess).Return(nil)
 				jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
+				jobRunRepo.On("UpsertMetrics", ctx, jobRun.ID, &scheduler.JobRunMetrics{ScheduledAt: jobRun.ScheduledAt, Values: map[scheduler.MetricName]float64{scheduler.MetricSlotMillis: 5000}}).Return(nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				eventHandler := newEventHandler(t)
+				eventHandler.On("HandleEvent", mock.Anything).Times(1)
+				defer eventHandler.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, nil, nil, nil, nil, eventHandler)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should drop invalid monitoring metrics on JobSuccessEvent and store the valid ones", func(t *testing.T) {
+				invalidMonitoring := map[string]any{
+					"rows_written": float64(-10),
+					"slot_millis":  "5000",
+					"cost":         0.5,
+				}
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					Status:         scheduler.StateSuccess,
+					JobScheduledAt: scheduledAtTimeStamp,
+					EventTime:      todayDate,
+					Values: map[string]any{
+						"status":     "success",
+						"monitoring": invalidMonitoring,
+					},
+				}
+
+				jobRun := scheduler.JobRun{
+					ID:        uuid.New(),
+					JobName:   jobName,
+					Tenant:    tnnt,
+					StartTime: todayDate,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("Update", ctx, jobRun.ID, todayDate, scheduler.StateSuccess).Return(nil)
+				jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, invalidMonitoring).Return(nil)
+				jobRunRepo.On("UpsertMetrics", ctx, jobRun.ID, &scheduler.JobRunMetrics{ScheduledAt: jobRun.ScheduledAt, Values: map[scheduler.MetricName]float64{scheduler.MetricCost: 0.5}}).Return(nil)
 				defer jobRunRepo.AssertExpectations(t)
 
 				eventHandler := newEventHandler(t)
@@ -332,6 +378,7 @@ func TestJobRunService(t *testing.T) {
 					jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil).Once()
 					jobRunRepo.On("Update", ctx, jobRun.ID, endTime, scheduler.StateSuccess).Return(nil)
 					jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
+					jobRunRepo.On("UpsertMetrics", ctx, jobRun.ID, &scheduler.JobRunMetrics{ScheduledAt: jobRun.ScheduledAt, Values: map[scheduler.MetricName]float64{scheduler.MetricSlotMillis: 5000}}).Return(nil)
 					defer jobRunRepo.AssertExpectations(t)
 
 					eventHandler := newEventHandler(t)
@@ -583,6 +630,102 @@ func TestJobRunService(t *testing.T) {
 				assert.NotNil(t, err)
 				assert.EqualError(t, err, "error in getting job run GetByScheduledAt")
 			})
+			t.Run("on SensorSuccessEvent should add the upstream runs the sensor was satisfied by", func(t *testing.T) {
+				scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
+				eventTime := time.Unix(todayDate.Add(time.Hour).Unix(), 0)
+				upstreamTnnt, _ := tenant.NewTenant("upstream-proj", "upstream-ns")
+				upstreamRuns := []*scheduler.JobRunRef{
+					{JobName: "upstream_job", Tenant: upstreamTnnt, ScheduledAt: scheduledAtTimeStamp.Add(-time.Hour)},
+				}
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.SensorSuccessEvent,
+					EventTime:      eventTime,
+					OperatorName:   "wait-upstream_job",
+					Status:         scheduler.StateSuccess,
+					JobScheduledAt: scheduledAtTimeStamp,
+					UpstreamRuns:   upstreamRuns,
+				}
+				jobRun := scheduler.JobRun{
+					ID:        uuid.New(),
+					JobName:   jobName,
+					Tenant:    tnnt,
+					StartTime: time.Now(),
+				}
+				operatorRun := scheduler.OperatorRun{
+					ID:           uuid.New(),
+					Name:         "wait-upstream_job",
+					JobRunID:     jobRun.ID,
+					OperatorType: scheduler.OperatorSensor,
+					Status:       scheduler.StateRunning,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddUpstreamRuns", ctx, jobRun.ID, upstreamRuns).Return(fmt.Errorf("some error"))
+				defer jobRunRepo.AssertExpectations(t)
+
+				operatorRunRepository := new(mockOperatorRunRepository)
+				operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorSensor, jobRun.ID).Return(&operatorRun, nil)
+				operatorRunRepository.On("UpdateOperatorRun", ctx, scheduler.OperatorSensor, operatorRun.ID, eventTime, scheduler.StateSuccess).Return(nil)
+				defer operatorRunRepository.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.EqualError(t, err, "some error")
+			})
+			t.Run("on SensorSuccessEvent should add the optional upstream the sensor proceeded without", func(t *testing.T) {
+				scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
+				eventTime := time.Unix(todayDate.Add(time.Hour).Unix(), 0)
+				upstreamTnnt, _ := tenant.NewTenant("upstream-proj", "upstream-ns")
+				skippedUpstream := &scheduler.SkippedUpstream{
+					JobName: "upstream_job",
+					Tenant:  upstreamTnnt,
+					Reason:  "max wait of 2h0m0s exceeded",
+				}
+				event := &scheduler.Event{
+					JobName:         jobName,
+					Tenant:          tnnt,
+					Type:            scheduler.SensorSuccessEvent,
+					EventTime:       eventTime,
+					OperatorName:    "wait-upstream_job",
+					Status:          scheduler.StateSuccess,
+					JobScheduledAt:  scheduledAtTimeStamp,
+					SkippedUpstream: skippedUpstream,
+				}
+				jobRun := scheduler.JobRun{
+					ID:        uuid.New(),
+					JobName:   jobName,
+					Tenant:    tnnt,
+					StartTime: time.Now(),
+				}
+				operatorRun := scheduler.OperatorRun{
+					ID:           uuid.New(),
+					Name:         "wait-upstream_job",
+					JobRunID:     jobRun.ID,
+					OperatorType: scheduler.OperatorSensor,
+					Status:       scheduler.StateRunning,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddSkippedUpstream", ctx, jobRun.ID, skippedUpstream).Return(nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				operatorRunRepository := new(mockOperatorRunRepository)
+				operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorSensor, jobRun.ID).Return(&operatorRun, nil)
+				operatorRunRepository.On("UpdateOperatorRun", ctx, scheduler.OperatorSensor, operatorRun.ID, eventTime, scheduler.StateSuccess).Return(nil)
+				defer operatorRunRepository.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.NoError(t, err)
+			})
 			t.Run("on HookSuccessEvent should fail when unable to get operator run due to errors other than not found error ", func(t *testing.T) {
 				scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
 				eventTime := time.Unix(todayDate.Add(time.Hour).Unix(), 0)
@@ -860,6 +1003,491 @@ func TestJobRunService(t *testing.T) {
 			assert.Equal(t, &dummyExecutorInput, executorInput)
 		})
 	})
+	t.Run("GetJobRunStats", func(t *testing.T) {
+		startDate := time.Date(2022, 3, 20, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2022, 3, 27, 0, 0, 0, 0, time.UTC)
+		stats := &scheduler.JobRunStats{TotalRuns: 7, SuccessRuns: 6, FailedRuns: 1}
+
+		t.Run("should return error when job does not exist", func(t *testing.T) {
+			criteria, _ := scheduler.NewJobRunStatsCriteria(projName, "", jobName.String(), startDate, endDate)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(nil, errors.NotFound(scheduler.EntityJobRun, "job not found"))
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			jobRunStats, err := runService.GetJobRunStats(ctx, criteria)
+			assert.Nil(t, jobRunStats)
+			assert.EqualError(t, err, "not found for entity jobRun: job not found")
+		})
+		t.Run("should return error when unable to get stats", func(t *testing.T) {
+			criteria, _ := scheduler.NewJobRunStatsCriteria(projName, namespaceName.String(), "", startDate, endDate)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetJobRunStats", ctx, criteria).Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				nil, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			jobRunStats, err := runService.GetJobRunStats(ctx, criteria)
+			assert.Nil(t, jobRunStats)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("should return stats of the job runs", func(t *testing.T) {
+			criteria, _ := scheduler.NewJobRunStatsCriteria(projName, "", jobName.String(), startDate, endDate)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetJobRunStats", ctx, criteria).Return(stats, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			jobRunStats, err := runService.GetJobRunStats(ctx, criteria)
+			assert.Nil(t, err)
+			assert.Equal(t, stats, jobRunStats)
+		})
+	})
+	t.Run("GetJobRunMetrics", func(t *testing.T) {
+		startDate := time.Date(2022, 3, 20, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2022, 3, 27, 0, 0, 0, 0, time.UTC)
+
+		t.Run("should return error when job does not exist", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(nil, errors.NotFound(scheduler.EntityJobRun, "job not found"))
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			series, err := runService.GetJobRunMetrics(ctx, projName, jobName, startDate, endDate)
+			assert.Nil(t, series)
+			assert.EqualError(t, err, "not found for entity jobRun: job not found")
+		})
+		t.Run("should return error when unable to get metrics", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetMetrics", ctx, projName, jobName, startDate, endDate).Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			series, err := runService.GetJobRunMetrics(ctx, projName, jobName, startDate, endDate)
+			assert.Nil(t, series)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("should return series of the metrics reported by the runs", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetMetrics", ctx, projName, jobName, startDate, endDate).Return([]*scheduler.JobRunMetrics{
+				{
+					ScheduledAt: startDate.Add(time.Hour * 24),
+					Values:      map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 120},
+				},
+				{
+					ScheduledAt: startDate,
+					Values:      map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 100, scheduler.MetricCost: 0.5},
+				},
+			}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			series, err := runService.GetJobRunMetrics(ctx, projName, jobName, startDate, endDate)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.MetricSeries{
+				{
+					Name: scheduler.MetricRowsWritten,
+					Points: []scheduler.MetricPoint{
+						{ScheduledAt: startDate, Value: 100},
+						{ScheduledAt: startDate.Add(time.Hour * 24), Value: 120},
+					},
+				},
+				{
+					Name:   scheduler.MetricCost,
+					Points: []scheduler.MetricPoint{{ScheduledAt: startDate, Value: 0.5}},
+				},
+			}, series)
+		})
+	})
+	t.Run("GetJobRunLineage", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+		remoteTnnt, _ := tenant.NewTenant("remote-proj", "remote-ns")
+		scheduledAt := time.Date(2022, 3, 20, 2, 0, 0, 0, time.UTC)
+		jobRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateSuccess, ScheduledAt: scheduledAt}
+		upstreamRun := &scheduler.JobRun{ID: uuid.New(), JobName: "upstream_job", Tenant: tnnt, State: scheduler.StateSuccess, ScheduledAt: scheduledAt.Add(-time.Hour)}
+		upstreamRef := &scheduler.JobRunRef{JobName: upstreamRun.JobName, Tenant: tnnt, ScheduledAt: upstreamRun.ScheduledAt}
+		remoteRef := &scheduler.JobRunRef{JobName: "remote_job", Tenant: remoteTnnt, ScheduledAt: scheduledAt.Add(-time.Hour * 2)}
+
+		t.Run("should return error when job run does not exist", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName, Tenant: tnnt}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			lineage, err := runService.GetJobRunLineage(ctx, projName, jobName, scheduledAt, scheduler.LineageDirectionUpstream, 3)
+			assert.Nil(t, lineage)
+			assert.EqualError(t, err, "not found for entity jobRun: no record")
+		})
+		t.Run("should walk the upstream runs up to the depth", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName, Tenant: tnnt}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+			jobRunRepo.On("GetUpstreamRuns", ctx, jobRun.ID).Return([]*scheduler.JobRunRef{upstreamRef, remoteRef}, nil)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, upstreamRef.JobName, upstreamRef.ScheduledAt).Return(upstreamRun, nil)
+			jobRunRepo.On("GetByScheduledAt", ctx, remoteTnnt, remoteRef.JobName, remoteRef.ScheduledAt).Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			lineage, err := runService.GetJobRunLineage(ctx, projName, jobName, scheduledAt, scheduler.LineageDirectionUpstream, 1)
+			assert.Nil(t, err)
+			assert.Equal(t, jobRun.ID, lineage.ID)
+			assert.Len(t, lineage.Links, 2)
+			assert.Equal(t, upstreamRun.ID, lineage.Links[0].ID)
+			assert.Equal(t, scheduler.StateSuccess, lineage.Links[0].State)
+			assert.Empty(t, lineage.Links[0].Links)
+			assert.Equal(t, &scheduler.JobRunLineage{JobRunRef: *remoteRef}, lineage.Links[1])
+		})
+		t.Run("should walk the downstream runs", func(t *testing.T) {
+			downstreamRun := &scheduler.JobRun{ID: uuid.New(), JobName: "downstream_job", Tenant: tnnt, State: scheduler.StateFailed, ScheduledAt: scheduledAt.Add(time.Hour)}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName, Tenant: tnnt}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+			jobRunRepo.On("GetDownstreamRuns", ctx, &scheduler.JobRunRef{JobName: jobName, Tenant: tnnt, ScheduledAt: scheduledAt}).Return([]*scheduler.JobRun{downstreamRun}, nil)
+			jobRunRepo.On("GetDownstreamRuns", ctx, &scheduler.JobRunRef{JobName: downstreamRun.JobName, Tenant: tnnt, ScheduledAt: downstreamRun.ScheduledAt}).Return(nil, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			lineage, err := runService.GetJobRunLineage(ctx, projName, jobName, scheduledAt, scheduler.LineageDirectionDownstream, 3)
+			assert.Nil(t, err)
+			assert.Len(t, lineage.Links, 1)
+			assert.Equal(t, downstreamRun.ID, lineage.Links[0].ID)
+			assert.Equal(t, scheduler.StateFailed, lineage.Links[0].State)
+		})
+		t.Run("should return error when unable to get linked runs", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJob", ctx, projName, jobName).Return(&scheduler.Job{Name: jobName, Tenant: tnnt}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+			jobRunRepo.On("GetUpstreamRuns", ctx, jobRun.ID).Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			lineage, err := runService.GetJobRunLineage(ctx, projName, jobName, scheduledAt, scheduler.LineageDirectionUpstream, 3)
+			assert.Nil(t, lineage)
+			assert.EqualError(t, err, "some error")
+		})
+	})
+	t.Run("GetJobRunGaps", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+		otherTnnt, _ := tenant.NewTenant(projName.String(), "ns2")
+		startTime := time.Date(2022, 3, 20, 0, 0, 0, 0, time.UTC)
+		endTime := time.Date(2022, 3, 23, 0, 0, 0, 0, time.UTC)
+		newJob := func(name scheduler.JobName, t tenant.Tenant, disabled bool) *scheduler.JobWithDetails {
+			return &scheduler.JobWithDetails{
+				Name:     name,
+				Job:      &scheduler.Job{Name: name, Tenant: t},
+				Schedule: &scheduler.Schedule{StartDate: startTime.Add(-time.Hour * 24 * 30), Interval: "0 12 * * *"},
+				Disabled: disabled,
+			}
+		}
+
+		t.Run("should return nothing when project has no jobs", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, projName).Return(nil, errors.NotFound(scheduler.EntityJobRun, "unable to find job"))
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			gaps, err := runService.GetJobRunGaps(ctx, projName, "", startTime, endTime)
+			assert.Nil(t, err)
+			assert.Empty(t, gaps)
+		})
+		t.Run("should return error when unable to get successful runs", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, projName).Return([]*scheduler.JobWithDetails{newJob(jobName, tnnt, false)}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetSuccessfulRuns", ctx, projName, startTime, endTime).Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			gaps, err := runService.GetJobRunGaps(ctx, projName, "", startTime, endTime)
+			assert.Nil(t, gaps)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("should return the missing runs of enabled jobs in the namespace", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, projName).Return([]*scheduler.JobWithDetails{
+				newJob(jobName, tnnt, false),
+				newJob("disabled_job", tnnt, true),
+				newJob("other_namespace_job", otherTnnt, false),
+				newJob("complete_job", tnnt, false),
+			}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetSuccessfulRuns", ctx, projName, startTime, endTime).Return(map[scheduler.JobName][]time.Time{
+				jobName: {time.Date(2022, 3, 21, 12, 0, 0, 0, time.UTC)},
+				"complete_job": {
+					time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC),
+					time.Date(2022, 3, 21, 12, 0, 0, 0, time.UTC),
+					time.Date(2022, 3, 22, 12, 0, 0, 0, time.UTC),
+				},
+			}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil)
+
+			gaps, err := runService.GetJobRunGaps(ctx, projName, namespaceName.String(), startTime, endTime)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobRunGap{
+				{
+					JobName: jobName,
+					Tenant:  tnnt,
+					ScheduledAt: []time.Time{
+						time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC),
+						time.Date(2022, 3, 22, 12, 0, 0, 0, time.UTC),
+					},
+				},
+			}, gaps)
+		})
+	})
+	t.Run("UpdateJobRunState", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+		startDate := time.Date(2022, 3, 20, 12, 0, 0, 0, time.UTC)
+		endDate := time.Date(2022, 3, 21, 12, 0, 0, 0, time.UTC)
+		jobCron, err := cron.ParseCronSchedule("0 12 * * *")
+		assert.Nil(t, err)
+		jobWithDetails := &scheduler.JobWithDetails{
+			Job: &scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+			},
+			Schedule: &scheduler.Schedule{
+				StartDate: startDate.Add(-time.Hour * 24),
+				Interval:  "0 12 * * *",
+			},
+		}
+		change, err := scheduler.NewJobRunStateChange("success", "optimus@example.io", "upstream incident")
+		assert.Nil(t, err)
+
+		t.Run("should return error when end date is before start date", func(t *testing.T) {
+			runService := service.NewJobRunService(logger,
+				nil, nil, nil, nil, nil, nil, nil, nil)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, endDate, startDate, change)
+			assert.Nil(t, updatedRuns)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: end date cannot be before start date")
+		})
+		t.Run("should return error when unable to get job details", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(nil, fmt.Errorf("some error in get job details"))
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, startDate, endDate, change)
+			assert.Nil(t, updatedRuns)
+			assert.ErrorContains(t, err, "unable to get job details for jobName: sample_select, project:proj")
+		})
+		t.Run("should return error when no run is scheduled in the range", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			notScheduledAt := startDate.Add(time.Hour)
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, notScheduledAt, notScheduledAt, change)
+			assert.Nil(t, updatedRuns)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: no job run is scheduled in the given range")
+		})
+		t.Run("should restore the state of job runs in optimus when scheduler fails", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			firstRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateFailed, ScheduledAt: startDate}
+			secondRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateRunning, ScheduledAt: endDate}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, startDate).Return(firstRun, nil)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, endDate).Return(secondRun, nil)
+			jobRunRepo.On("UpdateStateManually", ctx, firstRun.ID, change).Return(nil)
+			jobRunRepo.On("UpdateStateManually", ctx, secondRun.ID, change).Return(nil)
+			jobRunRepo.On("RestoreState", ctx, firstRun).Return(nil)
+			jobRunRepo.On("RestoreState", ctx, secondRun).Return(fmt.Errorf("some error in restore"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			sch := new(mockScheduler)
+			sch.On("UpdateJobRunState", ctx, tnnt, jobName, jobCron, []time.Time{startDate, endDate}, scheduler.StateSuccess).
+				Return(fmt.Errorf("dag run not found"))
+			defer sch.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, startDate, endDate, change)
+			assert.Nil(t, updatedRuns)
+			assert.ErrorContains(t, err, "dag run not found")
+			assert.ErrorContains(t, err, "some error in restore")
+		})
+		t.Run("should not create job runs which are not registered in optimus", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			secondRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateFailed, ScheduledAt: endDate}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, startDate).Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record for job run"))
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, endDate).Return(secondRun, nil)
+			jobRunRepo.On("UpdateStateManually", ctx, secondRun.ID, change).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			sch := new(mockScheduler)
+			sch.On("UpdateJobRunState", ctx, tnnt, jobName, jobCron, []time.Time{endDate}, scheduler.StateSuccess).Return(nil)
+			defer sch.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, sch, nil, nil, eventHandler)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, startDate, endDate, change)
+			assert.ErrorContains(t, err, "no record for job run")
+			assert.Equal(t, []*scheduler.JobRunStatus{
+				{ScheduledAt: endDate, State: scheduler.StateSuccess, StateChangedBy: "optimus@example.io", StateChangeReason: "upstream incident"},
+			}, updatedRuns)
+		})
+		t.Run("should not update the scheduler when no job run is updated in optimus", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			firstRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateFailed, ScheduledAt: startDate}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, startDate).Return(firstRun, nil)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, endDate).Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record for job run"))
+			jobRunRepo.On("UpdateStateManually", ctx, firstRun.ID, change).Return(fmt.Errorf("some error in update"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			sch := new(mockScheduler)
+			defer sch.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, startDate, endDate, change)
+			assert.Nil(t, updatedRuns)
+			assert.ErrorContains(t, err, "some error in update")
+			assert.ErrorContains(t, err, "no record for job run")
+		})
+		t.Run("should update job runs on optimus and scheduler and raise events", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			sch := new(mockScheduler)
+			sch.On("UpdateJobRunState", ctx, tnnt, jobName, jobCron, []time.Time{startDate, endDate}, scheduler.StateSuccess).Return(nil)
+			defer sch.AssertExpectations(t)
+
+			firstRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateFailed, ScheduledAt: startDate}
+			secondRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateRunning, ScheduledAt: endDate}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, startDate).Return(firstRun, nil)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, endDate).Return(secondRun, nil)
+			jobRunRepo.On("UpdateStateManually", ctx, firstRun.ID, change).Return(nil)
+			jobRunRepo.On("UpdateStateManually", ctx, secondRun.ID, change).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+			eventHandler.On("HandleEvent", mock.Anything).Times(2)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, sch, nil, nil, eventHandler)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, startDate, endDate, change)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobRunStatus{
+				{ScheduledAt: startDate, State: scheduler.StateSuccess, StateChangedBy: "optimus@example.io", StateChangeReason: "upstream incident"},
+				{ScheduledAt: endDate, State: scheduler.StateSuccess, StateChangedBy: "optimus@example.io", StateChangeReason: "upstream incident"},
+			}, updatedRuns)
+		})
+		t.Run("should mark job runs as skipped on optimus and scheduler", func(t *testing.T) {
+			skipChange, err := scheduler.NewJobRunStateChange("skipped", "optimus@example.io", "holiday")
+			assert.Nil(t, err)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			sch := new(mockScheduler)
+			sch.On("UpdateJobRunState", ctx, tnnt, jobName, jobCron, []time.Time{startDate}, scheduler.StateSkipped).Return(nil)
+			defer sch.AssertExpectations(t)
+
+			jobRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, State: scheduler.StateFailed, ScheduledAt: startDate}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, startDate).Return(jobRun, nil)
+			jobRunRepo.On("UpdateStateManually", ctx, jobRun.ID, skipChange).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, nil, nil, sch, nil, nil, eventHandler)
+
+			updatedRuns, err := runService.UpdateJobRunState(ctx, projName, jobName, startDate, startDate, skipChange)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobRunStatus{
+				{ScheduledAt: startDate, State: scheduler.StateSkipped, StateChangedBy: "optimus@example.io", StateChangeReason: "holiday"},
+			}, updatedRuns)
+			eventHandler.AssertNotCalled(t, "HandleEvent", mock.Anything)
+		})
+	})
 	t.Run("GetJobRunList", func(t *testing.T) {
 		startDate, err := time.Parse(time.RFC3339, "2022-03-20T02:00:00+00:00")
 		if err != nil {
@@ -1058,8 +1686,10 @@ func TestJobRunService(t *testing.T) {
 					jobRepo := new(JobRepository)
 					jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 					defer jobRepo.AssertExpectations(t)
+					jobRunRepo := new(mockJobRunRepository)
+					jobRunRepo.On("GetRunsScheduledBetween", ctx, tnnt, jobName, mock.Anything, mock.Anything).Return([]*scheduler.JobRun{}, nil).Maybe()
 					runService := service.NewJobRunService(logger,
-						jobRepo, nil, nil, nil, sch, nil, nil, nil)
+						jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil)
 					returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, scenario.input)
 					assert.Nil(t, err)
 					assert.Equal(t, scenario.expectedResult, returnedRuns)
@@ -1238,8 +1868,108 @@ func TestJobRunService(t *testing.T) {
 			jobRepo := new(JobRepository)
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetRunsScheduledBetween", ctx, tnnt, jobName, endDate, endDate).Return([]*scheduler.JobRun{
+				{JobName: jobName, Tenant: tnnt, State: scheduler.StateSuccess, ScheduledAt: endDate, StateChangedBy: "optimus@example.io", StateChangeReason: "upstream incident"},
+			}, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil)
+			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, criteria)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobRunStatus{
+				{State: scheduler.StateSuccess, ScheduledAt: endDate, StateChangedBy: "optimus@example.io", StateChangeReason: "upstream incident"},
+			}, returnedRuns)
+		})
+		t.Run("should skip the expected runs which are not on a business day of the window calendar", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+			calendar, _ := models.CalendarFrom("exchange", "weekdays=mon-fri;holidays=2023-01-03")
+			window, _ := models.NewWindowWithCalendar(3, "d", "0", "1b", "exchange")
+			window, _ = window.(models.CalendarWindow).WithCalendar(calendar)
+			job := scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+				Window: window,
+			}
+			jobWithDetails := scheduler.JobWithDetails{
+				Job: &job,
+				JobMetadata: &scheduler.JobMetadata{
+					Version: 3,
+				},
+				Schedule: &scheduler.Schedule{
+					StartDate: time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  "0 2 * * *",
+				},
+			}
+
+			criteria := &scheduler.JobRunsCriteria{
+				Name:      "sample_select",
+				StartDate: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC),
+			}
+
+			sch := new(mockScheduler)
+			sch.On("GetJobRuns", ctx, tnnt, criteria, mock.Anything).Return([]*scheduler.JobRunStatus{}, nil)
+			defer sch.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetRunsScheduledBetween", ctx, tnnt, jobName, time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC), time.Date(2023, 1, 4, 2, 0, 0, 0, time.UTC)).
+				Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil)
+			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, criteria)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobRunStatus{
+				{State: scheduler.StatePending, ScheduledAt: time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)},
+				{State: scheduler.StatePending, ScheduledAt: time.Date(2023, 1, 4, 2, 0, 0, 0, time.UTC)},
+			}, returnedRuns)
+		})
+		t.Run("should get the runs within the dates shifted by the run offset", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+			job := scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+			}
+			jobWithDetails := scheduler.JobWithDetails{
+				Job: &job,
+				JobMetadata: &scheduler.JobMetadata{
+					Version: 1,
+				},
+				Schedule: &scheduler.Schedule{
+					StartDate: time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  "0 2 * * *",
+				},
+			}
+
+			criteria := &scheduler.JobRunsCriteria{
+				Name:      "sample_select",
+				StartDate: time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC),
+				RunOffset: -24 * time.Hour,
+			}
+			shiftedCriteria := &scheduler.JobRunsCriteria{
+				Name:      "sample_select",
+				StartDate: time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC),
+			}
+			runs := []*scheduler.JobRunStatus{
+				{State: scheduler.StateSuccess, ScheduledAt: time.Date(2023, 1, 4, 2, 0, 0, 0, time.UTC)},
+			}
+
+			sch := new(mockScheduler)
+			sch.On("GetJobRuns", ctx, tnnt, shiftedCriteria, mock.Anything).Return(runs, nil)
+			defer sch.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetRunsScheduledBetween", ctx, tnnt, jobName, runs[0].ScheduledAt, runs[0].ScheduledAt).Return([]*scheduler.JobRun{}, nil)
+			defer jobRunRepo.AssertExpectations(t)
 
-			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, sch, nil, nil, nil)
+			runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, criteria)
 			assert.Nil(t, err)
 			assert.Equal(t, runs, returnedRuns)
@@ -1296,6 +2026,14 @@ func (m *mockJobRunRepository) GetByScheduledAt(ctx context.Context, tenant tena
 	return args.Get(0).(*scheduler.JobRun), args.Error(1)
 }
 
+func (m *mockJobRunRepository) GetRecentDurations(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, before time.Time, limit int) ([]time.Duration, error) {
+	args := m.Called(ctx, t, jobName, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]time.Duration), args.Error(1)
+}
+
 func (m *mockJobRunRepository) Create(ctx context.Context, tenant tenant.Tenant, name scheduler.JobName, scheduledAt time.Time, slaDefinitionInSec int64) error {
 	args := m.Called(ctx, tenant, name, scheduledAt, slaDefinitionInSec)
 	return args.Error(0)
@@ -1311,6 +2049,24 @@ func (m *mockJobRunRepository) UpdateState(ctx context.Context, jobRunID uuid.UU
 	return args.Error(0)
 }
 
+func (m *mockJobRunRepository) UpdateStateManually(ctx context.Context, jobRunID uuid.UUID, change *scheduler.JobRunStateChange) error {
+	args := m.Called(ctx, jobRunID, change)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) RestoreState(ctx context.Context, previous *scheduler.JobRun) error {
+	args := m.Called(ctx, previous)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) GetRunsScheduledBetween(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.JobRun, error) {
+	args := m.Called(ctx, t, jobName, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRun), args.Error(1)
+}
+
 func (m *mockJobRunRepository) UpdateSLA(ctx context.Context, slaObjects []*scheduler.SLAObject) error {
 	args := m.Called(ctx, slaObjects)
 	return args.Error(0)
@@ -1321,6 +2077,61 @@ func (m *mockJobRunRepository) UpdateMonitoring(ctx context.Context, jobRunID uu
 	return args.Error(0)
 }
 
+func (m *mockJobRunRepository) GetJobRunStats(ctx context.Context, criteria *scheduler.JobRunStatsCriteria) (*scheduler.JobRunStats, error) {
+	args := m.Called(ctx, criteria)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.JobRunStats), args.Error(1)
+}
+
+func (m *mockJobRunRepository) GetSuccessfulRuns(ctx context.Context, projectName tenant.ProjectName, startTime, endTime time.Time) (map[scheduler.JobName][]time.Time, error) {
+	args := m.Called(ctx, projectName, startTime, endTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[scheduler.JobName][]time.Time), args.Error(1)
+}
+
+func (m *mockJobRunRepository) AddUpstreamRuns(ctx context.Context, jobRunID uuid.UUID, upstreamRuns []*scheduler.JobRunRef) error {
+	args := m.Called(ctx, jobRunID, upstreamRuns)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) AddSkippedUpstream(ctx context.Context, jobRunID uuid.UUID, skipped *scheduler.SkippedUpstream) error {
+	args := m.Called(ctx, jobRunID, skipped)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) GetUpstreamRuns(ctx context.Context, jobRunID uuid.UUID) ([]*scheduler.JobRunRef, error) {
+	args := m.Called(ctx, jobRunID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRunRef), args.Error(1)
+}
+
+func (m *mockJobRunRepository) GetDownstreamRuns(ctx context.Context, upstreamRun *scheduler.JobRunRef) ([]*scheduler.JobRun, error) {
+	args := m.Called(ctx, upstreamRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRun), args.Error(1)
+}
+
+func (m *mockJobRunRepository) UpsertMetrics(ctx context.Context, jobRunID uuid.UUID, metrics *scheduler.JobRunMetrics) error {
+	args := m.Called(ctx, jobRunID, metrics)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) GetMetrics(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.JobRunMetrics, error) {
+	args := m.Called(ctx, projectName, jobName, startDate, endDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRunMetrics), args.Error(1)
+}
+
 type JobRepository struct {
 	mock.Mock
 }
@@ -1384,6 +2195,24 @@ func (ms *mockScheduler) DeleteJobs(ctx context.Context, t tenant.Tenant, jobsTo
 	return args.Error(0)
 }
 
+func (ms *mockScheduler) UpdateJobRunState(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, jobCron *cron.ScheduleSpec, scheduledAts []time.Time, state scheduler.State) error {
+	args := ms.Called(ctx, t, jobName, jobCron, scheduledAts, state)
+	return args.Error(0)
+}
+
+func (ms *mockScheduler) CompileJob(ctx context.Context, job *scheduler.JobWithDetails) ([]byte, error) {
+	args := ms.Called(ctx, job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (ms *mockScheduler) SyncPools(ctx context.Context, t tenant.Tenant) error {
+	args := ms.Called(ctx, t)
+	return args.Error(0)
+}
+
 type mockOperatorRunRepository struct {
 	mock.Mock
 }
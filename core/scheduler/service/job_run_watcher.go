@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/raystack/salt/log"
+
+	"github.com/raystack/optimus/core/event"
+	"github.com/raystack/optimus/core/event/moderator"
+	"github.com/raystack/optimus/core/scheduler"
+)
+
+// jobRunWatchBufferSize is the number of state changes kept for a watcher, changes are dropped for a watcher
+// which falls further behind instead of blocking the job runs being updated
+const jobRunWatchBufferSize = 100
+
+// JobRunWatcher passes the job run state change events on to the next handler, and to the watchers of job runs
+type JobRunWatcher struct {
+	l       log.Logger
+	next    EventHandler
+	jobRepo JobRepository
+
+	mu          sync.RWMutex
+	subscribers map[chan *scheduler.JobRun]struct{}
+}
+
+func NewJobRunWatcher(l log.Logger, jobRepo JobRepository, next EventHandler) *JobRunWatcher {
+	return &JobRunWatcher{
+		l:           l,
+		next:        next,
+		jobRepo:     jobRepo,
+		subscribers: map[chan *scheduler.JobRun]struct{}{},
+	}
+}
+
+func (w *JobRunWatcher) HandleEvent(e moderator.Event) {
+	w.next.HandleEvent(e)
+
+	var jobRun *scheduler.JobRun
+	switch stateChange := e.(type) {
+	case *event.JobRunWaitUpstream:
+		jobRun = stateChange.JobRun
+	case *event.JobRunInProgress:
+		jobRun = stateChange.JobRun
+	case *event.JobRunSuccess:
+		jobRun = stateChange.JobRun
+	case *event.JobRunFailed:
+		jobRun = stateChange.JobRun
+	default:
+		return
+	}
+	w.publish(jobRun)
+}
+
+func (w *JobRunWatcher) publish(jobRun *scheduler.JobRun) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	for subscriber := range w.subscribers {
+		// the run is copied as it is still being updated by the caller
+		jobRunCopy := *jobRun
+		select {
+		case subscriber <- &jobRunCopy:
+		default:
+			w.l.Warn("dropping state change of job [%s] scheduled at [%s] for a slow watcher", jobRun.JobName, jobRun.ScheduledAt)
+		}
+	}
+}
+
+// Watch calls onChange with the state changes of the job runs matching the filter, until the context is done
+// or onChange returns an error
+func (w *JobRunWatcher) Watch(ctx context.Context, filter *scheduler.JobRunWatchFilter, onChange func(*scheduler.JobRun) error) error {
+	subscriber := make(chan *scheduler.JobRun, jobRunWatchBufferSize)
+	w.mu.Lock()
+	w.subscribers[subscriber] = struct{}{}
+	w.mu.Unlock()
+	defer func() {
+		w.mu.Lock()
+		delete(w.subscribers, subscriber)
+		w.mu.Unlock()
+	}()
+
+	jobLabelsMatch := map[scheduler.JobName]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case jobRun := <-subscriber:
+			if !filter.MatchJobRun(jobRun) {
+				continue
+			}
+			if filter.HasLabels() {
+				match, ok := jobLabelsMatch[jobRun.JobName]
+				if !ok {
+					job, err := w.jobRepo.GetJobDetails(ctx, jobRun.Tenant.ProjectName(), jobRun.JobName)
+					if err != nil {
+						w.l.Error("error getting details of job [%s] to match labels: %s", jobRun.JobName, err)
+						continue
+					}
+					var labels map[string]string
+					if job.JobMetadata != nil {
+						labels = job.JobMetadata.Labels
+					}
+					match = filter.MatchLabels(labels)
+					jobLabelsMatch[jobRun.JobName] = match
+				}
+				if !match {
+					continue
+				}
+			}
+			if err := onChange(jobRun); err != nil {
+				return err
+			}
+		}
+	}
+}
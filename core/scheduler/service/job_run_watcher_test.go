@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/event"
+	"github.com/raystack/optimus/core/event/moderator"
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestJobRunWatcher(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("proj", "ns")
+	otherTnnt, _ := tenant.NewTenant("proj", "other-ns")
+	scheduledAt := time.Date(2023, 1, 2, 2, 0, 0, 0, time.UTC)
+
+	jobRun := &scheduler.JobRun{
+		ID:          uuid.New(),
+		JobName:     "job1",
+		Tenant:      tnnt,
+		State:       scheduler.StateSuccess,
+		ScheduledAt: scheduledAt,
+	}
+	successEvent, _ := event.NewJobRunSuccessEvent(jobRun)
+
+	// publishUntilDone keeps raising the events, as the watcher only receives the changes after it is subscribed
+	publishUntilDone := func(watcher *service.JobRunWatcher, done <-chan struct{}, events ...moderator.Event) {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(time.Millisecond * 10):
+				for _, e := range events {
+					watcher.HandleEvent(e)
+				}
+			}
+		}
+	}
+	errStopWatch := fmt.Errorf("stop watch")
+
+	t.Run("HandleEvent", func(t *testing.T) {
+		t.Run("passes the event on to the next handler", func(t *testing.T) {
+			eventHandler := newEventHandler(t)
+			eventHandler.On("HandleEvent", successEvent).Once()
+
+			watcher := service.NewJobRunWatcher(logger, nil, eventHandler)
+			watcher.HandleEvent(successEvent)
+		})
+	})
+	t.Run("Watch", func(t *testing.T) {
+		t.Run("returns when the context is done", func(t *testing.T) {
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			filter, _ := scheduler.NewJobRunWatchFilter("proj", "", nil, nil)
+
+			watcher := service.NewJobRunWatcher(logger, nil, moderator.NoOpHandler{})
+			err := watcher.Watch(cancelledCtx, filter, func(*scheduler.JobRun) error {
+				return errStopWatch
+			})
+			assert.Nil(t, err)
+		})
+		t.Run("calls on change with the state changes of the matching job runs", func(t *testing.T) {
+			otherJobRun := &scheduler.JobRun{JobName: "job1", Tenant: otherTnnt, State: scheduler.StateFailed}
+			otherEvent, _ := event.NewJobRunFailedEvent(otherJobRun)
+			filter, _ := scheduler.NewJobRunWatchFilter("proj", "ns", []string{"job1"}, nil)
+
+			watcher := service.NewJobRunWatcher(logger, nil, moderator.NoOpHandler{})
+			done := make(chan struct{})
+			defer close(done)
+			go publishUntilDone(watcher, done, otherEvent, successEvent)
+
+			var changed *scheduler.JobRun
+			err := watcher.Watch(ctx, filter, func(run *scheduler.JobRun) error {
+				changed = run
+				return errStopWatch
+			})
+			assert.ErrorIs(t, err, errStopWatch)
+			assert.Equal(t, jobRun, changed)
+		})
+		t.Run("matches the labels of the job of the runs", func(t *testing.T) {
+			unlabelledJobRun := &scheduler.JobRun{JobName: "job2", Tenant: tnnt, State: scheduler.StateSuccess}
+			unlabelledEvent, _ := event.NewJobRunSuccessEvent(unlabelledJobRun)
+			filter, _ := scheduler.NewJobRunWatchFilter("proj", "", nil, map[string]string{"team": "data"})
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, tnnt.ProjectName(), scheduler.JobName("job1")).Return(&scheduler.JobWithDetails{
+				Name:        "job1",
+				JobMetadata: &scheduler.JobMetadata{Labels: map[string]string{"team": "data"}},
+			}, nil).Once()
+			jobRepo.On("GetJobDetails", ctx, tnnt.ProjectName(), scheduler.JobName("job2")).Return(&scheduler.JobWithDetails{
+				Name: "job2",
+			}, nil).Maybe()
+			defer jobRepo.AssertExpectations(t)
+
+			watcher := service.NewJobRunWatcher(logger, jobRepo, moderator.NoOpHandler{})
+			done := make(chan struct{})
+			defer close(done)
+			go publishUntilDone(watcher, done, unlabelledEvent, successEvent)
+
+			var changed *scheduler.JobRun
+			err := watcher.Watch(ctx, filter, func(run *scheduler.JobRun) error {
+				changed = run
+				return errStopWatch
+			})
+			assert.ErrorIs(t, err, errStopWatch)
+			assert.Equal(t, jobRun, changed)
+		})
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/raystack/salt/log"
 
@@ -24,9 +25,15 @@ type Notifier interface {
 	Notify(ctx context.Context, attr scheduler.NotifyAttrs) error
 }
 
+type JobRunDurationRepository interface {
+	GetByScheduledAt(ctx context.Context, tenant tenant.Tenant, name scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRun, error)
+	GetRecentDurations(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, before time.Time, limit int) ([]time.Duration, error)
+}
+
 type NotifyService struct {
 	notifyChannels map[string]Notifier
 	jobRepo        JobRepository
+	jobRunRepo     JobRunDurationRepository
 	tenantService  TenantService
 	l              log.Logger
 }
@@ -88,9 +95,62 @@ func (n *NotifyService) Push(ctx context.Context, event *scheduler.Event) error
 			}).Inc()
 		}
 	}
+
+	// the duration anomaly is advisory, failing to check or notify it does not fail the event
+	if err := n.pushDurationAnomaly(ctx, jobDetails, event); err != nil {
+		n.l.Warn("error notifying duration anomaly for job [%s] scheduled at [%s]: %s", event.JobName, event.JobScheduledAt, err)
+	}
 	return multierror.ToErr()
 }
 
+// pushDurationAnomaly compares the duration of a finished job run with the baseline of its past runs,
+// and pushes a duration anomaly event when the run takes unusually long or short
+func (n *NotifyService) pushDurationAnomaly(ctx context.Context, jobDetails *scheduler.JobWithDetails, event *scheduler.Event) error {
+	if event.Type != scheduler.JobSuccessEvent && event.Type != scheduler.JobFailureEvent {
+		return nil
+	}
+
+	var anomalyAlert *scheduler.Alert
+	for i := range jobDetails.Alerts {
+		if jobDetails.Alerts[i].On == scheduler.EventCategoryDurationAnomaly {
+			anomalyAlert = &jobDetails.Alerts[i]
+			break
+		}
+	}
+	if anomalyAlert == nil {
+		return nil
+	}
+
+	anomalyConfig, err := scheduler.DurationAnomalyConfigFrom(anomalyAlert.Config)
+	if err != nil {
+		n.l.Error("invalid duration anomaly config for job [%s]: %s", event.JobName, err)
+		return err
+	}
+
+	jobRun, err := n.jobRunRepo.GetByScheduledAt(ctx, event.Tenant, event.JobName, event.JobScheduledAt)
+	if err != nil {
+		n.l.Error("error getting job run of job [%s] scheduled at [%s]: %s", event.JobName, event.JobScheduledAt, err)
+		return err
+	}
+
+	durations, err := n.jobRunRepo.GetRecentDurations(ctx, event.Tenant, event.JobName, event.JobScheduledAt, anomalyConfig.HistorySize())
+	if err != nil {
+		n.l.Error("error getting recent durations of job [%s]: %s", event.JobName, err)
+		return err
+	}
+	if len(durations) < anomalyConfig.MinHistory {
+		n.l.Debug("not enough history to detect duration anomaly for job [%s], found %d runs", event.JobName, len(durations))
+		return nil
+	}
+
+	duration := event.EventTime.Sub(jobRun.StartTime)
+	baseline := scheduler.NewDurationBaseline(durations, anomalyConfig.Sensitivity)
+	if !baseline.IsAnomaly(duration) {
+		return nil
+	}
+	return n.Push(ctx, scheduler.NewDurationAnomalyEvent(event, duration, baseline))
+}
+
 func (n *NotifyService) Close() error {
 	me := errors.NewMultiError("ErrorsInNotifyClose")
 	for _, notify := range n.notifyChannels {
@@ -102,10 +162,11 @@ func (n *NotifyService) Close() error {
 	return me.ToErr()
 }
 
-func NewNotifyService(l log.Logger, jobRepo JobRepository, tenantService TenantService, notifyChan map[string]Notifier) *NotifyService {
+func NewNotifyService(l log.Logger, jobRepo JobRepository, jobRunRepo JobRunDurationRepository, tenantService TenantService, notifyChan map[string]Notifier) *NotifyService {
 	return &NotifyService{
 		l:              l,
 		jobRepo:        jobRepo,
+		jobRunRepo:     jobRunRepo,
 		tenantService:  tenantService,
 		notifyChannels: notifyChan,
 	}
@@ -33,7 +33,7 @@ func TestNotificationService(t *testing.T) {
 			jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(nil, fmt.Errorf("some error"))
 			defer jobRepo.AssertExpectations(t)
 
-			notifyService := service.NewNotifyService(logger, jobRepo, nil, nil)
+			notifyService := service.NewNotifyService(logger, jobRepo, nil, nil, nil)
 
 			event := &scheduler.Event{
 				JobName: jobName,
@@ -102,7 +102,7 @@ func TestNotificationService(t *testing.T) {
 				"pagerduty": notifyChanelPager,
 			}
 
-			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, notifierChannels)
+			notifyService := service.NewNotifyService(logger, jobRepo, nil, tenantService, notifierChannels)
 
 			err := notifyService.Push(ctx, event)
 			assert.Nil(t, err)
@@ -164,7 +164,7 @@ func TestNotificationService(t *testing.T) {
 				"pagerduty": notifyChanelPager,
 			}
 
-			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, notifierChannels)
+			notifyService := service.NewNotifyService(logger, jobRepo, nil, tenantService, notifierChannels)
 
 			err := notifyService.Push(ctx, event)
 			assert.Nil(t, err)
@@ -226,13 +226,228 @@ func TestNotificationService(t *testing.T) {
 				"pagerduty": notifyChanelPager,
 			}
 
-			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, notifierChannels)
+			notifyService := service.NewNotifyService(logger, jobRepo, nil, tenantService, notifierChannels)
 
 			err := notifyService.Push(ctx, event)
 
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "ErrorsInNotifypush:\n notifyChannel.Notify: pagerduty://#chanel-name: error in pagerduty push")
 		})
+		t.Run("duration anomaly", func(t *testing.T) {
+			scheduledAt := startDate.Add(time.Hour * 10)
+			jobWithDetails := scheduler.JobWithDetails{
+				Job: &scheduler.Job{
+					Name:   jobName,
+					Tenant: tnnt,
+				},
+				JobMetadata: &scheduler.JobMetadata{
+					Version: 1,
+					Owner:   "jobOwnerName",
+				},
+				Alerts: []scheduler.Alert{
+					{
+						On:       scheduler.EventCategoryDurationAnomaly,
+						Channels: []string{"slack://#chanel-name"},
+						Config:   map[string]string{"min_history": "3"},
+					},
+				},
+			}
+			jobRun := &scheduler.JobRun{
+				JobName:     jobName,
+				Tenant:      tnnt,
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt,
+			}
+			pastDurations := []time.Duration{time.Minute * 10, time.Minute * 11, time.Minute * 9}
+
+			t.Run("should not check the duration of events other than job run finished", func(t *testing.T) {
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, nil, nil, nil)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.TaskSuccessEvent,
+					JobScheduledAt: scheduledAt,
+				}
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should not return error when duration anomaly config is invalid", func(t *testing.T) {
+				invalidJobWithDetails := jobWithDetails
+				invalidJobWithDetails.Alerts = []scheduler.Alert{
+					{
+						On:       scheduler.EventCategoryDurationAnomaly,
+						Channels: []string{"slack://#chanel-name"},
+						Config:   map[string]string{"sensitivity": "high"},
+					},
+				}
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&invalidJobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, nil, nil, nil)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      scheduledAt.Add(time.Minute * 10),
+					JobScheduledAt: scheduledAt,
+				}
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should not return error when unable to get recent durations", func(t *testing.T) {
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+				jobRunRepo.On("GetRecentDurations", ctx, tnnt, jobName, scheduledAt, scheduler.DurationAnomalyHistorySize).
+					Return(nil, fmt.Errorf("some error"))
+				defer jobRunRepo.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, jobRunRepo, nil, nil)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      scheduledAt.Add(time.Hour),
+					JobScheduledAt: scheduledAt,
+				}
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should not notify when there is not enough history", func(t *testing.T) {
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+				jobRunRepo.On("GetRecentDurations", ctx, tnnt, jobName, scheduledAt, scheduler.DurationAnomalyHistorySize).
+					Return(pastDurations[:2], nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, jobRunRepo, nil, nil)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      scheduledAt.Add(time.Hour),
+					JobScheduledAt: scheduledAt,
+				}
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should not notify when the duration is within the baseline", func(t *testing.T) {
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+				jobRunRepo.On("GetRecentDurations", ctx, tnnt, jobName, scheduledAt, scheduler.DurationAnomalyHistorySize).
+					Return(pastDurations, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, jobRunRepo, nil, nil)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      scheduledAt.Add(time.Minute * 10),
+					JobScheduledAt: scheduledAt,
+				}
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should notify duration anomaly when the duration is outside the baseline", func(t *testing.T) {
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+				jobRunRepo.On("GetRecentDurations", ctx, tnnt, jobName, scheduledAt, scheduler.DurationAnomalyHistorySize).
+					Return(pastDurations, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				plainSecret, _ := tenant.NewPlainTextSecret("NOTIFY_SLACK", "secretValue")
+				tenantService := new(mockTenantService)
+				tenantService.On("GetSecrets", ctx, tnnt).Return([]*tenant.PlainTextSecret{plainSecret}, nil)
+				defer tenantService.AssertExpectations(t)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      scheduledAt.Add(time.Hour),
+					Status:         scheduler.StateSuccess,
+					JobScheduledAt: scheduledAt,
+				}
+				anomalyEvent := scheduler.NewDurationAnomalyEvent(event, time.Hour, scheduler.NewDurationBaseline(pastDurations, scheduler.DefaultDurationAnomalySensitivity))
+
+				notifyChanelSlack := new(mockNotificationChanel)
+				notifyChanelSlack.On("Notify", ctx, scheduler.NotifyAttrs{
+					Owner:    "jobOwnerName",
+					JobEvent: anomalyEvent,
+					Route:    "#chanel-name",
+					Secret:   "secretValue",
+				}).Return(nil)
+				defer notifyChanelSlack.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, jobRunRepo, tenantService, map[string]service.Notifier{
+					"slack": notifyChanelSlack,
+				})
+
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should not return error when unable to notify the duration anomaly", func(t *testing.T) {
+				jobRepo := new(JobRepository)
+				jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+				defer jobRepo.AssertExpectations(t)
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+				jobRunRepo.On("GetRecentDurations", ctx, tnnt, jobName, scheduledAt, scheduler.DurationAnomalyHistorySize).
+					Return(pastDurations, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				plainSecret, _ := tenant.NewPlainTextSecret("NOTIFY_SLACK", "secretValue")
+				tenantService := new(mockTenantService)
+				tenantService.On("GetSecrets", ctx, tnnt).Return([]*tenant.PlainTextSecret{plainSecret}, nil)
+				defer tenantService.AssertExpectations(t)
+
+				notifyChanelSlack := new(mockNotificationChanel)
+				notifyChanelSlack.On("Notify", ctx, mock.Anything).Return(fmt.Errorf("some error"))
+				defer notifyChanelSlack.AssertExpectations(t)
+
+				notifyService := service.NewNotifyService(logger, jobRepo, jobRunRepo, tenantService, map[string]service.Notifier{
+					"slack": notifyChanelSlack,
+				})
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      scheduledAt.Add(time.Hour),
+					Status:         scheduler.StateSuccess,
+					JobScheduledAt: scheduledAt,
+				}
+				err := notifyService.Push(ctx, event)
+				assert.Nil(t, err)
+			})
+		})
 	})
 }
 
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/robfig/cron/v3"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/telemetry"
+)
+
+const (
+	metricJobDrift = "scheduler_job_drift"
+)
+
+type ProjectGetter interface {
+	GetAll(ctx context.Context) ([]*tenant.Project, error)
+}
+
+type Reconciler interface {
+	Reconcile(ctx context.Context, projectName tenant.ProjectName, namespaceName string, fix bool) ([]*scheduler.JobDrift, error)
+}
+
+// ReconcileManager periodically reports the jobs drifted from the scheduler as metrics, without fixing them
+type ReconcileManager struct {
+	l log.Logger
+
+	projectGetter ProjectGetter
+	reconciler    Reconciler
+
+	schedule *cron.Cron
+	interval time.Duration
+
+	// tenants having drift in the last report, their metrics are reset once the drift is gone
+	driftedTenants map[tenant.Tenant]struct{}
+}
+
+func NewReconcileManager(l log.Logger, projectGetter ProjectGetter, reconciler Reconciler, interval time.Duration) *ReconcileManager {
+	return &ReconcileManager{
+		l:              l,
+		projectGetter:  projectGetter,
+		reconciler:     reconciler,
+		interval:       interval,
+		driftedTenants: map[tenant.Tenant]struct{}{},
+		schedule: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
+
+func (m *ReconcileManager) Initialize() {
+	if m.interval <= 0 {
+		return
+	}
+	m.schedule.Schedule(cron.Every(m.interval), cron.FuncJob(m.ReportDrifts))
+	m.schedule.Start()
+}
+
+func (m *ReconcileManager) Close() {
+	if m.interval > 0 {
+		m.schedule.Stop()
+	}
+}
+
+func (m *ReconcileManager) ReportDrifts() {
+	ctx := context.Background()
+
+	projects, err := m.projectGetter.GetAll(ctx)
+	if err != nil {
+		m.l.Error("error getting projects to report drift: %s", err)
+		return
+	}
+
+	driftCounts := map[tenant.Tenant]map[scheduler.DriftType]int{}
+	for t := range m.driftedTenants {
+		driftCounts[t] = map[scheduler.DriftType]int{}
+	}
+	for _, project := range projects {
+		drifts, err := m.reconciler.Reconcile(ctx, project.Name(), "", false)
+		if err != nil {
+			m.l.Error("error reconciling jobs of project [%s]: %s", project.Name(), err)
+		}
+		for _, drift := range drifts {
+			if _, ok := driftCounts[drift.Tenant]; !ok {
+				driftCounts[drift.Tenant] = map[scheduler.DriftType]int{}
+			}
+			driftCounts[drift.Tenant][drift.Type]++
+		}
+	}
+
+	m.driftedTenants = map[tenant.Tenant]struct{}{}
+	for t, counts := range driftCounts {
+		for _, driftType := range []scheduler.DriftType{scheduler.DriftOrphaned, scheduler.DriftMissing, scheduler.DriftStale} {
+			telemetry.NewGauge(metricJobDrift, map[string]string{
+				"project":   t.ProjectName().String(),
+				"namespace": t.NamespaceName().String(),
+				"type":      driftType.String(),
+			}).Set(float64(counts[driftType]))
+		}
+		if len(counts) > 0 {
+			m.driftedTenants[t] = struct{}{}
+		}
+	}
+}
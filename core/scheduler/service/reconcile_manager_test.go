@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/telemetry"
+)
+
+func TestReconcileManager(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+
+	project1, _ := tenant.NewProject("drift-proj1", map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	project2, _ := tenant.NewProject("drift-proj2", map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	tnnt1, _ := tenant.NewTenant(project1.Name().String(), "ns1")
+	tnnt2, _ := tenant.NewTenant(project2.Name().String(), "ns1")
+
+	driftGauge := func(t tenant.Tenant, driftType scheduler.DriftType) float64 {
+		return testutil.ToFloat64(telemetry.NewGauge("scheduler_job_drift", map[string]string{
+			"project":   t.ProjectName().String(),
+			"namespace": t.NamespaceName().String(),
+			"type":      driftType.String(),
+		}))
+	}
+
+	t.Run("ReportDrifts", func(t *testing.T) {
+		t.Run("does not report when unable to get projects", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return(nil, fmt.Errorf("some error"))
+			defer projectGetter.AssertExpectations(t)
+
+			reconciler := new(mockReconciler)
+			defer reconciler.AssertExpectations(t)
+
+			manager := service.NewReconcileManager(logger, projectGetter, reconciler, 0)
+			manager.ReportDrifts()
+		})
+		t.Run("reports drift of every project and resets it once the drift is gone", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project1, project2}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			reconciler := new(mockReconciler)
+			reconciler.On("Reconcile", ctx, project1.Name(), "", false).Return([]*scheduler.JobDrift{
+				{JobName: "job-1", Tenant: tnnt1, Type: scheduler.DriftOrphaned},
+				{JobName: "job-2", Tenant: tnnt1, Type: scheduler.DriftOrphaned},
+				{JobName: "job-3", Tenant: tnnt1, Type: scheduler.DriftStale},
+			}, nil).Once()
+			reconciler.On("Reconcile", ctx, project2.Name(), "", false).Return(nil, fmt.Errorf("some error")).Once()
+			defer reconciler.AssertExpectations(t)
+
+			manager := service.NewReconcileManager(logger, projectGetter, reconciler, 0)
+			manager.ReportDrifts()
+
+			assert.Equal(t, float64(2), driftGauge(tnnt1, scheduler.DriftOrphaned))
+			assert.Equal(t, float64(1), driftGauge(tnnt1, scheduler.DriftStale))
+			assert.Equal(t, float64(0), driftGauge(tnnt1, scheduler.DriftMissing))
+
+			reconciler.On("Reconcile", ctx, project1.Name(), "", false).Return(nil, nil).Once()
+			reconciler.On("Reconcile", ctx, project2.Name(), "", false).Return([]*scheduler.JobDrift{
+				{JobName: "job-4", Tenant: tnnt2, Type: scheduler.DriftMissing},
+			}, nil).Once()
+			manager.ReportDrifts()
+
+			assert.Equal(t, float64(0), driftGauge(tnnt1, scheduler.DriftOrphaned))
+			assert.Equal(t, float64(0), driftGauge(tnnt1, scheduler.DriftStale))
+			assert.Equal(t, float64(1), driftGauge(tnnt2, scheduler.DriftMissing))
+		})
+	})
+}
+
+type mockProjectGetter struct {
+	mock.Mock
+}
+
+func (m *mockProjectGetter) GetAll(ctx context.Context) ([]*tenant.Project, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.Project), args.Error(1)
+}
+
+type mockReconciler struct {
+	mock.Mock
+}
+
+func (m *mockReconciler) Reconcile(ctx context.Context, projectName tenant.ProjectName, namespaceName string, fix bool) ([]*scheduler.JobDrift, error) {
+	args := m.Called(ctx, projectName, namespaceName, fix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobDrift), args.Error(1)
+}
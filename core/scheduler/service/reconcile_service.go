@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+
+	"github.com/raystack/salt/log"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+type NamespaceGetter interface {
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.Namespace, error)
+}
+
+type ReconcileScheduler interface {
+	ListDeployedJobs(ctx context.Context, t tenant.Tenant) ([]*scheduler.DeployedJob, error)
+	Checksum(job *scheduler.JobWithDetails) (string, error)
+	DeployJobs(ctx context.Context, t tenant.Tenant, jobs []*scheduler.JobWithDetails) error
+	DeleteJobs(ctx context.Context, t tenant.Tenant, jobsToDelete []string) error
+}
+
+type ReconcileService struct {
+	l                log.Logger
+	jobRepo          JobRepository
+	namespaceGetter  NamespaceGetter
+	scheduler        ReconcileScheduler
+	priorityResolver PriorityResolver
+}
+
+// Reconcile compares the jobs deployed on the scheduler under every namespace directory of the project with the stored
+// jobs, the jobs deployed under the directory of a deleted or renamed namespace are orphaned. When fix is set the orphaned
+// jobs are deleted and the missing and stale jobs are deployed again
+func (s *ReconcileService) Reconcile(ctx context.Context, projectName tenant.ProjectName, namespaceName string, fix bool) ([]*scheduler.JobDrift, error) {
+	namespaces, err := s.namespaceGetter.GetAll(ctx, projectName)
+	if err != nil {
+		s.l.Error("error getting namespaces of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	allJobs, err := s.jobRepo.GetAll(ctx, projectName)
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		// reconciling with partial jobs would report the unread jobs as orphaned
+		s.l.Error("error getting jobs of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+	jobsByTenant := scheduler.GroupJobsByTenant(allJobs)
+
+	// the jobs are compiled as for the deployment, the priority is left out of the comparison but the
+	// missing and stale jobs are deployed with it
+	if len(allJobs) > 0 {
+		if err := s.priorityResolver.Resolve(ctx, allJobs); err != nil {
+			s.l.Error("error resolving priority: %s", err)
+			return nil, err
+		}
+	}
+
+	// the directories of all the namespaces are under the same storage of the project, listed with a namespace of it
+	listingTenant, err := tenant.NewTenant(projectName.String(), namespaces[0].Name().String())
+	if err != nil {
+		return nil, err
+	}
+	deployedJobs, err := s.scheduler.ListDeployedJobs(ctx, listingTenant)
+	if err != nil {
+		s.l.Error("error listing deployed jobs under project [%s]: %s", projectName, err)
+		return nil, err
+	}
+
+	var tenants []tenant.Tenant
+	deployedJobsByTenant := map[tenant.Tenant][]*scheduler.DeployedJob{}
+	addTenant := func(t tenant.Tenant) {
+		if _, ok := deployedJobsByTenant[t]; !ok {
+			deployedJobsByTenant[t] = nil
+			tenants = append(tenants, t)
+		}
+	}
+	for _, namespace := range namespaces {
+		t, err := tenant.NewTenant(projectName.String(), namespace.Name().String())
+		if err != nil {
+			return nil, err
+		}
+		addTenant(t)
+	}
+	for _, deployedJob := range deployedJobs {
+		addTenant(deployedJob.Tenant)
+		deployedJobsByTenant[deployedJob.Tenant] = append(deployedJobsByTenant[deployedJob.Tenant], deployedJob)
+	}
+
+	me := errors.NewMultiError("errorInReconcile")
+	var drifts []*scheduler.JobDrift
+	for _, t := range tenants {
+		if namespaceName != "" && t.NamespaceName().String() != namespaceName {
+			continue
+		}
+		jobs := jobsByTenant[t]
+		checksums := make(map[scheduler.JobName]string, len(jobs))
+		for _, job := range jobs {
+			checksum, err := s.scheduler.Checksum(job)
+			if err != nil {
+				s.l.Error("error compiling job [%s] under project [%s] namespace [%s]: %s", job.Name, projectName, t.NamespaceName(), err)
+				me.Append(err)
+				continue
+			}
+			checksums[job.Name] = checksum
+		}
+		drifts = append(drifts, scheduler.DetectDrifts(t, jobs, checksums, deployedJobsByTenant[t])...)
+	}
+
+	if fix && len(drifts) > 0 {
+		me.Append(s.fixDrifts(ctx, allJobs, drifts))
+	}
+	return drifts, me.ToErr()
+}
+
+func (s *ReconcileService) fixDrifts(ctx context.Context, allJobs []*scheduler.JobWithDetails, drifts []*scheduler.JobDrift) error {
+	jobsByName := make(map[scheduler.JobName]*scheduler.JobWithDetails, len(allJobs))
+	for _, job := range allJobs {
+		jobsByName[job.Name] = job
+	}
+
+	var tenants []tenant.Tenant
+	tenantsWithDrift := map[tenant.Tenant]struct{}{}
+	jobsToDeploy := map[tenant.Tenant][]*scheduler.JobWithDetails{}
+	jobsToDelete := map[tenant.Tenant][]string{}
+	for _, drift := range drifts {
+		if _, ok := tenantsWithDrift[drift.Tenant]; !ok {
+			tenantsWithDrift[drift.Tenant] = struct{}{}
+			tenants = append(tenants, drift.Tenant)
+		}
+		if drift.Type == scheduler.DriftOrphaned {
+			jobsToDelete[drift.Tenant] = append(jobsToDelete[drift.Tenant], drift.JobName.String())
+			continue
+		}
+		jobsToDeploy[drift.Tenant] = append(jobsToDeploy[drift.Tenant], jobsByName[drift.JobName])
+	}
+
+	me := errors.NewMultiError("errorInFixDrifts")
+	for _, t := range tenants {
+		if jobs := jobsToDeploy[t]; len(jobs) > 0 {
+			if err := s.scheduler.DeployJobs(ctx, t, jobs); err != nil {
+				s.l.Error("error deploying jobs under project [%s] namespace [%s]: %s", t.ProjectName(), t.NamespaceName(), err)
+				me.Append(err)
+			} else {
+				s.l.Info("[success] namespace: %s, project: %s, deployed %d missing or stale jobs", t.NamespaceName(), t.ProjectName(), len(jobs))
+			}
+		}
+		if jobNames := jobsToDelete[t]; len(jobNames) > 0 {
+			if err := s.scheduler.DeleteJobs(ctx, t, jobNames); err != nil {
+				s.l.Error("error deleting jobs under project [%s] namespace [%s]: %s", t.ProjectName(), t.NamespaceName(), err)
+				me.Append(err)
+			} else {
+				s.l.Info("[success] namespace: %s, project: %s, deleted %d orphaned jobs", t.NamespaceName(), t.ProjectName(), len(jobNames))
+			}
+		}
+	}
+	return me.ToErr()
+}
+
+func NewReconcileService(l log.Logger, jobRepo JobRepository, namespaceGetter NamespaceGetter, scheduler ReconcileScheduler, resolver PriorityResolver) *ReconcileService {
+	return &ReconcileService{
+		l:                l,
+		jobRepo:          jobRepo,
+		namespaceGetter:  namespaceGetter,
+		scheduler:        scheduler,
+		priorityResolver: resolver,
+	}
+}
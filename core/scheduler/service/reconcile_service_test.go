@@ -0,0 +1,311 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+func TestReconcileService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	proj1Name := tenant.ProjectName("proj1")
+
+	project, _ := tenant.NewProject(proj1Name.String(), map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	namespace1, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{})
+	namespace2, _ := tenant.NewNamespace("ns2", project.Name(), map[string]string{})
+	namespaces := []*tenant.Namespace{namespace1, namespace2}
+	tnnt1, _ := tenant.NewTenant(proj1Name.String(), "ns1")
+	tnnt2, _ := tenant.NewTenant(proj1Name.String(), "ns2")
+	deletedTnnt, _ := tenant.NewTenant(proj1Name.String(), "deleted-ns")
+
+	jobInNs1 := &scheduler.JobWithDetails{Name: "job-1", Job: &scheduler.Job{Name: "job-1", Tenant: tnnt1}}
+	jobMovedToNs2 := &scheduler.JobWithDetails{Name: "job-2", Job: &scheduler.Job{Name: "job-2", Tenant: tnnt2}}
+	allJobs := []*scheduler.JobWithDetails{jobInNs1, jobMovedToNs2}
+
+	t.Run("Reconcile", func(t *testing.T) {
+		t.Run("returns error when unable to get namespaces", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(nil, fmt.Errorf("some error"))
+			defer namespaceGetter.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, nil, namespaceGetter, nil, nil)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, drifts)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("returns no drift when the project has no namespace", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(nil, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, nil, namespaceGetter, nil, nil)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, err)
+			assert.Empty(t, drifts)
+		})
+		t.Run("returns error when unable to get jobs", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return([]*scheduler.JobWithDetails{jobInNs1}, fmt.Errorf("some error"))
+			defer jobRepo.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, nil, nil)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, drifts)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("returns error when unable to resolve the priority of the jobs", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(fmt.Errorf("some error"))
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, nil, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, drifts)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("returns error when unable to list the deployed jobs", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt1).Return(nil, fmt.Errorf("some error"))
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, drifts)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("reports all deployed jobs as orphaned when the project has no job", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return([]*tenant.Namespace{namespace1}, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(nil, errors.NotFound(scheduler.EntityJobRun, "unable to find jobs in project:proj1"))
+			defer jobRepo.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt1).Return([]*scheduler.DeployedJob{{Name: "job-1", Tenant: tnnt1, Checksum: "checksum-1"}}, nil)
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, nil)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobDrift{{JobName: "job-1", Tenant: tnnt1, Type: scheduler.DriftOrphaned}}, drifts)
+		})
+		t.Run("reports the jobs deployed under the directory of a deleted namespace as orphaned", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt1).Return([]*scheduler.DeployedJob{
+				{Name: "job-1", Tenant: tnnt1, Checksum: "checksum-1"},
+				{Name: "job-2", Tenant: tnnt2, Checksum: "checksum-2"},
+				{Name: "job-3", Tenant: deletedTnnt, Checksum: "checksum-3"},
+			}, nil)
+			reconcileScheduler.On("Checksum", jobInNs1).Return("checksum-1", nil)
+			reconcileScheduler.On("Checksum", jobMovedToNs2).Return("checksum-2", nil)
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobDrift{{JobName: "job-3", Tenant: deletedTnnt, Type: scheduler.DriftOrphaned}}, drifts)
+		})
+		t.Run("reports drifts of the jobs it is able to compile, returning error", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt1).Return([]*scheduler.DeployedJob{
+				{Name: "job-1", Tenant: tnnt1, Checksum: "checksum-1"},
+				{Name: "job-2", Tenant: tnnt2, Checksum: "checksum-2"},
+			}, nil)
+			reconcileScheduler.On("Checksum", jobInNs1).Return("", fmt.Errorf("some error"))
+			reconcileScheduler.On("Checksum", jobMovedToNs2).Return("checksum-2-updated", nil)
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", false)
+			assert.ErrorContains(t, err, "some error")
+			assert.Equal(t, []*scheduler.JobDrift{{JobName: "job-2", Tenant: tnnt2, Type: scheduler.DriftStale}}, drifts)
+		})
+		t.Run("reports drifts only of the given namespace", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt1).Return([]*scheduler.DeployedJob{
+				{Name: "job-3", Tenant: tnnt1, Checksum: "checksum-3"},
+				{Name: "job-2", Tenant: tnnt2, Checksum: "checksum-2"},
+			}, nil)
+			reconcileScheduler.On("Checksum", jobMovedToNs2).Return("checksum-2-updated", nil)
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "ns2", false)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobDrift{{JobName: "job-2", Tenant: tnnt2, Type: scheduler.DriftStale}}, drifts)
+		})
+		t.Run("fixes the drifts when asked", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return(namespaces, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt1).Return([]*scheduler.DeployedJob{
+				{Name: "job-1", Tenant: tnnt1, Checksum: "checksum-1"},
+				{Name: "job-2", Tenant: tnnt1, Checksum: "checksum-2"},
+				{Name: "job-3", Tenant: deletedTnnt, Checksum: "checksum-3"},
+			}, nil)
+			reconcileScheduler.On("Checksum", jobInNs1).Return("checksum-1", nil)
+			reconcileScheduler.On("Checksum", jobMovedToNs2).Return("checksum-2", nil)
+			reconcileScheduler.On("DeleteJobs", ctx, tnnt1, []string{"job-2"}).Return(nil)
+			reconcileScheduler.On("DeployJobs", ctx, tnnt2, []*scheduler.JobWithDetails{jobMovedToNs2}).Return(nil)
+			reconcileScheduler.On("DeleteJobs", ctx, deletedTnnt, []string{"job-3"}).Return(nil)
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", true)
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.JobDrift{
+				{JobName: "job-2", Tenant: tnnt1, Type: scheduler.DriftOrphaned},
+				{JobName: "job-2", Tenant: tnnt2, Type: scheduler.DriftMissing},
+				{JobName: "job-3", Tenant: deletedTnnt, Type: scheduler.DriftOrphaned},
+			}, drifts)
+		})
+		t.Run("returns error when unable to fix the drifts", func(t *testing.T) {
+			namespaceGetter := new(mockNamespaceGetter)
+			namespaceGetter.On("GetAll", ctx, proj1Name).Return([]*tenant.Namespace{namespace2}, nil)
+			defer namespaceGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, proj1Name).Return(allJobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", ctx, allJobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			reconcileScheduler := new(mockReconcileScheduler)
+			reconcileScheduler.On("ListDeployedJobs", ctx, tnnt2).Return(nil, nil)
+			reconcileScheduler.On("Checksum", jobMovedToNs2).Return("checksum-2", nil)
+			reconcileScheduler.On("DeployJobs", ctx, tnnt2, []*scheduler.JobWithDetails{jobMovedToNs2}).Return(fmt.Errorf("some error"))
+			defer reconcileScheduler.AssertExpectations(t)
+
+			reconcileService := service.NewReconcileService(logger, jobRepo, namespaceGetter, reconcileScheduler, priorityResolver)
+			drifts, err := reconcileService.Reconcile(ctx, proj1Name, "", true)
+			assert.ErrorContains(t, err, "some error")
+			assert.Len(t, drifts, 1)
+		})
+	})
+}
+
+type mockNamespaceGetter struct {
+	mock.Mock
+}
+
+func (m *mockNamespaceGetter) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.Namespace, error) {
+	args := m.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.Namespace), args.Error(1)
+}
+
+type mockReconcileScheduler struct {
+	mock.Mock
+}
+
+func (m *mockReconcileScheduler) ListDeployedJobs(ctx context.Context, t tenant.Tenant) ([]*scheduler.DeployedJob, error) {
+	args := m.Called(ctx, t)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.DeployedJob), args.Error(1)
+}
+
+func (m *mockReconcileScheduler) Checksum(job *scheduler.JobWithDetails) (string, error) {
+	args := m.Called(job)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockReconcileScheduler) DeployJobs(ctx context.Context, t tenant.Tenant, jobs []*scheduler.JobWithDetails) error {
+	args := m.Called(ctx, t, jobs)
+	return args.Error(0)
+}
+
+func (m *mockReconcileScheduler) DeleteJobs(ctx context.Context, t tenant.Tenant, jobsToDelete []string) error {
+	args := m.Called(ctx, t, jobsToDelete)
+	return args.Error(0)
+}
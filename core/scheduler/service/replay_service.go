@@ -69,7 +69,7 @@ func (r ReplayService) CreateReplay(ctx context.Context, tenant tenant.Tenant, j
 		return uuid.Nil, err
 	}
 
-	runs := getExpectedRuns(jobCron, config.StartTime, config.EndTime)
+	runs := getExpectedRuns(jobCron, config.StartTime, config.EndTime, subjectJob.Job.Window)
 	replayID, err = r.replayRepo.RegisterReplay(ctx, replayReq, runs)
 	if err != nil {
 		return uuid.Nil, err
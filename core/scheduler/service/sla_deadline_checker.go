@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/salt/log"
+	"github.com/robfig/cron/v3"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+	"github.com/raystack/optimus/internal/telemetry"
+)
+
+const (
+	// slaDeadlineLookback limits the scheduled times to check, older runs are not expected to finish anymore
+	slaDeadlineLookback = time.Hour * 24 * 7
+
+	metricSLADeadlineMiss = "jobrun_sla_deadline_miss_total"
+)
+
+type SLADeadlineRepository interface {
+	GetRunsScheduledSince(ctx context.Context, projectName tenant.ProjectName, since time.Time) ([]*scheduler.JobRun, error)
+	UpdateSLADeadlineMissed(ctx context.Context, jobRunID uuid.UUID) error
+	GetSLADeadlineAlerts(ctx context.Context, projectName tenant.ProjectName, since time.Time) (map[scheduler.JobName][]time.Time, error)
+	AddSLADeadlineAlert(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (bool, error)
+}
+
+type EventPusher interface {
+	Push(ctx context.Context, event *scheduler.Event) error
+}
+
+// SLADeadlineChecker periodically checks the scheduled times of the jobs against the deadline of their
+// sla_deadline_miss alert, the runs which are missing, not finished, or finished after the deadline are notified once.
+// The notified runs are marked, and the missing runs are recorded as alerted, so every deadline within the lookback is
+// notified whenever the checks run
+type SLADeadlineChecker struct {
+	l log.Logger
+
+	projectGetter ProjectGetter
+	jobRepo       JobRepository
+	jobRunRepo    SLADeadlineRepository
+	notifier      EventPusher
+
+	schedule *cron.Cron
+	interval time.Duration
+}
+
+func NewSLADeadlineChecker(l log.Logger, projectGetter ProjectGetter, jobRepo JobRepository, jobRunRepo SLADeadlineRepository, notifier EventPusher, interval time.Duration) *SLADeadlineChecker {
+	return &SLADeadlineChecker{
+		l:             l,
+		projectGetter: projectGetter,
+		jobRepo:       jobRepo,
+		jobRunRepo:    jobRunRepo,
+		notifier:      notifier,
+		interval:      interval,
+		schedule: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
+
+func (c *SLADeadlineChecker) Initialize() {
+	if c.interval <= 0 {
+		return
+	}
+	c.schedule.Schedule(cron.Every(c.interval), cron.FuncJob(func() {
+		c.Check(context.Background(), time.Now())
+	}))
+	c.schedule.Start()
+}
+
+func (c *SLADeadlineChecker) Close() {
+	if c.interval > 0 {
+		c.schedule.Stop()
+	}
+}
+
+// Check notifies the scheduled times of the jobs which are not done by their deadline as of the given time
+func (c *SLADeadlineChecker) Check(ctx context.Context, now time.Time) {
+	projects, err := c.projectGetter.GetAll(ctx)
+	if err != nil {
+		c.l.Error("error getting projects to check sla deadline: %s", err)
+		return
+	}
+
+	since := now.Add(-slaDeadlineLookback)
+	for _, project := range projects {
+		jobs, err := c.jobRepo.GetAll(ctx, project.Name())
+		if err != nil {
+			if !errors.IsErrorType(err, errors.ErrNotFound) {
+				c.l.Error("error getting jobs of project [%s] to check sla deadline: %s", project.Name(), err)
+			}
+			continue
+		}
+		jobRuns, err := c.jobRunRepo.GetRunsScheduledSince(ctx, project.Name(), since)
+		if err != nil {
+			c.l.Error("error getting job runs of project [%s] to check sla deadline: %s", project.Name(), err)
+			continue
+		}
+		alerts, err := c.jobRunRepo.GetSLADeadlineAlerts(ctx, project.Name(), since)
+		if err != nil {
+			c.l.Error("error getting sla deadline alerts of project [%s]: %s", project.Name(), err)
+			continue
+		}
+
+		runsByJob := map[scheduler.JobName]map[time.Time]*scheduler.JobRun{}
+		for _, jobRun := range jobRuns {
+			if runsByJob[jobRun.JobName] == nil {
+				runsByJob[jobRun.JobName] = map[time.Time]*scheduler.JobRun{}
+			}
+			runsByJob[jobRun.JobName][jobRun.ScheduledAt.UTC()] = jobRun
+		}
+		for _, job := range jobs {
+			if job.Disabled {
+				continue
+			}
+			alerted := map[time.Time]bool{}
+			for _, scheduledAt := range alerts[job.Name] {
+				alerted[scheduledAt.UTC()] = true
+			}
+			c.checkJob(ctx, job, runsByJob[job.Name], alerted, since, now)
+		}
+	}
+}
+
+// checkJob notifies the deadlines of the job which passed, alerted holds the scheduled times already notified without a run
+func (c *SLADeadlineChecker) checkJob(ctx context.Context, job *scheduler.JobWithDetails, jobRuns map[time.Time]*scheduler.JobRun, alerted map[time.Time]bool, since, now time.Time) {
+	_, hasDeadline, err := job.SLADeadline(since)
+	if err != nil {
+		c.l.Error("error getting sla deadline of job [%s]: %s", job.Name, err)
+		return
+	}
+	if !hasDeadline {
+		return
+	}
+	scheduledTimes, err := scheduler.ExpectedScheduledTimes(job, since, now)
+	if err != nil {
+		c.l.Error("error getting scheduled times of job [%s] to check sla deadline: %s", job.Name, err)
+		return
+	}
+
+	for _, scheduledAt := range scheduledTimes {
+		deadline, _, err := job.SLADeadline(scheduledAt)
+		if err != nil {
+			c.l.Error("error getting sla deadline of job [%s]: %s", job.Name, err)
+			return
+		}
+		if now.Before(deadline) {
+			continue
+		}
+
+		jobRun, ok := jobRuns[scheduledAt]
+		if !ok {
+			if alerted[scheduledAt] {
+				continue
+			}
+			// the alert is recorded first, so it is not notified again by a check running at the same time
+			added, err := c.jobRunRepo.AddSLADeadlineAlert(ctx, job.Job.Tenant, job.Name, scheduledAt)
+			if err != nil {
+				c.l.Error("error recording sla deadline alert of job [%s] scheduled at [%s]: %s", job.Name, scheduledAt, err)
+				continue
+			}
+			if !added {
+				continue
+			}
+			jobRun = &scheduler.JobRun{
+				JobName:     job.Name,
+				Tenant:      job.Job.Tenant,
+				State:       scheduler.StatePending,
+				ScheduledAt: scheduledAt,
+			}
+		} else {
+			if jobRun.SLADeadlineMissed || (isFinished(jobRun.State) && !jobRun.EndTime.After(deadline)) {
+				continue
+			}
+			if err := c.jobRunRepo.UpdateSLADeadlineMissed(ctx, jobRun.ID); err != nil {
+				c.l.Error("error marking sla deadline missed for job run [%s]: %s", jobRun.ID, err)
+				continue
+			}
+			// the run started after it was notified as missing
+			if alerted[scheduledAt] {
+				continue
+			}
+		}
+
+		telemetry.NewCounter(metricSLADeadlineMiss, map[string]string{
+			"project":   jobRun.Tenant.ProjectName().String(),
+			"namespace": jobRun.Tenant.NamespaceName().String(),
+			"name":      jobRun.JobName.String(),
+		}).Inc()
+
+		if err := c.notifier.Push(ctx, scheduler.NewSLADeadlineMissEvent(jobRun, deadline, now)); err != nil {
+			c.l.Error("error notifying sla deadline miss of job [%s] scheduled at [%s]: %s", jobRun.JobName, jobRun.ScheduledAt, err)
+		}
+	}
+}
+
+func isFinished(state scheduler.State) bool {
+	return state == scheduler.StateSuccess || state == scheduler.StateFailed
+}
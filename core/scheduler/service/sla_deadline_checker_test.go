@@ -0,0 +1,330 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/scheduler/service"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestSLADeadlineChecker(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	project, _ := tenant.NewProject("proj", map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	tnnt, _ := tenant.NewTenant(project.Name().String(), "ns")
+	window, _ := models.NewWindow(2, "d", "0", "24h")
+	interval := time.Hour
+	lookback := time.Hour * 24 * 7
+	now := time.Date(2023, 1, 5, 7, 30, 0, 0, time.UTC)
+	since := now.Add(-lookback)
+
+	// a daily run scheduled at 02:00 has the deadline at 07:00 of the same day
+	scheduledOn := func(day int) time.Time {
+		return time.Date(2023, 1, day, 2, 0, 0, 0, time.UTC)
+	}
+	deadlineOn := func(day int) time.Time {
+		return time.Date(2023, 1, day, 7, 0, 0, 0, time.UTC)
+	}
+
+	jobName := scheduler.JobName("job1")
+	newJob := func(alerts []scheduler.Alert) *scheduler.JobWithDetails {
+		return &scheduler.JobWithDetails{
+			Name: jobName,
+			Job: &scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+				Window: window,
+			},
+			Schedule: &scheduler.Schedule{
+				StartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				Interval:  "0 2 * * *",
+			},
+			Alerts: alerts,
+		}
+	}
+	jobWithDetails := newJob([]scheduler.Alert{
+		{
+			On:       scheduler.EventCategorySLADeadlineMiss,
+			Channels: []string{"slack://#chanel-name"},
+			Config:   map[string]string{"deadline": "7h"},
+		},
+	})
+	newJobRun := func(day int, state scheduler.State, endTime time.Time) *scheduler.JobRun {
+		return &scheduler.JobRun{
+			ID:          uuid.New(),
+			JobName:     jobName,
+			Tenant:      tnnt,
+			State:       state,
+			ScheduledAt: scheduledOn(day),
+			EndTime:     endTime,
+		}
+	}
+	notFinished := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Check", func(t *testing.T) {
+		t.Run("does not check when unable to get projects", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return(nil, fmt.Errorf("some error"))
+			defer projectGetter.AssertExpectations(t)
+
+			jobRunRepo := new(mockSLADeadlineRepository)
+			defer jobRunRepo.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, nil, jobRunRepo, nil, interval)
+			checker.Check(ctx, now)
+		})
+		t.Run("does not check the project when unable to get its job runs", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), since).Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, now)
+		})
+		t.Run("skips the jobs without sla deadline and the disabled jobs", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			disabledJob := newJob(jobWithDetails.Alerts)
+			disabledJob.Disabled = true
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{newJob(nil), disabledJob}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), since).Return(nil, nil)
+			jobRunRepo.On("GetSLADeadlineAlerts", ctx, project.Name(), since).Return(nil, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, now)
+		})
+		t.Run("does not check the project when unable to get its sla deadline alerts", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), since).Return(nil, nil)
+			jobRunRepo.On("GetSLADeadlineAlerts", ctx, project.Name(), since).Return(nil, fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, now)
+		})
+		t.Run("does not notify when unable to mark the run", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRuns := []*scheduler.JobRun{
+				newJobRun(1, scheduler.StateSuccess, scheduledOn(1).Add(time.Hour)),
+				newJobRun(2, scheduler.StateSuccess, scheduledOn(2).Add(time.Hour)),
+				newJobRun(3, scheduler.StateSuccess, scheduledOn(3).Add(time.Hour)),
+				newJobRun(4, scheduler.StateSuccess, scheduledOn(4).Add(time.Hour)),
+				newJobRun(5, scheduler.StateInProgress, notFinished),
+			}
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), since).Return(jobRuns, nil)
+			jobRunRepo.On("GetSLADeadlineAlerts", ctx, project.Name(), since).Return(nil, nil)
+			jobRunRepo.On("UpdateSLADeadlineMissed", ctx, jobRuns[4].ID).Return(fmt.Errorf("some error"))
+			defer jobRunRepo.AssertExpectations(t)
+
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, now)
+		})
+		t.Run("does not notify the missing runs when their alert is not recorded", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRuns := []*scheduler.JobRun{
+				newJobRun(1, scheduler.StateSuccess, scheduledOn(1).Add(time.Hour)),
+				newJobRun(2, scheduler.StateSuccess, scheduledOn(2).Add(time.Hour)),
+				newJobRun(3, scheduler.StateSuccess, scheduledOn(3).Add(time.Hour)),
+			}
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), since).Return(jobRuns, nil)
+			jobRunRepo.On("GetSLADeadlineAlerts", ctx, project.Name(), since).Return(nil, nil)
+			jobRunRepo.On("AddSLADeadlineAlert", ctx, tnnt, jobName, scheduledOn(4)).Return(false, fmt.Errorf("some error"))
+			// the alert is recorded by another check since the alerts are read
+			jobRunRepo.On("AddSLADeadlineAlert", ctx, tnnt, jobName, scheduledOn(5)).Return(false, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, now)
+		})
+		t.Run("notifies every missing run not alerted within the lookback, whenever the check runs", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			// the checks were not running for days, the deadlines passed long before this check
+			lateNow := time.Date(2023, 1, 7, 23, 0, 0, 0, time.UTC)
+			lateSince := lateNow.Add(-lookback)
+			startedAfterAlert := newJobRun(3, scheduler.StateRunning, notFinished)
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), lateSince).Return([]*scheduler.JobRun{
+				newJobRun(1, scheduler.StateSuccess, scheduledOn(1).Add(time.Hour)),
+				newJobRun(2, scheduler.StateSuccess, scheduledOn(2).Add(time.Hour)),
+				startedAfterAlert,
+				newJobRun(4, scheduler.StateSuccess, scheduledOn(4).Add(time.Hour)),
+				newJobRun(7, scheduler.StateSuccess, scheduledOn(7).Add(time.Hour)),
+			}, nil)
+			jobRunRepo.On("GetSLADeadlineAlerts", ctx, project.Name(), lateSince).Return(map[scheduler.JobName][]time.Time{
+				jobName: {scheduledOn(3), scheduledOn(5)},
+			}, nil)
+			jobRunRepo.On("UpdateSLADeadlineMissed", ctx, startedAfterAlert.ID).Return(nil)
+			jobRunRepo.On("AddSLADeadlineAlert", ctx, tnnt, jobName, scheduledOn(6)).Return(true, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			missingRun := &scheduler.JobRun{
+				JobName:     jobName,
+				Tenant:      tnnt,
+				State:       scheduler.StatePending,
+				ScheduledAt: scheduledOn(6),
+			}
+			notifier := new(mockEventPusher)
+			notifier.On("Push", ctx, scheduler.NewSLADeadlineMissEvent(missingRun, deadlineOn(6), lateNow)).Return(nil).Once()
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, lateNow)
+		})
+		t.Run("marks and notifies the runs not finished or finished after their deadline, and notifies the missing runs once", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("GetAll", ctx).Return([]*tenant.Project{project}, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", ctx, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			alreadyMissed := newJobRun(1, scheduler.StateInProgress, notFinished)
+			alreadyMissed.SLADeadlineMissed = true
+			finishedLate := newJobRun(3, scheduler.StateSuccess, deadlineOn(3).Add(time.Hour))
+			notFinishedYet := newJobRun(4, scheduler.StateWaitUpstream, notFinished)
+			jobRunRepo := new(mockSLADeadlineRepository)
+			jobRunRepo.On("GetRunsScheduledSince", ctx, project.Name(), since).Return([]*scheduler.JobRun{
+				alreadyMissed,
+				// the run scheduled on the 2nd is missing, it is alerted by a previous check
+				finishedLate,
+				notFinishedYet,
+				// the run scheduled on the 5th is missing, its deadline passed since the last check
+			}, nil)
+			jobRunRepo.On("GetSLADeadlineAlerts", ctx, project.Name(), since).Return(map[scheduler.JobName][]time.Time{
+				jobName: {scheduledOn(2)},
+			}, nil)
+			jobRunRepo.On("AddSLADeadlineAlert", ctx, tnnt, jobName, scheduledOn(5)).Return(true, nil)
+			jobRunRepo.On("UpdateSLADeadlineMissed", ctx, finishedLate.ID).Return(nil)
+			jobRunRepo.On("UpdateSLADeadlineMissed", ctx, notFinishedYet.ID).Return(nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			missingRun := &scheduler.JobRun{
+				JobName:     jobName,
+				Tenant:      tnnt,
+				State:       scheduler.StatePending,
+				ScheduledAt: scheduledOn(5),
+			}
+			notifier := new(mockEventPusher)
+			notifier.On("Push", ctx, scheduler.NewSLADeadlineMissEvent(finishedLate, deadlineOn(3), now)).Return(nil).Once()
+			notifier.On("Push", ctx, scheduler.NewSLADeadlineMissEvent(notFinishedYet, deadlineOn(4), now)).Return(fmt.Errorf("some error")).Once()
+			notifier.On("Push", ctx, scheduler.NewSLADeadlineMissEvent(missingRun, deadlineOn(5), now)).Return(nil).Once()
+			defer notifier.AssertExpectations(t)
+
+			checker := service.NewSLADeadlineChecker(logger, projectGetter, jobRepo, jobRunRepo, notifier, interval)
+			checker.Check(ctx, now)
+		})
+	})
+}
+
+type mockSLADeadlineRepository struct {
+	mock.Mock
+}
+
+func (m *mockSLADeadlineRepository) GetRunsScheduledSince(ctx context.Context, projectName tenant.ProjectName, since time.Time) ([]*scheduler.JobRun, error) {
+	args := m.Called(ctx, projectName, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRun), args.Error(1)
+}
+
+func (m *mockSLADeadlineRepository) UpdateSLADeadlineMissed(ctx context.Context, jobRunID uuid.UUID) error {
+	args := m.Called(ctx, jobRunID)
+	return args.Error(0)
+}
+
+func (m *mockSLADeadlineRepository) GetSLADeadlineAlerts(ctx context.Context, projectName tenant.ProjectName, since time.Time) (map[scheduler.JobName][]time.Time, error) {
+	args := m.Called(ctx, projectName, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[scheduler.JobName][]time.Time), args.Error(1)
+}
+
+func (m *mockSLADeadlineRepository) AddSLADeadlineAlert(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (bool, error) {
+	args := m.Called(ctx, t, jobName, scheduledAt)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockEventPusher struct {
+	mock.Mock
+}
+
+func (m *mockEventPusher) Push(ctx context.Context, event *scheduler.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
@@ -20,6 +20,7 @@ const (
 
 	StateSuccess State = "success"
 	StateFailed  State = "failed"
+	StateSkipped State = "skipped"
 
 	StateWaitUpstream State = "wait_upstream"
 	StateInProgress   State = "in_progress"
@@ -45,6 +46,8 @@ func StateFromString(state string) (State, error) {
 		return StateSuccess, nil
 	case string(StateFailed):
 		return StateFailed, nil
+	case string(StateSkipped):
+		return StateSkipped, nil
 	case string(StateWaitUpstream):
 		return StateWaitUpstream, nil
 	case string(StateInProgress):
@@ -61,6 +64,10 @@ func (j State) String() string {
 type JobRunStatus struct {
 	ScheduledAt time.Time
 	State       State
+
+	// StateChangedBy and StateChangeReason are set when the state of the run is changed manually
+	StateChangedBy    string
+	StateChangeReason string
 }
 
 func JobRunStatusFrom(scheduledAt time.Time, state string) (JobRunStatus, error) {
@@ -136,6 +143,18 @@ type JobRunsCriteria struct {
 	EndDate     time.Time
 	Filter      []string
 	OnlyLastRun bool
+
+	// RunOffset shifts the start and end date, eg. to get the runs of the previous day
+	RunOffset time.Duration
+}
+
+// WithRunOffsetApplied returns a copy of the criteria with the start and end date shifted by the run offset
+func (c *JobRunsCriteria) WithRunOffsetApplied() *JobRunsCriteria {
+	shifted := *c
+	shifted.StartDate = c.StartDate.Add(c.RunOffset)
+	shifted.EndDate = c.EndDate.Add(c.RunOffset)
+	shifted.RunOffset = 0
+	return &shifted
 }
 
 func (c *JobRunsCriteria) ExecutionStart(cron *cron.ScheduleSpec) time.Time {
@@ -0,0 +1,90 @@
+package tenant
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/optimus/internal/errors"
+)
+
+const (
+	EntitySchedulerPool = "schedulerPool"
+
+	// SchedulerPoolsKey is the project or namespace config declaring the scheduler pools,
+	// the value is a comma separated list of pool name and slots, eg. bq_pool:10,etl_pool:4
+	SchedulerPoolsKey = "SCHEDULER_POOLS"
+)
+
+type SchedulerPool struct {
+	name  string
+	slots int
+}
+
+func (p SchedulerPool) Name() string {
+	return p.name
+}
+
+func (p SchedulerPool) Slots() int {
+	return p.slots
+}
+
+func NewSchedulerPool(name string, slots int) (SchedulerPool, error) {
+	if name == "" {
+		return SchedulerPool{}, errors.InvalidArgument(EntitySchedulerPool, "pool name is empty")
+	}
+	if slots < 1 {
+		return SchedulerPool{}, errors.InvalidArgument(EntitySchedulerPool, "slots of pool "+name+" should be greater than 0")
+	}
+	return SchedulerPool{name: name, slots: slots}, nil
+}
+
+// SchedulerPoolsFrom parses the pools declared in config value
+func SchedulerPoolsFrom(config string) ([]SchedulerPool, error) {
+	var pools []SchedulerPool
+	for _, declaration := range strings.Split(config, ",") {
+		declaration = strings.TrimSpace(declaration)
+		if declaration == "" {
+			continue
+		}
+		name, slotsStr, found := strings.Cut(declaration, ":")
+		if !found {
+			return nil, errors.InvalidArgument(EntitySchedulerPool, fmt.Sprintf("invalid pool declaration %s, expected name:slots", declaration))
+		}
+		slots, err := strconv.Atoi(strings.TrimSpace(slotsStr))
+		if err != nil {
+			return nil, errors.InvalidArgument(EntitySchedulerPool, fmt.Sprintf("invalid slots in pool declaration %s", declaration))
+		}
+		pool, err := NewSchedulerPool(strings.TrimSpace(name), slots)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// SchedulerPools returns the pools declared in project and namespace config,
+// namespace declaration overrides the slots of the project pool having the same name
+func (w *WithDetails) SchedulerPools() ([]SchedulerPool, error) {
+	poolsByName := map[string]SchedulerPool{}
+	for _, config := range []map[string]string{w.project.config, w.namespace.config} {
+		pools, err := SchedulerPoolsFrom(config[SchedulerPoolsKey])
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range pools {
+			poolsByName[pool.name] = pool
+		}
+	}
+
+	pools := make([]SchedulerPool, 0, len(poolsByName))
+	for _, pool := range poolsByName {
+		pools = append(pools, pool)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].name < pools[j].name
+	})
+	return pools, nil
+}
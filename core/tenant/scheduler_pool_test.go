@@ -0,0 +1,83 @@
+package tenant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/core/tenant"
+)
+
+func TestEntitySchedulerPool(t *testing.T) {
+	t.Run("NewSchedulerPool", func(t *testing.T) {
+		t.Run("returns error when name is empty", func(t *testing.T) {
+			_, err := tenant.NewSchedulerPool("", 2)
+			assert.EqualError(t, err, "invalid argument for entity schedulerPool: pool name is empty")
+		})
+		t.Run("returns error when slots is not positive", func(t *testing.T) {
+			_, err := tenant.NewSchedulerPool("bq_pool", 0)
+			assert.EqualError(t, err, "invalid argument for entity schedulerPool: slots of pool bq_pool should be greater than 0")
+		})
+		t.Run("creates a scheduler pool", func(t *testing.T) {
+			pool, err := tenant.NewSchedulerPool("bq_pool", 2)
+			assert.Nil(t, err)
+			assert.Equal(t, "bq_pool", pool.Name())
+			assert.Equal(t, 2, pool.Slots())
+		})
+	})
+	t.Run("SchedulerPoolsFrom", func(t *testing.T) {
+		t.Run("returns error when slots is not declared", func(t *testing.T) {
+			_, err := tenant.SchedulerPoolsFrom("bq_pool")
+			assert.EqualError(t, err, "invalid argument for entity schedulerPool: invalid pool declaration bq_pool, expected name:slots")
+		})
+		t.Run("returns error when slots is not a number", func(t *testing.T) {
+			_, err := tenant.SchedulerPoolsFrom("bq_pool:ten")
+			assert.EqualError(t, err, "invalid argument for entity schedulerPool: invalid slots in pool declaration bq_pool:ten")
+		})
+		t.Run("returns no pool when config is empty", func(t *testing.T) {
+			pools, err := tenant.SchedulerPoolsFrom("")
+			assert.Nil(t, err)
+			assert.Empty(t, pools)
+		})
+		t.Run("returns the declared pools", func(t *testing.T) {
+			pools, err := tenant.SchedulerPoolsFrom("bq_pool:10, etl_pool : 4,")
+			assert.Nil(t, err)
+			assert.Len(t, pools, 2)
+			assert.Equal(t, "bq_pool", pools[0].Name())
+			assert.Equal(t, 10, pools[0].Slots())
+			assert.Equal(t, "etl_pool", pools[1].Name())
+			assert.Equal(t, 4, pools[1].Slots())
+		})
+	})
+	t.Run("WithDetails.SchedulerPools", func(t *testing.T) {
+		project, _ := tenant.NewProject("test-project", map[string]string{
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+			tenant.SchedulerPoolsKey:     "bq_pool:10,etl_pool:4",
+		})
+
+		t.Run("returns error when declaration is invalid", func(t *testing.T) {
+			namespace, _ := tenant.NewNamespace("test-ns", project.Name(), map[string]string{
+				tenant.SchedulerPoolsKey: "invalid",
+			})
+			tenantWithDetails, _ := tenant.NewTenantDetails(project, namespace, nil)
+
+			_, err := tenantWithDetails.SchedulerPools()
+			assert.NotNil(t, err)
+		})
+		t.Run("returns pools of project and namespace giving priority to namespace", func(t *testing.T) {
+			namespace, _ := tenant.NewNamespace("test-ns", project.Name(), map[string]string{
+				tenant.SchedulerPoolsKey: "etl_pool:8,ns_pool:1",
+			})
+			tenantWithDetails, _ := tenant.NewTenantDetails(project, namespace, nil)
+
+			pools, err := tenantWithDetails.SchedulerPools()
+			assert.Nil(t, err)
+
+			bqPool, _ := tenant.NewSchedulerPool("bq_pool", 10)
+			etlPool, _ := tenant.NewSchedulerPool("etl_pool", 8)
+			nsPool, _ := tenant.NewSchedulerPool("ns_pool", 1)
+			assert.Equal(t, []tenant.SchedulerPool{bqPool, etlPool, nsPool}, pools)
+		})
+	})
+}
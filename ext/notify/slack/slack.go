@@ -185,6 +185,42 @@ func buildMessageBlocks(events []event, workerErrChan chan error) []api.Block {
 			if taskID, ok := evt.meta.Values["task_id"]; ok && taskID.(string) != "" {
 				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Task ID:*\n%s", taskID.(string)), false, false))
 			}
+		} else if evt.meta.Type.IsOfType(scheduler.EventCategorySLADeadlineMiss) {
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Job] SLA Deadline Missed | %s/%s", projectName, namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if scheduledAt, ok := evt.meta.Values["scheduled_at"]; ok && scheduledAt.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Scheduled At:*\n%s", scheduledAt.(string)), false, false))
+			}
+			if deadline, ok := evt.meta.Values["deadline"]; ok && deadline.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Deadline:*\n%s", deadline.(string)), false, false))
+			}
+			if state, ok := evt.meta.Values["state"]; ok && state.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*State:*\n%s", state.(string)), false, false))
+			}
+		} else if evt.meta.Type.IsOfType(scheduler.EventCategoryMissingRun) {
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Job] Missing Run | %s/%s", projectName, namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if missingRuns, ok := evt.meta.Values["missing_runs"]; ok && missingRuns.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Missing Runs:*\n%s", missingRuns.(string)), false, false))
+			}
+		} else if evt.meta.Type.IsOfType(scheduler.EventCategoryDurationAnomaly) {
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Job] Duration Anomaly | %s/%s", projectName, namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if scheduledAt, ok := evt.meta.Values["scheduled_at"]; ok && scheduledAt.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Scheduled At:*\n%s", scheduledAt.(string)), false, false))
+			}
+			if duration, ok := evt.meta.Values["duration"]; ok && duration.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Duration:*\n%s", duration.(string)), false, false))
+			}
+			if expectedDuration, ok := evt.meta.Values["expected_duration"]; ok && expectedDuration.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Expected Duration:*\n%s", expectedDuration.(string)), false, false))
+			}
 		} else {
 			workerErrChan <- fmt.Errorf("worker_buildMessageBlocks: unknown event type: %v", evt.meta.Type)
 			continue
@@ -27,6 +27,8 @@ TIMESTAMP_FORMAT = "%Y-%m-%dT%H:%M:%SZ"
 TIMESTAMP_MS_FORMAT = "%Y-%m-%dT%H:%M:%S.%fZ"
 
 SCHEDULER_ERR_MSG = "scheduler_error"
+XCOM_UPSTREAM_RUNS = "upstream_runs"
+XCOM_SKIPPED_UPSTREAM = "skipped_upstream"
 
 def lookup_non_standard_cron_expression(expr: str) -> str:
     expr_mapping = {
@@ -44,6 +46,12 @@ def lookup_non_standard_cron_expression(expr: str) -> str:
         return expr
 
 
+def is_business_day(weekdays, holidays, **context) -> bool:
+    # weekdays are numbered from sunday as 0, the same as the calendar of optimus
+    schedule_time = context['next_execution_date']
+    return schedule_time.isoweekday() % 7 in weekdays and schedule_time.strftime("%Y-%m-%d") not in holidays
+
+
 class SuperKubernetesPodOperator(KubernetesPodOperator):
     def __init__(self, *args, **kwargs):
         super(SuperKubernetesPodOperator, self).__init__(*args, **kwargs)
@@ -75,13 +83,26 @@ class OptimusAPIClient:
             return host
         return "http://" + host
 
-    def get_job_run(self, optimus_project: str, optimus_job: str, startDate: str, endDate: str) -> dict:
+    def get_job_run(self, optimus_project: str, optimus_job: str, startDate: str, endDate: str,
+                    run_offset: str = "") -> dict:
         url = '{optimus_host}/api/v1beta1/project/{optimus_project}/job/{optimus_job}/run'.format(
             optimus_host=self.host,
             optimus_project=optimus_project,
             optimus_job=optimus_job,
         )
-        response = requests.get(url, params={'start_date': startDate, 'end_date': endDate})
+        params = {'start_date': startDate, 'end_date': endDate}
+        if run_offset:
+            params['run_offset'] = run_offset
+        response = requests.get(url, params=params)
+        self._raise_error_if_request_failed(response)
+        return response.json()
+
+    def get_disabled_jobs(self, optimus_project: str, optimus_namespace: str) -> dict:
+        url = '{optimus_host}/api/v1beta1/project/{optimus_project}/job/disabled'.format(
+            optimus_host=self.host,
+            optimus_project=optimus_project,
+        )
+        response = requests.get(url, params={'namespace_name': optimus_namespace})
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -191,6 +212,11 @@ class SuperExternalTaskSensor(BaseSensorOperator):
             upstream_optimus_job: str,
             window_size: str,
             window_version: int,
+            window_offset: str = "0",
+            window_truncate_to: str = "",
+            run_offset: str = "",
+            optional: bool = False,
+            max_wait_in_secs: int = 0,
             *args,
             **kwargs) -> None:
         kwargs['mode'] = kwargs.get('mode', 'reschedule')
@@ -200,12 +226,22 @@ class SuperExternalTaskSensor(BaseSensorOperator):
         self.optimus_job = upstream_optimus_job
         self.window_size = window_size
         self.window_version = window_version
+        self.window_offset = window_offset
+        self.window_truncate_to = window_truncate_to
+        # shifts the window of the upstream runs to wait for, eg. -24h for the runs of the previous day
+        self.run_offset = run_offset
+        # optional upstreams are skipped when disabled, or when not done within max_wait_in_secs if set
+        self.optional = optional
+        self.max_wait_in_secs = max_wait_in_secs
         self._optimus_client = OptimusAPIClient(optimus_hostname)
         self._upstream_optimus_client = OptimusAPIClient(upstream_optimus_hostname)
 
     def poke(self, context):
         schedule_time = context['next_execution_date']
 
+        if self.optional and self._is_upstream_disabled():
+            return self._proceed_without_upstream(context, "upstream is disabled")
+
         try:
             upstream_schedule = self.get_schedule_interval(schedule_time)
         except Exception as e:
@@ -217,12 +253,14 @@ class SuperExternalTaskSensor(BaseSensorOperator):
             schedule_time, upstream_schedule)
 
         # get schedule window
-        task_window = JobSpecTaskWindow(self.window_version, self.window_size, 0, "", self._optimus_client)
+        task_window = JobSpecTaskWindow(self.window_version, self.window_size, self.window_offset,
+                                        self.window_truncate_to, self._optimus_client)
         schedule_time_window_start, schedule_time_window_end = task_window.get_schedule_window(
             last_upstream_schedule_time.strftime(TIMESTAMP_FORMAT), upstream_schedule)
 
-        self.log.info("waiting for upstream runs between: {} - {} schedule times of airflow dag run".format(
-            schedule_time_window_start, schedule_time_window_end))
+        self.log.info("waiting for upstream runs between: {} - {} schedule times of airflow dag run{}".format(
+            schedule_time_window_start, schedule_time_window_end,
+            " shifted by {}".format(self.run_offset) if self.run_offset else ""))
 
         # a = 0/0
         if not self._are_all_job_runs_successful(schedule_time_window_start, schedule_time_window_end):
@@ -230,7 +268,40 @@ class SuperExternalTaskSensor(BaseSensorOperator):
                              "'{}' dated between {} and {}(inclusive), rescheduling sensor".
                              format(self.optimus_job, self.optimus_project, schedule_time_window_start,
                                     schedule_time_window_end))
+            if self.optional and self.max_wait_in_secs and self._waited_in_secs(context) >= self.max_wait_in_secs:
+                return self._proceed_without_upstream(
+                    context, "upstream runs are not successful within {} seconds".format(self.max_wait_in_secs))
             return False
+
+        # upstream runs the sensor is satisfied by, reported to optimus with the sensor success event
+        context['ti'].xcom_push(key=XCOM_UPSTREAM_RUNS, value=self._upstream_runs)
+        return True
+
+    def _is_upstream_disabled(self) -> bool:
+        try:
+            api_response = self._upstream_optimus_client.get_disabled_jobs(self.optimus_project, self.optimus_namespace)
+        except Exception as e:
+            self.log.warning("error while fetching disabled jobs :: {}".format(e))
+            return False
+        return any(disabled_job['jobName'] == self.optimus_job for disabled_job in api_response.get('jobs', []))
+
+    def _waited_in_secs(self, context) -> float:
+        ti = context['ti']
+        # the sensor is rescheduled between pokes, the wait starts at the first poke
+        task_reschedules = TaskReschedule.find_for_task_instance(ti)
+        wait_start = task_reschedules[0].start_date if len(task_reschedules) > 0 else ti.start_date
+        return (datetime.now(tz=utc) - wait_start).total_seconds()
+
+    def _proceed_without_upstream(self, context, reason) -> bool:
+        self.log.warning("proceeding without optional upstream '{}' in '{}': {}".format(
+            self.optimus_job, self.optimus_project, reason))
+        # reported to optimus with the sensor success event, the data of the job run might be partial
+        context['ti'].xcom_push(key=XCOM_SKIPPED_UPSTREAM, value={
+            "project_name": self.optimus_project,
+            "namespace_name": self.optimus_namespace,
+            "job_name": self.optimus_job,
+            "reason": reason,
+        })
         return True
 
     def get_last_upstream_times(self, schedule_time_of_current_job, upstream_schedule_interval):
@@ -252,15 +323,24 @@ class SuperExternalTaskSensor(BaseSensorOperator):
     def _are_all_job_runs_successful(self, schedule_time_window_start, schedule_time_window_end) -> bool:
         try:
             api_response = self._upstream_optimus_client.get_job_run(self.optimus_project, self.optimus_job,
-                                                            schedule_time_window_start, schedule_time_window_end)
+                                                            schedule_time_window_start, schedule_time_window_end,
+                                                            self.run_offset)
             self.log.info("job_run api response :: {}".format(api_response))
         except Exception as e:
             self.log.warning("error while fetching job runs :: {}".format(e))
             raise AirflowFailException(e)
+        self._upstream_runs = []
         for job_run in api_response['jobRuns']:
-            if job_run['state'] != 'success':
+            # runs skipped manually do not block the downstream jobs
+            if job_run['state'] not in ('success', 'skipped'):
                 self.log.info("failed for run :: {}".format(job_run))
                 return False
+            self._upstream_runs.append({
+                "project_name": self.optimus_project,
+                "namespace_name": self.optimus_namespace,
+                "job_name": self.optimus_job,
+                "scheduled_at": self._parse_datetime(job_run['scheduledAt']).strftime(TIMESTAMP_FORMAT),
+            })
         return True
 
     def _parse_datetime(self, timestamp) -> datetime:
@@ -401,6 +481,14 @@ def operator_success_event(context):
             "event_type": "TYPE_{}_SUCCESS".format(run_type),
             "status": "success"
         }
+        if run_type == "SENSOR":
+            ti = context.get('task_instance')
+            upstream_runs = ti.xcom_pull(task_ids=ti.task_id, key=XCOM_UPSTREAM_RUNS)
+            if upstream_runs is not None:
+                meta[XCOM_UPSTREAM_RUNS] = upstream_runs
+            skipped_upstream = ti.xcom_pull(task_ids=ti.task_id, key=XCOM_SKIPPED_UPSTREAM)
+            if skipped_upstream is not None:
+                meta[XCOM_SKIPPED_UPSTREAM] = skipped_upstream
         optimus_notify(context, meta)
     except Exception as e:
         print(e)
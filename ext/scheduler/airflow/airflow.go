@@ -2,13 +2,16 @@ package airflow
 
 import (
 	"context"
+	"crypto/md5" //nolint:gosec
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -35,6 +38,10 @@ const (
 	dagURL            = "api/v1/dags/%s"
 	dagRunClearURL    = "api/v1/dags/%s/clearTaskInstances"
 	dagRunCreateURL   = "api/v1/dags/%s/dagRuns"
+	dagRunURL         = "api/v1/dags/%s/dagRuns/%s"
+	poolsURL          = "api/v1/pools"
+	poolURL           = "api/v1/pools/%s"
+	poolsPageLimit    = 100
 	airflowDateFormat = "2006-01-02T15:04:05+00:00"
 
 	schedulerHostKey = "SCHEDULER_HOST"
@@ -55,6 +62,7 @@ const (
 type Bucket interface {
 	WriteAll(ctx context.Context, key string, p []byte, opts *blob.WriterOptions) error
 	List(opts *blob.ListOptions) *blob.ListIterator
+	ReadAll(ctx context.Context, key string) ([]byte, error)
 	Delete(ctx context.Context, key string) error
 	Close() error
 }
@@ -75,6 +83,10 @@ type SecretGetter interface {
 	Get(ctx context.Context, projName tenant.ProjectName, namespaceName, name string) (*tenant.PlainTextSecret, error)
 }
 
+type TenantGetter interface {
+	GetDetails(ctx context.Context, tnnt tenant.Tenant) (*tenant.WithDetails, error)
+}
+
 type ProjectGetter interface {
 	Get(context.Context, tenant.ProjectName) (*tenant.Project, error)
 }
@@ -87,6 +99,7 @@ type Scheduler struct {
 
 	projectGetter ProjectGetter
 	secretGetter  SecretGetter
+	tenantGetter  TenantGetter
 }
 
 func (s *Scheduler) DeployJobs(ctx context.Context, tenant tenant.Tenant, jobs []*scheduler.JobWithDetails) error {
@@ -161,6 +174,74 @@ func (s *Scheduler) ListJobs(ctx context.Context, t tenant.Tenant) ([]string, er
 	return jobNames, nil
 }
 
+// ListDeployedJobs lists the jobs deployed under every namespace directory of the project of the tenant, along with
+// the checksum of the deployed job, the storage of the project is accessed as the tenant
+func (s *Scheduler) ListDeployedJobs(ctx context.Context, t tenant.Tenant) ([]*scheduler.DeployedJob, error) {
+	spanCtx, span := startChildSpan(ctx, "ListDeployedJobs")
+	defer span.End()
+
+	bucket, err := s.bucketFac.New(spanCtx, t)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	var deployedJobs []*scheduler.DeployedJob
+	prefix := jobsDir + "/"
+	it := bucket.List(&blob.ListOptions{
+		Prefix: prefix,
+	})
+	for {
+		obj, err := it.Next(spanCtx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		namespaceName, jobFileName := path.Split(strings.TrimPrefix(obj.Key, prefix))
+		namespaceName = strings.TrimSuffix(namespaceName, "/")
+		if namespaceName == "" || strings.Contains(namespaceName, "/") || !strings.HasSuffix(jobFileName, jobsExtension) {
+			continue
+		}
+		deployedTenant, err := tenant.NewTenant(t.ProjectName().String(), namespaceName)
+		if err != nil {
+			return nil, err
+		}
+
+		// the checksum reported by the storage covers the priority as well, the deployed job is read to leave it out
+		content, err := bucket.ReadAll(spanCtx, obj.Key)
+		if err != nil {
+			return nil, errors.AddErrContext(err, EntityAirflow, "error in reading "+obj.Key)
+		}
+		deployedJobs = append(deployedJobs, &scheduler.DeployedJob{
+			Name:     scheduler.JobName(jobNameFromPath(obj.Key, jobsExtension)),
+			Tenant:   deployedTenant,
+			Checksum: dagChecksum(content),
+		})
+	}
+	return deployedJobs, nil
+}
+
+// Checksum is of the job compiled for the deployment, the same as the checksum of the job deployed when it is up-to-date
+func (s *Scheduler) Checksum(job *scheduler.JobWithDetails) (string, error) {
+	compiledJob, err := s.compiler.Compile(job)
+	if err != nil {
+		return "", errors.AddErrContext(err, EntityAirflow, "job:"+job.Name.String())
+	}
+	return dagChecksum(compiledJob), nil
+}
+
+// priorityWeightLine is left out of the checksum of a dag, the priority resolved from the durations of the runs
+// changes without any change on the job and is refreshed on the next deployment of the job
+var priorityWeightLine = regexp.MustCompile(`(?m)^[ \t]*"priority_weight": -?\d+,\n`)
+
+// dagChecksum is the md5 of the dag without its priority, it is not used for security
+func dagChecksum(dag []byte) string {
+	sum := md5.Sum(priorityWeightLine.ReplaceAll(dag, nil)) //nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
 func (s *Scheduler) DeleteJobs(ctx context.Context, t tenant.Tenant, jobNames []string) error {
 	spanCtx, span := startChildSpan(ctx, "DeleteJobs")
 	defer span.End()
@@ -235,6 +316,15 @@ func (s *Scheduler) compileAndUpload(ctx context.Context, job *scheduler.JobWith
 	return nil
 }
 
+// CompileJob compiles the job to the dag file which is uploaded on deployment
+func (s *Scheduler) CompileJob(_ context.Context, job *scheduler.JobWithDetails) ([]byte, error) {
+	compiledJob, err := s.compiler.Compile(job)
+	if err != nil {
+		return nil, errors.AddErrContext(err, EntityAirflow, "job:"+job.Name.String())
+	}
+	return compiledJob, nil
+}
+
 func pathFromJobName(prefix, namespace, jobName, suffix string) string {
 	if len(prefix) > 0 && prefix[0] == '/' {
 		prefix = prefix[1:]
@@ -329,6 +419,160 @@ func (s *Scheduler) UpdateJobState(ctx context.Context, tnnt tenant.Tenant, jobN
 	return nil
 }
 
+// UpdateJobRunState set the state of existing dag runs of a job on the given schedule times
+func (s *Scheduler) UpdateJobRunState(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, jobCron *cron.ScheduleSpec, scheduledAts []time.Time, state scheduler.State) error {
+	spanCtx, span := startChildSpan(ctx, "UpdateJobRunState")
+	defer span.End()
+
+	if len(scheduledAts) == 0 {
+		return nil
+	}
+	startDate, endDate := scheduledAts[0], scheduledAts[0]
+	for _, scheduledAt := range scheduledAts {
+		if scheduledAt.Before(startDate) {
+			startDate = scheduledAt
+		}
+		if scheduledAt.After(endDate) {
+			endDate = scheduledAt
+		}
+	}
+	reqBody, err := json.Marshal(DagRunRequest{
+		OrderBy:          "execution_date",
+		PageOffset:       0,
+		PageLimit:        pageLimit,
+		DagIds:           []string{jobName.String()},
+		ExecutionDateGte: jobCron.Prev(startDate).UTC().Format(airflowDateFormat),
+		ExecutionDateLte: jobCron.Prev(endDate).UTC().Format(airflowDateFormat),
+	})
+	if err != nil {
+		return errors.Wrap(EntityAirflow, "unable to marshal dag run request", err)
+	}
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Invoke(spanCtx, airflowRequest{
+		path:   dagStatusBatchURL,
+		method: http.MethodPost,
+		body:   reqBody,
+	}, schdAuth)
+	if err != nil {
+		return errors.Wrap(EntityAirflow, "failure while fetching airflow dag runs", err)
+	}
+
+	var dagRunList DagRunListResponse
+	if err := json.Unmarshal(resp, &dagRunList); err != nil {
+		return errors.Wrap(EntityAirflow, fmt.Sprintf("json error on parsing airflow dag runs: %s", string(resp)), err)
+	}
+
+	dagRunIDs := make(map[time.Time]string, len(dagRunList.DagRuns))
+	for _, dagRun := range dagRunList.DagRuns {
+		dagRunIDs[jobCron.Next(dagRun.ExecutionDate).UTC()] = dagRun.DagRunID
+	}
+	var runIDsToUpdate []string
+	for _, scheduledAt := range scheduledAts {
+		runID, ok := dagRunIDs[scheduledAt.UTC()]
+		if !ok {
+			return errors.NotFound(EntityAirflow, fmt.Sprintf("dag run for job %s scheduled at %s is not found", jobName, scheduledAt.UTC().Format(time.RFC3339)))
+		}
+		runIDsToUpdate = append(runIDsToUpdate, runID)
+	}
+
+	data := []byte(fmt.Sprintf(`{"state": %q}`, state.String()))
+	me := errors.NewMultiError("update dag run state on scheduler")
+	for _, runID := range runIDsToUpdate {
+		req := airflowRequest{
+			path:   fmt.Sprintf(dagRunURL, jobName.String(), runID),
+			method: http.MethodPatch,
+			body:   data,
+		}
+		if _, err := s.client.Invoke(spanCtx, req, schdAuth); err != nil {
+			me.Append(errors.Wrap(EntityAirflow, "failure while updating state of dag run "+runID, err))
+		}
+	}
+	return me.ToErr()
+}
+
+// SyncPools creates the pools declared for the tenant which are missing on airflow, and updates the slots of the existing ones,
+// pools which are not declared are left as they are since they might be managed outside of optimus
+func (s *Scheduler) SyncPools(ctx context.Context, tnnt tenant.Tenant) error {
+	spanCtx, span := startChildSpan(ctx, "SyncPools")
+	defer span.End()
+
+	tenantWithDetails, err := s.tenantGetter.GetDetails(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+	declaredPools, err := tenantWithDetails.SchedulerPools()
+	if err != nil {
+		return err
+	}
+	if len(declaredPools) == 0 {
+		return nil
+	}
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+	existingPools, err := s.listPools(spanCtx, schdAuth)
+	if err != nil {
+		return err
+	}
+
+	me := errors.NewMultiError("sync pools on scheduler")
+	for _, pool := range declaredPools {
+		data, err := json.Marshal(Pool{Name: pool.Name(), Slots: pool.Slots()})
+		if err != nil {
+			me.Append(errors.Wrap(EntityAirflow, "unable to marshal pool "+pool.Name(), err))
+			continue
+		}
+		slots, ok := existingPools[pool.Name()]
+		if ok && slots == pool.Slots() {
+			continue
+		}
+
+		req := airflowRequest{path: poolsURL, method: http.MethodPost, body: data}
+		if ok {
+			req = airflowRequest{path: fmt.Sprintf(poolURL, pool.Name()), method: http.MethodPatch, body: data}
+		}
+		if _, err := s.client.Invoke(spanCtx, req, schdAuth); err != nil {
+			me.Append(errors.Wrap(EntityAirflow, "failure while syncing pool "+pool.Name(), err))
+			continue
+		}
+		s.l.Info("synced pool %s with %d slots for project %s", pool.Name(), pool.Slots(), tnnt.ProjectName())
+	}
+	return me.ToErr()
+}
+
+// listPools returns the slots of existing airflow pools by the pool name
+func (s *Scheduler) listPools(ctx context.Context, schdAuth SchedulerAuth) (map[string]int, error) {
+	pools := map[string]int{}
+	for offset := 0; ; offset += poolsPageLimit {
+		resp, err := s.client.Invoke(ctx, airflowRequest{
+			path:   poolsURL,
+			query:  fmt.Sprintf("limit=%d&offset=%d", poolsPageLimit, offset),
+			method: http.MethodGet,
+		}, schdAuth)
+		if err != nil {
+			return nil, errors.Wrap(EntityAirflow, "failure while fetching airflow pools", err)
+		}
+
+		var poolList PoolListResponse
+		if err := json.Unmarshal(resp, &poolList); err != nil {
+			return nil, errors.Wrap(EntityAirflow, fmt.Sprintf("json error on parsing airflow pools: %s", string(resp)), err)
+		}
+		for _, pool := range poolList.Pools {
+			pools[pool.Name] = pool.Slots
+		}
+		if len(poolList.Pools) == 0 || offset+poolsPageLimit >= poolList.TotalEntries {
+			return pools, nil
+		}
+	}
+}
+
 func getDagRunRequest(jobQuery *scheduler.JobRunsCriteria, jobCron *cron.ScheduleSpec) DagRunRequest {
 	if jobQuery.OnlyLastRun {
 		return DagRunRequest{
@@ -424,7 +668,9 @@ func (s *Scheduler) CreateRun(ctx context.Context, tnnt tenant.Tenant, jobName s
 	return nil
 }
 
-func NewScheduler(l log.Logger, bucketFac BucketFactory, client Client, compiler DagCompiler, projectGetter ProjectGetter, secretGetter SecretGetter) *Scheduler {
+func NewScheduler(l log.Logger, bucketFac BucketFactory, client Client, compiler DagCompiler, projectGetter ProjectGetter, secretGetter SecretGetter,
+	tenantGetter TenantGetter,
+) *Scheduler {
 	return &Scheduler{
 		l:             l,
 		bucketFac:     bucketFac,
@@ -432,6 +678,7 @@ func NewScheduler(l log.Logger, bucketFac BucketFactory, client Client, compiler
 		client:        client,
 		projectGetter: projectGetter,
 		secretGetter:  secretGetter,
+		tenantGetter:  tenantGetter,
 	}
 }
 
@@ -0,0 +1,372 @@
+package airflow
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/raystack/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gocloud.dev/blob/memblob"
+
+	"github.com/raystack/optimus/core/scheduler"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/lib/cron"
+)
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	tnnt2, _ := tenant.NewTenant("proj", "ns2")
+
+	checksumOf := func(content string) string {
+		sum := md5.Sum([]byte(content)) //nolint:gosec
+		return hex.EncodeToString(sum[:])
+	}
+
+	t.Run("ListDeployedJobs", func(t *testing.T) {
+		t.Run("returns error when unable to get the bucket", func(t *testing.T) {
+			bucketFac := new(mockBucketFactory)
+			bucketFac.On("New", mock.Anything, tnnt).Return(nil, fmt.Errorf("some error"))
+			defer bucketFac.AssertExpectations(t)
+
+			s := NewScheduler(logger, bucketFac, nil, nil, nil, nil, nil)
+			deployedJobs, err := s.ListDeployedJobs(ctx, tnnt)
+			assert.EqualError(t, err, "some error")
+			assert.Nil(t, deployedJobs)
+		})
+		t.Run("returns the jobs deployed under every namespace directory with their checksum", func(t *testing.T) {
+			bucket := memblob.OpenBucket(nil)
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/__lib.py", []byte("lib"), nil))
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/ns1/job-1.py", []byte("dag of job-1"), nil))
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/ns1/job-1.json", []byte("{}"), nil))
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/ns2/job-2.py", []byte("dag of job-2"), nil))
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/ns2/nested/job-3.py", []byte("dag of job-3"), nil))
+			assert.NoError(t, bucket.WriteAll(ctx, "other/ns1/job-4.py", []byte("dag of job-4"), nil))
+
+			bucketFac := new(mockBucketFactory)
+			bucketFac.On("New", mock.Anything, tnnt).Return(bucket, nil)
+			defer bucketFac.AssertExpectations(t)
+
+			s := NewScheduler(logger, bucketFac, nil, nil, nil, nil, nil)
+			deployedJobs, err := s.ListDeployedJobs(ctx, tnnt)
+			assert.NoError(t, err)
+			assert.Equal(t, []*scheduler.DeployedJob{
+				{Name: "job-1", Tenant: tnnt, Checksum: checksumOf("dag of job-1")},
+				{Name: "job-2", Tenant: tnnt2, Checksum: checksumOf("dag of job-2")},
+			}, deployedJobs)
+		})
+	})
+	t.Run("Checksum", func(t *testing.T) {
+		job := &scheduler.JobWithDetails{Name: "job-1", Job: &scheduler.Job{Name: "job-1", Tenant: tnnt}}
+
+		t.Run("returns error when unable to compile the job", func(t *testing.T) {
+			compiler := new(mockDagCompiler)
+			compiler.On("Compile", job).Return(nil, fmt.Errorf("some error"))
+			defer compiler.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, nil, compiler, nil, nil, nil)
+			_, err := s.Checksum(job)
+			assert.ErrorContains(t, err, "some error")
+		})
+		t.Run("returns the checksum of the compiled job", func(t *testing.T) {
+			compiler := new(mockDagCompiler)
+			compiler.On("Compile", job).Return([]byte("dag of job-1"), nil)
+			defer compiler.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, nil, compiler, nil, nil, nil)
+			checksum, err := s.Checksum(job)
+			assert.NoError(t, err)
+			assert.Equal(t, checksumOf("dag of job-1"), checksum)
+		})
+		t.Run("returns the checksum of the deployed job when only the priority of the job changed", func(t *testing.T) {
+			dagWithPriority := func(priority int) []byte {
+				return []byte(fmt.Sprintf("default_args = {\n    \"retries\": 3,\n    \"priority_weight\": %d,\n    \"start_date\": START,\n}\n", priority))
+			}
+			bucket := memblob.OpenBucket(nil)
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/ns1/job-1.py", dagWithPriority(5120), nil))
+
+			bucketFac := new(mockBucketFactory)
+			bucketFac.On("New", mock.Anything, tnnt).Return(bucket, nil)
+			defer bucketFac.AssertExpectations(t)
+
+			// the durations of the runs moved the job to another priority since it was deployed
+			compiler := new(mockDagCompiler)
+			compiler.On("Compile", job).Return(dagWithPriority(5320), nil)
+			defer compiler.AssertExpectations(t)
+
+			s := NewScheduler(logger, bucketFac, nil, compiler, nil, nil, nil)
+			deployedJobs, err := s.ListDeployedJobs(ctx, tnnt)
+			assert.NoError(t, err)
+			checksum, err := s.Checksum(job)
+			assert.NoError(t, err)
+
+			assert.Len(t, deployedJobs, 1)
+			assert.Equal(t, deployedJobs[0].Checksum, checksum)
+			assert.Empty(t, scheduler.DetectDrifts(tnnt, []*scheduler.JobWithDetails{job},
+				map[scheduler.JobName]string{job.Name: checksum}, deployedJobs))
+		})
+		t.Run("returns a different checksum when the job changed", func(t *testing.T) {
+			bucket := memblob.OpenBucket(nil)
+			assert.NoError(t, bucket.WriteAll(ctx, "dags/ns1/job-1.py", []byte("\"retries\": 3,\n\"priority_weight\": 100,\n"), nil))
+
+			bucketFac := new(mockBucketFactory)
+			bucketFac.On("New", mock.Anything, tnnt).Return(bucket, nil)
+			defer bucketFac.AssertExpectations(t)
+
+			compiler := new(mockDagCompiler)
+			compiler.On("Compile", job).Return([]byte("\"retries\": 5,\n\"priority_weight\": 100,\n"), nil)
+			defer compiler.AssertExpectations(t)
+
+			s := NewScheduler(logger, bucketFac, nil, compiler, nil, nil, nil)
+			deployedJobs, err := s.ListDeployedJobs(ctx, tnnt)
+			assert.NoError(t, err)
+			checksum, err := s.Checksum(job)
+			assert.NoError(t, err)
+
+			assert.Len(t, deployedJobs, 1)
+			assert.NotEqual(t, deployedJobs[0].Checksum, checksum)
+		})
+	})
+	t.Run("SyncPools", func(t *testing.T) {
+		project, _ := tenant.NewProject("proj", map[string]string{
+			tenant.ProjectStoragePathKey: "gs://location",
+			tenant.ProjectSchedulerHost:  "http://airflow.example.io",
+			tenant.SchedulerPoolsKey:     "bq_pool:4,sensor_pool:2,new_pool:3",
+		})
+		namespace, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{})
+		tenantWithPools, _ := tenant.NewTenantDetails(project, namespace, nil)
+		authSecret, _ := tenant.NewPlainTextSecret(tenant.SecretSchedulerAuth, "token")
+		schdAuth := SchedulerAuth{host: "airflow.example.io", token: "token"}
+
+		listPoolsRequest := func(offset int) airflowRequest {
+			return airflowRequest{path: poolsURL, query: fmt.Sprintf("limit=100&offset=%d", offset), method: http.MethodGet}
+		}
+		createNewPool := airflowRequest{path: poolsURL, method: http.MethodPost, body: []byte(`{"name":"new_pool","slots":3}`)}
+		updateSensorPool := airflowRequest{path: "api/v1/pools/sensor_pool", method: http.MethodPatch, body: []byte(`{"name":"sensor_pool","slots":2}`)}
+
+		t.Run("returns error when unable to get the tenant details", func(t *testing.T) {
+			tenantGetter := new(mockTenantGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(nil, fmt.Errorf("some error"))
+			defer tenantGetter.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, nil, nil, nil, nil, tenantGetter)
+			err := s.SyncPools(ctx, tnnt)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("does not call the scheduler when the tenant declares no pools", func(t *testing.T) {
+			projectWithoutPools, _ := tenant.NewProject("proj", map[string]string{
+				tenant.ProjectStoragePathKey: "gs://location",
+				tenant.ProjectSchedulerHost:  "http://airflow.example.io",
+			})
+			tenantWithoutPools, _ := tenant.NewTenantDetails(projectWithoutPools, namespace, nil)
+
+			tenantGetter := new(mockTenantGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(tenantWithoutPools, nil)
+			defer tenantGetter.AssertExpectations(t)
+
+			client := new(mockClient)
+			defer client.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, client, nil, nil, nil, tenantGetter)
+			err := s.SyncPools(ctx, tnnt)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error when unable to list the pools of the scheduler", func(t *testing.T) {
+			tenantGetter := new(mockTenantGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(tenantWithPools, nil)
+			defer tenantGetter.AssertExpectations(t)
+
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("Get", ctx, project.Name()).Return(project, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			secretGetter := new(mockSecretGetter)
+			secretGetter.On("Get", ctx, project.Name(), "ns1", tenant.SecretSchedulerAuth).Return(authSecret, nil)
+			defer secretGetter.AssertExpectations(t)
+
+			client := new(mockClient)
+			client.On("Invoke", mock.Anything, listPoolsRequest(0), schdAuth).Return(nil, fmt.Errorf("some error"))
+			defer client.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, client, nil, projectGetter, secretGetter, tenantGetter)
+			err := s.SyncPools(ctx, tnnt)
+			assert.ErrorContains(t, err, "failure while fetching airflow pools")
+		})
+		t.Run("creates missing pools and updates pools with different slots across every page of existing pools", func(t *testing.T) {
+			tenantGetter := new(mockTenantGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(tenantWithPools, nil)
+			defer tenantGetter.AssertExpectations(t)
+
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("Get", ctx, project.Name()).Return(project, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			secretGetter := new(mockSecretGetter)
+			secretGetter.On("Get", ctx, project.Name(), "ns1", tenant.SecretSchedulerAuth).Return(authSecret, nil)
+			defer secretGetter.AssertExpectations(t)
+
+			client := new(mockClient)
+			client.On("Invoke", mock.Anything, listPoolsRequest(0), schdAuth).
+				Return([]byte(`{"pools":[{"name":"default_pool","slots":128},{"name":"sensor_pool","slots":1}],"total_entries":101}`), nil).Once()
+			client.On("Invoke", mock.Anything, listPoolsRequest(100), schdAuth).
+				Return([]byte(`{"pools":[{"name":"bq_pool","slots":4}],"total_entries":101}`), nil).Once()
+			client.On("Invoke", mock.Anything, createNewPool, schdAuth).Return([]byte(`{}`), nil).Once()
+			client.On("Invoke", mock.Anything, updateSensorPool, schdAuth).Return([]byte(`{}`), nil).Once()
+			defer client.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, client, nil, projectGetter, secretGetter, tenantGetter)
+			err := s.SyncPools(ctx, tnnt)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error for the pools failed to sync after syncing the others", func(t *testing.T) {
+			tenantGetter := new(mockTenantGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(tenantWithPools, nil)
+			defer tenantGetter.AssertExpectations(t)
+
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("Get", ctx, project.Name()).Return(project, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			secretGetter := new(mockSecretGetter)
+			secretGetter.On("Get", ctx, project.Name(), "ns1", tenant.SecretSchedulerAuth).Return(authSecret, nil)
+			defer secretGetter.AssertExpectations(t)
+
+			client := new(mockClient)
+			client.On("Invoke", mock.Anything, listPoolsRequest(0), schdAuth).
+				Return([]byte(`{"pools":[{"name":"bq_pool","slots":4},{"name":"sensor_pool","slots":1}],"total_entries":2}`), nil).Once()
+			client.On("Invoke", mock.Anything, createNewPool, schdAuth).Return(nil, fmt.Errorf("some error")).Once()
+			client.On("Invoke", mock.Anything, updateSensorPool, schdAuth).Return([]byte(`{}`), nil).Once()
+			defer client.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, client, nil, projectGetter, secretGetter, tenantGetter)
+			err := s.SyncPools(ctx, tnnt)
+			assert.ErrorContains(t, err, "failure while syncing pool new_pool")
+			assert.NotContains(t, err.Error(), "sensor_pool")
+		})
+	})
+	t.Run("UpdateJobRunState", func(t *testing.T) {
+		project, _ := tenant.NewProject("proj", map[string]string{
+			tenant.ProjectStoragePathKey: "gs://location",
+			tenant.ProjectSchedulerHost:  "http://airflow.example.io",
+		})
+		authSecret, _ := tenant.NewPlainTextSecret(tenant.SecretSchedulerAuth, "token")
+		schdAuth := SchedulerAuth{host: "airflow.example.io", token: "token"}
+		jobCron, _ := cron.ParseCronSchedule("0 12 * * *")
+		scheduledAt := time.Date(2022, 3, 21, 12, 0, 0, 0, time.UTC)
+		executionDate := jobCron.Prev(scheduledAt)
+
+		listRunsBody, _ := json.Marshal(DagRunRequest{
+			OrderBy:          "execution_date",
+			PageLimit:        pageLimit,
+			DagIds:           []string{"job1"},
+			ExecutionDateGte: executionDate.Format(airflowDateFormat),
+			ExecutionDateLte: executionDate.Format(airflowDateFormat),
+		})
+		listRuns := airflowRequest{path: dagStatusBatchURL, method: http.MethodPost, body: listRunsBody}
+		dagRuns := []byte(fmt.Sprintf(`{"dag_runs":[{"dag_run_id":"scheduled__1","execution_date":%q,"state":"failed"}],"total_entries":1}`,
+			executionDate.Format(time.RFC3339)))
+
+		t.Run("marks the dag runs as skipped", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			projectGetter.On("Get", ctx, project.Name()).Return(project, nil)
+			defer projectGetter.AssertExpectations(t)
+
+			secretGetter := new(mockSecretGetter)
+			secretGetter.On("Get", ctx, project.Name(), "ns1", tenant.SecretSchedulerAuth).Return(authSecret, nil)
+			defer secretGetter.AssertExpectations(t)
+
+			client := new(mockClient)
+			client.On("Invoke", mock.Anything, listRuns, schdAuth).Return(dagRuns, nil).Once()
+			client.On("Invoke", mock.Anything, airflowRequest{
+				path:   "api/v1/dags/job1/dagRuns/scheduled__1",
+				method: http.MethodPatch,
+				body:   []byte(`{"state": "skipped"}`),
+			}, schdAuth).Return([]byte(`{}`), nil).Once()
+			defer client.AssertExpectations(t)
+
+			s := NewScheduler(logger, nil, client, nil, projectGetter, secretGetter, nil)
+			err := s.UpdateJobRunState(ctx, tnnt, "job1", jobCron, []time.Time{scheduledAt}, scheduler.StateSkipped)
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type mockClient struct {
+	mock.Mock
+}
+
+func (m *mockClient) Invoke(ctx context.Context, r airflowRequest, auth SchedulerAuth) ([]byte, error) {
+	args := m.Called(ctx, r, auth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+type mockTenantGetter struct {
+	mock.Mock
+}
+
+func (m *mockTenantGetter) GetDetails(ctx context.Context, tnnt tenant.Tenant) (*tenant.WithDetails, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.WithDetails), args.Error(1)
+}
+
+type mockProjectGetter struct {
+	mock.Mock
+}
+
+func (m *mockProjectGetter) Get(ctx context.Context, projName tenant.ProjectName) (*tenant.Project, error) {
+	args := m.Called(ctx, projName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.Project), args.Error(1)
+}
+
+type mockSecretGetter struct {
+	mock.Mock
+}
+
+func (m *mockSecretGetter) Get(ctx context.Context, projName tenant.ProjectName, namespaceName, name string) (*tenant.PlainTextSecret, error) {
+	args := m.Called(ctx, projName, namespaceName, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.PlainTextSecret), args.Error(1)
+}
+
+type mockBucketFactory struct {
+	mock.Mock
+}
+
+func (m *mockBucketFactory) New(ctx context.Context, tnnt tenant.Tenant) (Bucket, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(Bucket), args.Error(1)
+}
+
+type mockDagCompiler struct {
+	mock.Mock
+}
+
+func (m *mockDagCompiler) Compile(job *scheduler.JobWithDetails) ([]byte, error) {
+	args := m.Called(job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
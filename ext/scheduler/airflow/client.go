@@ -25,6 +25,7 @@ const (
 
 type airflowRequest struct {
 	path   string
+	query  string
 	method string
 	body   []byte
 }
@@ -35,6 +36,7 @@ type DagRunListResponse struct {
 }
 
 type DagRun struct {
+	DagRunID        string    `json:"dag_run_id"`
 	ExecutionDate   time.Time `json:"execution_date"`
 	State           string    `json:"state"`
 	ExternalTrigger bool      `json:"external_trigger"`
@@ -49,6 +51,16 @@ type DagRunRequest struct {
 	ExecutionDateLte string   `json:"execution_date_lte,omitempty"`
 }
 
+type PoolListResponse struct {
+	Pools        []Pool `json:"pools"`
+	TotalEntries int    `json:"total_entries"`
+}
+
+type Pool struct {
+	Name  string `json:"name"`
+	Slots int    `json:"slots"`
+}
+
 type SchedulerAuth struct {
 	host  string
 	token string
@@ -65,7 +77,7 @@ func NewAirflowClient() *ClientAirflow {
 func (ac ClientAirflow) Invoke(ctx context.Context, r airflowRequest, auth SchedulerAuth) ([]byte, error) {
 	var resp []byte
 
-	endpoint := buildEndPoint(auth.host, r.path)
+	endpoint := buildEndPoint(auth.host, r.path, r.query)
 	request, err := http.NewRequestWithContext(ctx, r.method, endpoint, bytes.NewBuffer(r.body))
 	if err != nil {
 		return resp, fmt.Errorf("failed to build http request for %s due to %w", endpoint, err)
@@ -94,12 +106,13 @@ func parseResponse(resp *http.Response) ([]byte, error) {
 	return body, nil
 }
 
-func buildEndPoint(host, path string) string {
+func buildEndPoint(host, path, query string) string {
 	host = strings.Trim(host, "/")
 	u := &url.URL{
-		Scheme: "http",
-		Host:   host,
-		Path:   path,
+		Scheme:   "http",
+		Host:     host,
+		Path:     path,
+		RawQuery: query,
 	}
 	return u.String()
 }
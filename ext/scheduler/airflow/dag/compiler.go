@@ -46,6 +46,11 @@ func (c *Compiler) Compile(jobDetails *scheduler.JobWithDetails) ([]byte, error)
 
 	upstreams := SetupUpstreams(jobDetails.Upstreams, c.hostname)
 
+	businessCalendar, err := PrepareBusinessCalendar(jobDetails.Job)
+	if err != nil {
+		return nil, err
+	}
+
 	templateContext := TemplateContext{
 		JobDetails:      jobDetails,
 		Tenant:          jobDetails.Job.Tenant,
@@ -59,6 +64,8 @@ func (c *Compiler) Compile(jobDetails *scheduler.JobWithDetails) ([]byte, error)
 		RuntimeConfig:   runtimeConfig,
 		Priority:        jobDetails.Priority,
 		Upstreams:       upstreams,
+
+		BusinessCalendar: businessCalendar,
 	}
 
 	var buf bytes.Buffer
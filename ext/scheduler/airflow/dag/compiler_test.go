@@ -72,6 +72,61 @@ func TestDagCompiler(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, string(compiledTemplate), string(compiledDag))
 		})
+		t.Run("compiles template with the sensor and task pools of the job", func(t *testing.T) {
+			com, err := dag.NewDagCompiler("http://optimus.example.com", repo)
+			assert.NoError(t, err)
+
+			job := setupJobDetails(tnnt)
+			job.RuntimeConfig.Scheduler = map[string]string{"pool": "bq_pool", "sensor_pool": "sensor_pool"}
+			compiledDag, err := com.Compile(job)
+			assert.NoError(t, err)
+			assert.Contains(t, string(compiledDag), `pool="sensor_pool"`)
+			assert.NotContains(t, string(compiledDag), "pool=POOL_SENSOR")
+			assert.Contains(t, string(compiledDag), `pool="bq_pool"`)
+			assert.NotContains(t, string(compiledDag), "pool=POOL_TASK")
+
+			job.RuntimeConfig.Scheduler = map[string]string{"task_pool": "task_pool"}
+			compiledDag, err = com.Compile(job)
+			assert.NoError(t, err)
+			assert.Contains(t, string(compiledDag), `pool="task_pool"`)
+			assert.Contains(t, string(compiledDag), "pool=POOL_SENSOR")
+			assert.Contains(t, string(compiledDag), "pool=POOL_HOOK")
+		})
+		t.Run("compiles template skipping the non business days of the calendar of the window", func(t *testing.T) {
+			com, err := dag.NewDagCompiler("http://optimus.example.com", repo)
+			assert.NoError(t, err)
+
+			window, err := models.NewWindowWithCalendar(3, "d", "", "1b", "exchange")
+			assert.NoError(t, err)
+			window, err = models.WindowWithCalendar(window, map[string]string{
+				"CALENDAR_EXCHANGE": "weekdays=mon-thu;holidays=2023-12-25,2023-01-02",
+			})
+			assert.NoError(t, err)
+
+			job := setupJobDetails(tnnt)
+			job.Job.Window = window
+			compiledDag, err := com.Compile(job)
+			assert.NoError(t, err)
+			assert.Contains(t, string(compiledDag), `"weekdays": [1, 2, 3, 4],`)
+			assert.Contains(t, string(compiledDag), `"holidays": ["2023-01-02", "2023-12-25"],`)
+			assert.Contains(t, string(compiledDag), "skip_non_business_day >> wait_foo__dash__intra__dash__dep__dash__job\n")
+			assert.Contains(t, string(compiledDag), "skip_non_business_day >> hook_transporter\n")
+			assert.Contains(t, string(compiledDag), "skip_non_business_day >> transformation_bq__dash__bq\n")
+			assert.NotContains(t, string(compiledDag), "skip_non_business_day >> hook_predator")
+		})
+		t.Run("returns error when the calendar of the window is not bound", func(t *testing.T) {
+			com, err := dag.NewDagCompiler("http://optimus.example.com", repo)
+			assert.NoError(t, err)
+
+			window, err := models.NewWindowWithCalendar(3, "d", "", "1b", "exchange")
+			assert.NoError(t, err)
+
+			job := setupJobDetails(tnnt)
+			job.Job.Window = window
+			_, err = com.Compile(job)
+			assert.True(t, errors.IsErrorType(err, errors.ErrInvalidArgument))
+			assert.ErrorContains(t, err, "calendar [exchange] of the window is not loaded")
+		})
 	})
 }
 
@@ -102,8 +157,8 @@ func setupJobDetails(tnnt tenant.Tenant) *scheduler.JobWithDetails {
 
 	hooks := []*scheduler.Hook{
 		{Name: "transporter"},
-		{Name: "predator"},
-		{Name: "failureHook"},
+		{Name: "predator", RunIf: scheduler.HookRunIfAlways, RetryCount: 2, RetryDelay: time.Second * 30, Timeout: time.Minute * 10},
+		{Name: "failureHook", DependsOn: []string{"predator"}},
 	}
 
 	jobMeta := &scheduler.JobMetadata{
@@ -151,6 +206,10 @@ func setupJobDetails(tnnt tenant.Tenant) *scheduler.JobWithDetails {
 				JobName:  "foo-inter-dep-job",
 				TaskName: "bq-bq",
 				State:    "resolved",
+
+				RunOffset:        "-24h",
+				WindowSize:       "4h",
+				WindowTruncateTo: "h",
 			},
 			{
 				JobName:  "foo-external-optimus-dep-job",
@@ -159,6 +218,9 @@ func setupJobDetails(tnnt tenant.Tenant) *scheduler.JobWithDetails {
 				Tenant:   tnnt2,
 				External: true,
 				State:    "resolved",
+
+				Optional: true,
+				MaxWait:  2 * time.Hour,
 			},
 		},
 	}
@@ -211,7 +273,6 @@ func setupPluginRepo() mockPluginRepo {
 			Shell:  "/bin/sh",
 			Script: "java -cp /opt/transporter/transporter.jar:/opt/transporter/jolokia-jvm-agent.jar -javaagent:jolokia-jvm-agent.jar=port=7777,host=0.0.0.0 com.gojek.transporter.Main",
 		},
-		DependsOn: []string{"predator"},
 	}, nil)
 
 	predatorHook := "predator"
@@ -224,6 +285,7 @@ func setupPluginRepo() mockPluginRepo {
 			Shell:  "/bin/sh",
 			Script: "predator ${SUB_COMMAND} -s ${PREDATOR_URL} -u \"${BQ_PROJECT}.${BQ_DATASET}.${BQ_TABLE}\"",
 		},
+		DependsOn: []string{"transporter"},
 	}, nil)
 
 	hookUnit3 := new(mock.YamlMod)
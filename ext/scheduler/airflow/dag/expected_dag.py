@@ -8,11 +8,11 @@ from __lib import job_success_event, job_failure_event
 # import operator level callbacks
 from __lib import operator_start_event, operator_success_event, operator_retry_event, operator_failure_event
 
-from __lib import optimus_sla_miss_notify, SuperKubernetesPodOperator, SuperExternalTaskSensor
+from __lib import optimus_sla_miss_notify, SuperKubernetesPodOperator, SuperExternalTaskSensor, is_business_day
 
 from airflow.configuration import conf
 from airflow.models import DAG, Variable
-from airflow.operators.python_operator import PythonOperator
+from airflow.operators.python_operator import PythonOperator, ShortCircuitOperator
 from airflow.utils.weight_rule import WeightRule
 from kubernetes.client import models as k8s
 
@@ -82,6 +82,8 @@ def get_entrypoint_cmd(plugin_entrypoint_script):
     path_secret = JOB_DIR + "/in/.secret"
     entrypoint = "set -o allexport; source {path_config}; set +o allexport; cat {path_config}; ".format(path_config=path_config)
     entrypoint += "set -o allexport; source {path_secret}; set +o allexport; ".format(path_secret=path_secret)
+    # hooks with a run_if expression evaluated to false are compiled with OPTIMUS_SKIP_HOOK
+    entrypoint += 'if [ "$OPTIMUS_SKIP_HOOK" = "true" ]; then echo "skipping hook, run_if is false"; exit 0; fi; '
     return entrypoint + plugin_entrypoint_script
 
 volume = k8s.V1Volume(
@@ -207,6 +209,10 @@ hook_predator = SuperKubernetesPodOperator(
     is_delete_operator_pod=True,
     do_xcom_push=False,
     env_vars=executor_env_vars,
+    trigger_rule="all_done",
+    retries=2,
+    retry_delay=timedelta(seconds=30),
+    execution_timeout=timedelta(seconds=600),
     resources=resources,
     reattach_on_restart=True,
     volume_mounts=asset_volume_mounts,
@@ -276,8 +282,11 @@ wait_foo__dash__inter__dash__dep__dash__job = SuperExternalTaskSensor(
     upstream_optimus_project="project",
     upstream_optimus_namespace="namespace",
     upstream_optimus_job="foo-inter-dep-job",
-    window_size="1h",
-    window_version=int("1"),
+    window_size="4h",
+    window_offset="",
+    window_truncate_to="h",
+    window_version=int("2"),
+    run_offset="-24h",
     poke_interval=SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS,
     timeout=SENSOR_DEFAULT_TIMEOUT_IN_SECS,
     task_id="wait_foo-inter-dep-job-bq-bq",
@@ -294,6 +303,8 @@ wait_foo__dash__external__dash__optimus__dash__dep__dash__job = SuperExternalTas
     upstream_optimus_job="foo-external-optimus-dep-job",
     window_size="1h",
     window_version=int("1"),
+    optional=True,
+    max_wait_in_secs=int("7200"),
     poke_interval=SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS,
     timeout=SENSOR_DEFAULT_TIMEOUT_IN_SECS,
     task_id="wait_foo-external-optimus-dep-job-bq-bq",
@@ -318,4 +329,5 @@ hook_transporter >> transformation_bq__dash__bq
 transformation_bq__dash__bq >> [hook_predator,] >> [hook_failureHook,]
 
 # set inter-dependencies between hooks and hooks
-hook_predator >> hook_transporter
+hook_transporter >> hook_predator
+hook_predator >> hook_failureHook
@@ -6,6 +6,7 @@ import (
 	"github.com/raystack/optimus/core/scheduler"
 	"github.com/raystack/optimus/core/tenant"
 	"github.com/raystack/optimus/internal/errors"
+	"github.com/raystack/optimus/internal/models"
 	"github.com/raystack/optimus/sdk/plugin"
 )
 
@@ -28,6 +29,8 @@ type TemplateContext struct {
 	Hooks         Hooks
 	Priority      int
 	Upstreams     Upstreams
+
+	BusinessCalendar *BusinessCalendar
 }
 
 type Task struct {
@@ -56,13 +59,23 @@ type Hook struct {
 	Image      string
 	Entrypoint plugin.Entrypoint
 	IsFailHook bool
+
+	TriggerRule       string
+	Retries           int
+	RetryDelaySeconds int64
+	TimeoutSeconds    int64
+}
+
+type HookDependency struct {
+	Before string
+	After  string
 }
 
 type Hooks struct {
 	Pre          []Hook
 	Post         []Hook
 	Fail         []Hook
-	Dependencies map[string]string
+	Dependencies []HookDependency
 }
 
 func (h Hooks) List() []Hook { //nolint: gocritic
@@ -74,7 +87,14 @@ func (h Hooks) List() []Hook { //nolint: gocritic
 
 func PrepareHooksForJob(job *scheduler.Job, pluginRepo PluginRepo) (Hooks, error) {
 	var hooks Hooks
-	hooks.Dependencies = map[string]string{}
+	added := map[HookDependency]bool{}
+	addDependency := func(before, after string) {
+		dependency := HookDependency{Before: before, After: after}
+		if !added[dependency] {
+			added[dependency] = true
+			hooks.Dependencies = append(hooks.Dependencies, dependency)
+		}
+	}
 
 	for _, h := range job.Hooks {
 		hook, err := pluginRepo.GetByName(h.Name)
@@ -84,9 +104,19 @@ func PrepareHooksForJob(job *scheduler.Job, pluginRepo PluginRepo) (Hooks, error
 
 		info := hook.Info()
 		hk := Hook{
-			Name:       h.Name,
-			Image:      info.Image,
-			Entrypoint: info.Entrypoint,
+			Name:              h.Name,
+			Image:             info.Image,
+			Entrypoint:        info.Entrypoint,
+			Retries:           h.RetryCount,
+			RetryDelaySeconds: int64(h.RetryDelay.Seconds()),
+			TimeoutSeconds:    int64(h.Timeout.Seconds()),
+		}
+		if info.HookType == plugin.HookTypeFail {
+			hk.IsFailHook = true
+			hk.TriggerRule = TriggerRuleOneFailed
+		}
+		if triggerRule := triggerRuleFor(h.RunIf); triggerRule != "" {
+			hk.TriggerRule = triggerRule
 		}
 		switch info.HookType {
 		case plugin.HookTypePre:
@@ -94,7 +124,6 @@ func PrepareHooksForJob(job *scheduler.Job, pluginRepo PluginRepo) (Hooks, error
 		case plugin.HookTypePost:
 			hooks.Post = append(hooks.Post, hk)
 		case plugin.HookTypeFail:
-			hk.IsFailHook = true
 			hooks.Fail = append(hooks.Fail, hk)
 		}
 
@@ -103,13 +132,36 @@ func PrepareHooksForJob(job *scheduler.Job, pluginRepo PluginRepo) (Hooks, error
 			if err != nil {
 				continue
 			}
-			hooks.Dependencies[before] = h.Name
+			addDependency(before, h.Name)
+		}
+		for _, before := range h.DependsOn {
+			addDependency(before, h.Name)
 		}
 	}
-
 	return hooks, nil
 }
 
+const (
+	TriggerRuleAllSuccess = "all_success"
+	TriggerRuleOneFailed  = "one_failed"
+	TriggerRuleAllDone    = "all_done"
+)
+
+// triggerRuleFor returns the airflow trigger rule of the run_if of a hook, an expression is
+// evaluated when the hook input is compiled, so it keeps the default rule of the hook type
+func triggerRuleFor(runIf string) string {
+	switch runIf {
+	case scheduler.HookRunIfSuccess:
+		return TriggerRuleAllSuccess
+	case scheduler.HookRunIfFailure:
+		return TriggerRuleOneFailed
+	case scheduler.HookRunIfAlways:
+		return TriggerRuleAllDone
+	default:
+		return ""
+	}
+}
+
 type RuntimeConfig struct {
 	Resource *Resource
 	Airflow  AirflowConfig
@@ -168,15 +220,26 @@ func ToResourceConfig(config *scheduler.ResourceConfig) *ResourceConfig {
 }
 
 type AirflowConfig struct {
-	Pool  string
-	Queue string
+	Pool       string
+	SensorPool string
+	TaskPool   string
+	Queue      string
 }
 
+// ToAirflowConfig falls back to the pool of the job for the sensors and the task when their own pool is not given
 func ToAirflowConfig(schedulerConf map[string]string) AirflowConfig {
 	conf := AirflowConfig{}
 	if pool, ok := schedulerConf["pool"]; ok {
 		conf.Pool = pool
 	}
+	conf.SensorPool = conf.Pool
+	if sensorPool := schedulerConf["sensor_pool"]; sensorPool != "" {
+		conf.SensorPool = sensorPool
+	}
+	conf.TaskPool = conf.Pool
+	if taskPool := schedulerConf["task_pool"]; taskPool != "" {
+		conf.TaskPool = taskPool
+	}
 	if queue, ok := schedulerConf["queue"]; ok {
 		conf.Queue = queue
 	}
@@ -201,3 +264,30 @@ func SLAMissDuration(job *scheduler.JobWithDetails) (int64, error) {
 	}
 	return slaMissDurationInSec, nil
 }
+
+// BusinessCalendar holds the business days of the calendar of a window, the runs scheduled on other days are skipped
+type BusinessCalendar struct {
+	// Weekdays are numbered from sunday as 0
+	Weekdays []int
+	Holidays []string
+}
+
+func PrepareBusinessCalendar(job *scheduler.Job) (*BusinessCalendar, error) {
+	calendarWindow, ok := job.Window.(models.CalendarWindow)
+	if !ok {
+		return nil, nil
+	}
+	calendar, err := calendarWindow.BusinessCalendar()
+	if err != nil {
+		return nil, errors.InvalidArgument(EntitySchedulerAirflow, "calendar of job "+job.Name.String()+": "+err.Error())
+	}
+
+	weekdays := make([]int, 0, len(calendar.Weekdays()))
+	for _, weekday := range calendar.Weekdays() {
+		weekdays = append(weekdays, int(weekday))
+	}
+	return &BusinessCalendar{
+		Weekdays: weekdays,
+		Holidays: calendar.Holidays(),
+	}, nil
+}
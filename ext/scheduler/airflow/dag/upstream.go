@@ -5,6 +5,9 @@ import (
 	"github.com/raystack/optimus/core/tenant"
 )
 
+// upstreamWindowVersion is the version of the windows set on the upstreams
+const upstreamWindowVersion = 2
+
 type Upstreams struct {
 	HTTP      []*scheduler.HTTPUpstreams
 	Upstreams []Upstream
@@ -22,6 +25,21 @@ type Upstream struct {
 	Tenant   tenant.Tenant
 	Host     string
 	TaskName string
+
+	RunOffset string
+	Window    *UpstreamWindow
+
+	// Optional upstreams let the sensor succeed when the upstream is disabled or not done within MaxWaitInSecs
+	Optional      bool
+	MaxWaitInSecs int64
+}
+
+// UpstreamWindow replaces the window of the job when the sensor checks the upstream runs
+type UpstreamWindow struct {
+	Size       string
+	Offset     string
+	TruncateTo string
+	Version    int
 }
 
 func SetupUpstreams(upstreams scheduler.Upstreams, host string) Upstreams {
@@ -34,10 +52,22 @@ func SetupUpstreams(upstreams scheduler.Upstreams, host string) Upstreams {
 			upstreamHost = u.Host
 		}
 		upstream := Upstream{
-			JobName:  u.JobName,
-			Tenant:   u.Tenant,
-			Host:     upstreamHost,
-			TaskName: u.TaskName,
+			JobName:   u.JobName,
+			Tenant:    u.Tenant,
+			Host:      upstreamHost,
+			TaskName:  u.TaskName,
+			RunOffset: u.RunOffset,
+
+			Optional:      u.Optional,
+			MaxWaitInSecs: int64(u.MaxWait.Seconds()),
+		}
+		if u.WindowSize != "" {
+			upstream.Window = &UpstreamWindow{
+				Size:       u.WindowSize,
+				Offset:     u.WindowOffset,
+				TruncateTo: u.WindowTruncateTo,
+				Version:    upstreamWindowVersion,
+			}
 		}
 		ups = append(ups, upstream)
 	}
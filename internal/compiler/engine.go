@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"text/template"
+	"text/template/parse"
 	"time"
 
 	"github.com/raystack/optimus/internal/errors"
@@ -17,52 +19,277 @@ const (
 	ISODateFormat = "2006-01-02"
 
 	ISOTimeFormat = time.RFC3339
+
+	DefaultExecutionTimeout = time.Second * 10
+	DefaultMaxOutputSize    = 10 * 1024 * 1024
+	DefaultMaxIterations    = 1000000
+
+	rangeGuardFunction = "optimusRangeGuard"
 )
 
 // Engine compiles a set of defined macros using the provided context
 type Engine struct {
 	baseTemplate *template.Template
+
+	executionTimeout time.Duration
+	maxOutputSize    int
+	maxIterations    int64
 }
 
 func NewEngine() *Engine {
+	return NewEngineWithLimits(DefaultExecutionTimeout, DefaultMaxOutputSize)
+}
+
+// NewEngineWithLimits creates an engine which fails the rendering of a template taking longer than
+// the execution timeout or producing more bytes than the max output size
+func NewEngineWithLimits(executionTimeout time.Duration, maxOutputSize int) *Engine {
 	baseTemplate := template.
 		New("optimus_template_engine").
-		Funcs(OptimusFuncMap())
+		Funcs(OptimusFuncMap()).
+		Funcs(template.FuncMap{rangeGuardFunction: func() string { return "" }})
 
 	return &Engine{
-		baseTemplate: baseTemplate,
+		baseTemplate:     baseTemplate,
+		executionTimeout: executionTimeout,
+		maxOutputSize:    maxOutputSize,
+		maxIterations:    DefaultMaxIterations,
 	}
 }
 
 func (e *Engine) Compile(templateMap map[string]string, context map[string]any) (map[string]string, error) {
-	rendered := map[string]string{}
+	return e.CompileWithLibraries(templateMap, nil, context)
+}
+
+// CompileWithLibraries compiles the templates which can pull in the libraries by their template name,
+// either through the template action or the include function, eg. {{ include "lib/dates" . }}
+func (e *Engine) CompileWithLibraries(templateMap, libraries map[string]string, context map[string]any) (map[string]string, error) {
+	baseTemplate, err := e.templateWithLibraries(libraries)
+	if err != nil {
+		return nil, err
+	}
 
+	rendered := map[string]string{}
 	for name, content := range templateMap {
-		tmpl, err := e.baseTemplate.New(name).Parse(content)
+		tmpl, err := baseTemplate.New(name).Parse(content)
 		if err != nil {
 			msg := fmt.Sprintf("unable to parse content for %s: %s", name, err.Error())
 			return nil, errors.InvalidArgument(EntityCompiler, msg)
 		}
 
-		var buf bytes.Buffer
-		err = tmpl.Execute(&buf, context)
+		output, err := e.execute(tmpl, context)
 		if err != nil {
 			msg := fmt.Sprintf("unable to render content for %s: %s", name, err.Error())
 			return nil, errors.InvalidArgument(EntityCompiler, msg)
 		}
-		rendered[name] = strings.TrimSpace(buf.String())
+		rendered[name] = strings.TrimSpace(output)
 	}
 	return rendered, nil
 }
 
+func (e *Engine) templateWithLibraries(libraries map[string]string) (*template.Template, error) {
+	baseTemplate, err := e.baseTemplate.Clone()
+	if err != nil {
+		return nil, errors.InternalError(EntityCompiler, "unable to prepare template", err)
+	}
+	baseTemplate.Funcs(template.FuncMap{
+		"include": func(name string, data any) (string, error) {
+			writer := newLimitedWriter(e.maxOutputSize)
+			if err := baseTemplate.ExecuteTemplate(writer, name, data); err != nil {
+				return "", err
+			}
+			return writer.String(), nil
+		},
+	})
+
+	for name, content := range libraries {
+		templateName := LibraryTemplateName(name)
+		if _, err := baseTemplate.New(templateName).Parse(content); err != nil {
+			msg := fmt.Sprintf("unable to parse library %s: %s", name, err.Error())
+			return nil, errors.InvalidArgument(EntityCompiler, msg)
+		}
+	}
+	return baseTemplate, nil
+}
+
 func (e *Engine) CompileString(input string, context map[string]any) (string, error) {
-	tmpl, err := e.baseTemplate.New("base").Parse(input)
+	baseTemplate, err := e.templateWithLibraries(nil)
+	if err != nil {
+		return "", err
+	}
+	tmpl, err := baseTemplate.New("base").Parse(input)
 	if err != nil {
 		return "", errors.InvalidArgument(EntityCompiler, "unable to parse string "+input)
 	}
-	var buf bytes.Buffer
-	if err = tmpl.Execute(&buf, context); err != nil {
+	output, err := e.execute(tmpl, context)
+	if err != nil {
 		return "", errors.InvalidArgument(EntityCompiler, "unable to render string "+input)
 	}
-	return strings.TrimSpace(buf.String()), nil
+	return strings.TrimSpace(output), nil
+}
+
+// ValidateTemplate checks the content can be parsed with the functions available to templates
+func ValidateTemplate(content string) error {
+	if _, err := template.New("validate").Funcs(OptimusFuncMap()).Parse(content); err != nil {
+		return errors.InvalidArgument(EntityCompiler, "unable to parse template: "+err.Error())
+	}
+	return nil
+}
+
+// execute renders the template within the limits of the engine. Every range iteration and every element of the
+// collections built by the template count against the max iterations, so a template still running past the
+// timeout, even without writing anything, fails on its next iteration or write once abandoned
+func (e *Engine) execute(tmpl *template.Template, data any) (string, error) {
+	budget := newIterationBudget(e.maxIterations)
+	tmpl.Funcs(budget.funcMap())
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			guardRanges(t.Tree.Root)
+		}
+	}
+
+	writer := newLimitedWriter(e.maxOutputSize)
+	done := make(chan error, 1)
+	go func() {
+		done <- tmpl.Execute(writer, data)
+	}()
+
+	timer := time.NewTimer(e.executionTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", err
+		}
+		return writer.String(), nil
+	case <-timer.C:
+		writer.abort()
+		budget.abort()
+		return "", fmt.Errorf("template execution exceeded %s", e.executionTimeout)
+	}
+}
+
+// limitedWriter fails the writes beyond the max size, or after being aborted
+type limitedWriter struct {
+	buf     bytes.Buffer
+	maxSize int
+	aborted atomic.Bool
+}
+
+func newLimitedWriter(maxSize int) *limitedWriter {
+	return &limitedWriter{maxSize: maxSize}
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.aborted.Load() {
+		return 0, fmt.Errorf("template execution aborted")
+	}
+	if w.buf.Len()+len(p) > w.maxSize {
+		return 0, fmt.Errorf("template output exceeded %d bytes", w.maxSize)
+	}
+	return w.buf.Write(p)
+}
+
+func (w *limitedWriter) String() string {
+	return w.buf.String()
+}
+
+func (w *limitedWriter) abort() {
+	w.aborted.Store(true)
+}
+
+// iterationBudget fails the iterations beyond the max count, or after being aborted
+type iterationBudget struct {
+	remaining atomic.Int64
+	max       int64
+	aborted   atomic.Bool
+}
+
+func newIterationBudget(max int64) *iterationBudget {
+	budget := &iterationBudget{max: max}
+	budget.remaining.Store(max)
+	return budget
+}
+
+func (b *iterationBudget) spend(count int) error {
+	if b.aborted.Load() {
+		return fmt.Errorf("template execution aborted")
+	}
+	if b.remaining.Add(-int64(count)) < 0 {
+		return fmt.Errorf("template execution exceeded %d iterations", b.max)
+	}
+	return nil
+}
+
+func (b *iterationBudget) abort() {
+	b.aborted.Store(true)
+}
+
+func (b *iterationBudget) collection(values []string, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := b.spend(len(values)); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// funcMap overrides the functions building collections to spend the budget on their elements,
+// along with the guard spending it on every range iteration
+func (b *iterationBudget) funcMap() template.FuncMap {
+	return template.FuncMap{
+		rangeGuardFunction: func() (string, error) {
+			return "", b.spend(1)
+		},
+		"partitions": func(interval, startIsoTime, endIsoTime string) ([]string, error) {
+			return b.collection(Partitions(interval, startIsoTime, endIsoTime))
+		},
+		"partitionDates": func(startIsoTime, endIsoTime string) ([]string, error) {
+			return b.collection(PartitionDates(startIsoTime, endIsoTime))
+		},
+		"split": func(sep, s string) ([]string, error) {
+			return b.collection(Split(sep, s), nil)
+		},
+		"list": func(values ...string) ([]string, error) {
+			return b.collection(List(values...), nil)
+		},
+		"keys": func(d map[string]any) ([]string, error) {
+			return b.collection(Keys(d), nil)
+		},
+	}
+}
+
+// rangeGuardNode calls the range guard, it is added at the start of the body of every range
+var rangeGuardNode = func() parse.Node {
+	trees, err := parse.Parse("guard", "{{"+rangeGuardFunction+"}}", "", "", map[string]any{rangeGuardFunction: true})
+	if err != nil {
+		panic(err)
+	}
+	return trees["guard"].Root.Nodes[0]
+}()
+
+// guardRanges adds the range guard to the ranges within the node, once
+func guardRanges(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			guardRanges(child)
+		}
+	case *parse.IfNode:
+		guardRanges(n.List)
+		guardRanges(n.ElseList)
+	case *parse.WithNode:
+		guardRanges(n.List)
+		guardRanges(n.ElseList)
+	case *parse.RangeNode:
+		if len(n.List.Nodes) == 0 || n.List.Nodes[0] != rangeGuardNode {
+			n.List.Nodes = append([]parse.Node{rangeGuardNode}, n.List.Nodes...)
+		}
+		guardRanges(n.List)
+		guardRanges(n.ElseList)
+	}
 }
@@ -1,7 +1,9 @@
 package compiler_test
 
 import (
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -159,4 +161,101 @@ func TestEngine(t *testing.T) {
 			}
 		})
 	})
+	t.Run("CompileWithLibraries", func(t *testing.T) {
+		context := map[string]interface{}{
+			"DSTART": "2021-02-10T10:00:00+00:00",
+			"DEND":   "2021-02-11T10:00:00+00:00",
+		}
+		libraries := map[string]string{
+			"dates": `{{ define "partition" }}__PARTITION__ >= "{{ .DSTART | Date }}" AND __PARTITION__ < "{{ .DEND | Date }}"{{ end }}date_range`,
+		}
+
+		t.Run("returns rendered templates pulling in libraries through template and include", func(t *testing.T) {
+			templateMap := map[string]string{
+				"query.sql":  `SELECT * FROM table WHERE {{ template "partition" . }}`,
+				"range.sql":  `{{ template "lib/dates" . }}`,
+				"upper.sql":  `{{ include "lib/dates" . | replace "_" "-" }}`,
+				"simple.sql": `SELECT 1`,
+			}
+
+			comp := compiler.NewEngine()
+			compiled, err := comp.CompileWithLibraries(templateMap, libraries, context)
+
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"query.sql":  `SELECT * FROM table WHERE __PARTITION__ >= "2021-02-10" AND __PARTITION__ < "2021-02-11"`,
+				"range.sql":  `date_range`,
+				"upper.sql":  `date-range`,
+				"simple.sql": `SELECT 1`,
+			}, compiled)
+		})
+		t.Run("returns error when library cannot be parsed", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileWithLibraries(map[string]string{"query.sql": `SELECT 1`}, map[string]string{"broken": "{{ .DSTART"}, context)
+
+			assert.ErrorContains(t, err, "unable to parse library broken")
+		})
+		t.Run("returns error when library is not registered", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileWithLibraries(map[string]string{"query.sql": `{{ include "lib/other" . }}`}, libraries, context)
+
+			assert.ErrorContains(t, err, "unable to render content for query.sql")
+		})
+	})
+	t.Run("limits", func(t *testing.T) {
+		context := map[string]interface{}{
+			"DSTART": "2021-02-10T10:00:00+00:00",
+			"DEND":   "2021-02-20T10:00:00+00:00",
+		}
+
+		t.Run("returns error when output exceeds the max size", func(t *testing.T) {
+			comp := compiler.NewEngineWithLimits(time.Second, 64)
+			_, err := comp.Compile(map[string]string{
+				"query.sql": `{{ range partitionDates .DSTART .DEND }}'{{ . }}',{{ end }}`,
+			}, context)
+
+			assert.ErrorContains(t, err, "unable to render content for query.sql")
+			assert.ErrorContains(t, err, "template output exceeded 64 bytes")
+		})
+		t.Run("returns error when execution exceeds the timeout", func(t *testing.T) {
+			comp := compiler.NewEngineWithLimits(time.Millisecond*50, 1<<40)
+			_, err := comp.Compile(map[string]string{
+				"query.sql": `{{ $minutes := partitions "1m" "2021-01-01T00:00:00Z" "2021-01-07T00:00:00Z" }}` +
+					`{{ range $minutes }}{{ range $minutes }}{{ range $minutes }}.{{ end }}{{ end }}{{ end }}`,
+			}, context)
+
+			assert.ErrorContains(t, err, "template execution exceeded 50ms")
+		})
+		t.Run("returns error when a write free nested loop exceeds the max iterations", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.Compile(map[string]string{
+				"query.sql": `{{ $minutes := partitions "1m" "2021-01-01T00:00:00Z" "2021-01-07T00:00:00Z" }}` +
+					`{{ range $minutes }}{{ range $minutes }}{{ range split "," "a,b,c" }}{{ end }}{{ end }}{{ end }}`,
+			}, context)
+
+			assert.ErrorContains(t, err, "template execution exceeded 1000000 iterations")
+		})
+		t.Run("stops a write free nested loop abandoned on timeout", func(t *testing.T) {
+			goroutines := runtime.NumGoroutine()
+
+			comp := compiler.NewEngineWithLimits(time.Millisecond, 1<<40)
+			_, err := comp.CompileString(`{{ $minutes := partitions "1m" "2021-01-01T00:00:00Z" "2021-01-07T00:00:00Z" }}`+
+				`{{ range $minutes }}{{ range $minutes }}{{ range $minutes }}{{ end }}{{ end }}{{ end }}`, context)
+
+			assert.ErrorContains(t, err, "unable to render string")
+			for i := 0; i < 500 && runtime.NumGoroutine() > goroutines; i++ {
+				time.Sleep(time.Millisecond * 10)
+			}
+			assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+		})
+		t.Run("returns rendered content within the limits", func(t *testing.T) {
+			comp := compiler.NewEngineWithLimits(time.Second, 64)
+			compiled, err := comp.Compile(map[string]string{
+				"query.sql": `{{ range partitionDates .DSTART .DEND }}{{ if lt (len .) 0 }}{{ end }}{{ end }}done`,
+			}, context)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "done", compiled["query.sql"])
+		})
+	})
 }
@@ -0,0 +1,39 @@
+package compiler
+
+import (
+	"regexp"
+	"sort"
+)
+
+// LibraryTemplatePrefix prefixes the template name of a library, assets pull in the library through
+// the template name, eg. {{ template "lib/dates" . }}
+const LibraryTemplatePrefix = "lib/"
+
+var libraryReferencePattern = regexp.MustCompile(`(?:template|include)\s+"` + LibraryTemplatePrefix + `([\w\-]+)"`)
+
+func LibraryTemplateName(name string) string {
+	return LibraryTemplatePrefix + name
+}
+
+// ValidateLibrary checks the library content can be parsed to be pulled in by the templates
+func ValidateLibrary(name, content string) error {
+	_, err := NewEngine().templateWithLibraries(map[string]string{name: content})
+	return err
+}
+
+// LibrariesUsedBy returns the sorted names of the libraries referred by the templates
+func LibrariesUsedBy(templateMap map[string]string) []string {
+	usedLibraries := map[string]bool{}
+	for _, content := range templateMap {
+		for _, match := range libraryReferencePattern.FindAllStringSubmatch(content, -1) {
+			usedLibraries[match[1]] = true
+		}
+	}
+
+	var names []string
+	for name := range usedLibraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,23 @@
+package compiler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/internal/compiler"
+)
+
+func TestLibrariesUsedBy(t *testing.T) {
+	t.Run("returns libraries referred by template action and include function", func(t *testing.T) {
+		templateMap := map[string]string{
+			"query.sql":  `SELECT * FROM {{ template "lib/tables" . }} WHERE {{ include "lib/dates" . }}`,
+			"filter.sql": `{{template "lib/dates" .}} AND {{ template "partition" . }}`,
+		}
+
+		assert.Equal(t, []string{"dates", "tables"}, compiler.LibrariesUsedBy(templateMap))
+	})
+	t.Run("returns empty when no library is referred", func(t *testing.T) {
+		assert.Empty(t, compiler.LibrariesUsedBy(map[string]string{"query.sql": `SELECT "lib/dates"`}))
+	})
+}
@@ -1,24 +1,161 @@
 package compiler
 
 import (
+	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 	"time"
 )
 
+const (
+	FunctionCategoryTime       = "time"
+	FunctionCategoryString     = "string"
+	FunctionCategoryCollection = "collection"
+	FunctionCategoryMath       = "math"
+	FunctionCategoryEncoding   = "encoding"
+)
+
+// TemplateFunction documents a function available to the templates of job assets and configs
+type TemplateFunction struct {
+	Name        string
+	Signature   string
+	Description string
+	Category    string
+
+	fn any
+}
+
+// templateFunctions is the audited set of functions available to templates, none of them can reach
+// the filesystem, the network or the environment of the server
+var templateFunctions = []TemplateFunction{
+	{Name: "Date", Signature: "Date(isoTime string) string", Category: FunctionCategoryTime, fn: Date,
+		Description: "formats an RFC3339 time as a YYYY-MM-DD date"},
+	{Name: "date", Signature: "date(layout string, t time.Time) string", Category: FunctionCategoryTime, fn: date,
+		Description: "formats a time with a go layout in the local timezone of the server"},
+	{Name: "date_modify", Signature: "date_modify(duration string, t time.Time) time.Time", Category: FunctionCategoryTime, fn: DateModify,
+		Description: "adds a go duration, eg. -24h, to a time"},
+	{Name: "toDate", Signature: "toDate(layout, value string) time.Time", Category: FunctionCategoryTime, fn: toDate,
+		Description: "parses a string with a go layout into a time"},
+	{Name: "unixEpoch", Signature: "unixEpoch(t time.Time) string", Category: FunctionCategoryTime, fn: UnixEpoch,
+		Description: "returns the seconds elapsed since the unix epoch"},
+	{Name: "formatTime", Signature: "formatTime(layout, isoTime string) string", Category: FunctionCategoryTime, fn: FormatTime,
+		Description: "formats an RFC3339 time with a go layout"},
+	{Name: "toTimezone", Signature: "toTimezone(zone, isoTime string) string", Category: FunctionCategoryTime, fn: ToTimezone,
+		Description: "converts an RFC3339 time to an IANA timezone, eg. Asia/Jakarta"},
+	{Name: "partitions", Signature: "partitions(interval, startIsoTime, endIsoTime string) []string", Category: FunctionCategoryTime, fn: Partitions,
+		Description: "lists the RFC3339 times from start, inclusive, to end, exclusive, stepped by a go duration, eg. 1h"},
+	{Name: "partitionDates", Signature: "partitionDates(startIsoTime, endIsoTime string) []string", Category: FunctionCategoryTime, fn: PartitionDates,
+		Description: "lists the YYYY-MM-DD dates from start, inclusive, to end, exclusive"},
+
+	{Name: "replace", Signature: "replace(old, new, s string) string", Category: FunctionCategoryString, fn: Replace,
+		Description: "replaces all occurrences of old in s with new"},
+	{Name: "trunc", Signature: "trunc(length int, s string) string", Category: FunctionCategoryString, fn: Trunc,
+		Description: "truncates s to length, negative length keeps s as is"},
+	{Name: "upper", Signature: "upper(s string) string", Category: FunctionCategoryString, fn: strings.ToUpper,
+		Description: "converts s to upper case"},
+	{Name: "lower", Signature: "lower(s string) string", Category: FunctionCategoryString, fn: strings.ToLower,
+		Description: "converts s to lower case"},
+	{Name: "title", Signature: "title(s string) string", Category: FunctionCategoryString, fn: Title,
+		Description: "upper cases the first letter of every word in s"},
+	{Name: "snakecase", Signature: "snakecase(s string) string", Category: FunctionCategoryString, fn: SnakeCase,
+		Description: "converts s to snake_case"},
+	{Name: "camelcase", Signature: "camelcase(s string) string", Category: FunctionCategoryString, fn: CamelCase,
+		Description: "converts s to camelCase"},
+	{Name: "kebabcase", Signature: "kebabcase(s string) string", Category: FunctionCategoryString, fn: KebabCase,
+		Description: "converts s to kebab-case"},
+	{Name: "trim", Signature: "trim(s string) string", Category: FunctionCategoryString, fn: strings.TrimSpace,
+		Description: "removes the leading and trailing white space of s"},
+	{Name: "trimPrefix", Signature: "trimPrefix(prefix, s string) string", Category: FunctionCategoryString, fn: TrimPrefix,
+		Description: "removes prefix from the start of s"},
+	{Name: "trimSuffix", Signature: "trimSuffix(suffix, s string) string", Category: FunctionCategoryString, fn: TrimSuffix,
+		Description: "removes suffix from the end of s"},
+	{Name: "contains", Signature: "contains(substr, s string) bool", Category: FunctionCategoryString, fn: Contains,
+		Description: "tells whether s contains substr"},
+	{Name: "hasPrefix", Signature: "hasPrefix(prefix, s string) bool", Category: FunctionCategoryString, fn: HasPrefix,
+		Description: "tells whether s starts with prefix"},
+	{Name: "hasSuffix", Signature: "hasSuffix(suffix, s string) bool", Category: FunctionCategoryString, fn: HasSuffix,
+		Description: "tells whether s ends with suffix"},
+	{Name: "split", Signature: "split(sep, s string) []string", Category: FunctionCategoryString, fn: Split,
+		Description: "splits s around every sep"},
+	{Name: "quote", Signature: "quote(s string) string", Category: FunctionCategoryString, fn: Quote,
+		Description: "wraps s in double quotes, escaping the quotes inside"},
+	{Name: "squote", Signature: "squote(s string) string", Category: FunctionCategoryString, fn: SingleQuote,
+		Description: "wraps s in single quotes, escaping the quotes inside"},
+
+	{Name: "list", Signature: "list(values ...string) []string", Category: FunctionCategoryCollection, fn: List,
+		Description: "returns the values as a list"},
+	{Name: "join", Signature: "join(sep string, values []string) string", Category: FunctionCategoryCollection, fn: Join,
+		Description: "joins the values with sep"},
+	{Name: "dict", Signature: "dict(key1 string, value1 any, ...) map[string]any", Category: FunctionCategoryCollection, fn: Dict,
+		Description: "builds a dictionary from pairs of key and value"},
+	{Name: "get", Signature: "get(key string, d map[string]any) any", Category: FunctionCategoryCollection, fn: Get,
+		Description: "returns the value of key in the dictionary, empty string when missing"},
+	{Name: "hasKey", Signature: "hasKey(key string, d map[string]any) bool", Category: FunctionCategoryCollection, fn: HasKey,
+		Description: "tells whether the dictionary has key"},
+	{Name: "keys", Signature: "keys(d map[string]any) []string", Category: FunctionCategoryCollection, fn: Keys,
+		Description: "returns the sorted keys of the dictionary"},
+
+	{Name: "add", Signature: "add(a, b int) int", Category: FunctionCategoryMath, fn: Add,
+		Description: "returns a + b"},
+	{Name: "sub", Signature: "sub(a, b int) int", Category: FunctionCategoryMath, fn: Sub,
+		Description: "returns a - b"},
+	{Name: "mul", Signature: "mul(a, b int) int", Category: FunctionCategoryMath, fn: Mul,
+		Description: "returns a * b"},
+	{Name: "div", Signature: "div(a, b int) int", Category: FunctionCategoryMath, fn: Div,
+		Description: "returns the integer division of a by b, fails when b is zero"},
+	{Name: "mod", Signature: "mod(a, b int) int", Category: FunctionCategoryMath, fn: Mod,
+		Description: "returns the remainder of a divided by b, fails when b is zero"},
+	{Name: "max", Signature: "max(a, b int) int", Category: FunctionCategoryMath, fn: Max,
+		Description: "returns the larger of a and b"},
+	{Name: "min", Signature: "min(a, b int) int", Category: FunctionCategoryMath, fn: Min,
+		Description: "returns the smaller of a and b"},
+	{Name: "atoi", Signature: "atoi(s string) int", Category: FunctionCategoryMath, fn: strconv.Atoi,
+		Description: "parses s as an integer"},
+
+	{Name: "toJson", Signature: "toJson(v any) string", Category: FunctionCategoryEncoding, fn: ToJSON,
+		Description: "encodes v as json"},
+	{Name: "toPrettyJson", Signature: "toPrettyJson(v any) string", Category: FunctionCategoryEncoding, fn: ToPrettyJSON,
+		Description: "encodes v as indented json"},
+	{Name: "fromJson", Signature: "fromJson(s string) any", Category: FunctionCategoryEncoding, fn: FromJSON,
+		Description: "decodes the json in s"},
+	{Name: "toYaml", Signature: "toYaml(v any) string", Category: FunctionCategoryEncoding, fn: ToYAML,
+		Description: "encodes v as yaml"},
+	{Name: "fromYaml", Signature: "fromYaml(s string) any", Category: FunctionCategoryEncoding, fn: FromYAML,
+		Description: "decodes the yaml in s"},
+	{Name: "b64enc", Signature: "b64enc(s string) string", Category: FunctionCategoryEncoding, fn: Base64Encode,
+		Description: "encodes s as standard base64"},
+	{Name: "b64dec", Signature: "b64dec(s string) string", Category: FunctionCategoryEncoding, fn: Base64Decode,
+		Description: "decodes the standard base64 in s"},
+	{Name: "md5", Signature: "md5(s string) string", Category: FunctionCategoryEncoding, fn: MD5,
+		Description: "returns the hex encoded md5 hash of s"},
+	{Name: "sha1", Signature: "sha1(s string) string", Category: FunctionCategoryEncoding, fn: SHA1,
+		Description: "returns the hex encoded sha1 hash of s"},
+	{Name: "sha256", Signature: "sha256(s string) string", Category: FunctionCategoryEncoding, fn: SHA256,
+		Description: "returns the hex encoded sha256 hash of s"},
+}
+
 func OptimusFuncMap() template.FuncMap {
-	return map[string]any{
-		"Date":        Date,
-		"replace":     Replace,
-		"trunc":       Trunc,
-		"date":        date,
-		"date_modify": DateModify,
-		"toDate":      toDate,
-		"unixEpoch":   UnixEpoch,
-		"list":        List,
-		"join":        Join,
+	funcMap := make(template.FuncMap, len(templateFunctions))
+	for _, function := range templateFunctions {
+		funcMap[function.Name] = function.fn
 	}
+	return funcMap
+}
+
+// TemplateFunctions returns the documentation of the functions available to templates sorted by category and name
+func TemplateFunctions() []TemplateFunction {
+	functions := make([]TemplateFunction, len(templateFunctions))
+	copy(functions, templateFunctions)
+	sort.Slice(functions, func(i, j int) bool {
+		if functions[i].Category != functions[j].Category {
+			return functions[i].Category < functions[j].Category
+		}
+		return functions[i].Name < functions[j].Name
+	})
+	return functions
 }
 
 func Date(timeStr string) (string, error) {
@@ -95,3 +232,79 @@ func List(v ...string) []string {
 func Join(sep string, v []string) string {
 	return strings.Join(v, sep)
 }
+
+func Dict(pairs ...any) (map[string]any, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("dict expects pairs of key and value, got %d arguments", len(pairs))
+	}
+	d := make(map[string]any, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict key at position %d is not a string", i)
+		}
+		d[key] = pairs[i+1]
+	}
+	return d, nil
+}
+
+func Get(key string, d map[string]any) any {
+	if v, ok := d[key]; ok {
+		return v
+	}
+	return ""
+}
+
+func HasKey(key string, d map[string]any) bool {
+	_, ok := d[key]
+	return ok
+}
+
+func Keys(d map[string]any) []string {
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func Add(a, b int) int {
+	return a + b
+}
+
+func Sub(a, b int) int {
+	return a - b
+}
+
+func Mul(a, b int) int {
+	return a * b
+}
+
+func Div(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return a / b, nil
+}
+
+func Mod(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errors.New("modulo by zero")
+	}
+	return a % b, nil
+}
+
+func Max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func Min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
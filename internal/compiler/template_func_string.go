@@ -0,0 +1,95 @@
+package compiler
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+func Title(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if i == 0 || unicode.IsSpace(runes[i-1]) {
+			runes[i] = unicode.ToUpper(r)
+		}
+	}
+	return string(runes)
+}
+
+func SnakeCase(s string) string {
+	return strings.Join(words(s), "_")
+}
+
+func KebabCase(s string) string {
+	return strings.Join(words(s), "-")
+}
+
+func CamelCase(s string) string {
+	var sb strings.Builder
+	for i, word := range words(s) {
+		if i > 0 {
+			word = Title(word)
+		}
+		sb.WriteString(word)
+	}
+	return sb.String()
+}
+
+// words splits s into lower cased words on separators and on the start of an upper cased word
+func words(s string) []string {
+	var result []string
+	var current []rune
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				result = append(result, string(current))
+				current = nil
+			}
+			continue
+		}
+		startsWord := unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])))
+		if startsWord && len(current) > 0 {
+			result = append(result, string(current))
+			current = nil
+		}
+		current = append(current, unicode.ToLower(r))
+	}
+	if len(current) > 0 {
+		result = append(result, string(current))
+	}
+	return result
+}
+
+func TrimPrefix(prefix, s string) string {
+	return strings.TrimPrefix(s, prefix)
+}
+
+func TrimSuffix(suffix, s string) string {
+	return strings.TrimSuffix(s, suffix)
+}
+
+func Contains(substr, s string) bool {
+	return strings.Contains(s, substr)
+}
+
+func HasPrefix(prefix, s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+func HasSuffix(suffix, s string) bool {
+	return strings.HasSuffix(s, suffix)
+}
+
+func Split(sep, s string) []string {
+	return strings.Split(s, sep)
+}
+
+func Quote(s string) string {
+	return strconv.Quote(s)
+}
+
+func SingleQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
@@ -60,4 +60,110 @@ func TestTemplateFunctions(t *testing.T) {
 			assert.Equal(t, "project_dataset_table", joined)
 		})
 	})
+	t.Run("FormatTime", func(t *testing.T) {
+		t.Run("formats ISO time with layout", func(t *testing.T) {
+			formatted, err := compiler.FormatTime("2006/01/02 15", d1.Format(compiler.ISOTimeFormat))
+			assert.NoError(t, err)
+			assert.Equal(t, "2023/01/15 03", formatted)
+		})
+	})
+	t.Run("ToTimezone", func(t *testing.T) {
+		t.Run("converts ISO time to timezone", func(t *testing.T) {
+			converted, err := compiler.ToTimezone("Asia/Jakarta", d1.Format(compiler.ISOTimeFormat))
+			assert.NoError(t, err)
+			assert.Equal(t, "2023-01-15T10:12:08+07:00", converted)
+		})
+		t.Run("returns error for unknown timezone", func(t *testing.T) {
+			_, err := compiler.ToTimezone("Mars/Olympus", d1.Format(compiler.ISOTimeFormat))
+			assert.ErrorContains(t, err, "unknown timezone Mars/Olympus")
+		})
+	})
+	t.Run("Partitions", func(t *testing.T) {
+		t.Run("lists the times between start and end", func(t *testing.T) {
+			partitions, err := compiler.Partitions("6h", "2023-01-15T00:00:00Z", "2023-01-16T00:00:00Z")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"2023-01-15T00:00:00Z", "2023-01-15T06:00:00Z", "2023-01-15T12:00:00Z", "2023-01-15T18:00:00Z"}, partitions)
+		})
+		t.Run("returns error when there are too many partitions", func(t *testing.T) {
+			_, err := compiler.Partitions("1s", "2023-01-15T00:00:00Z", "2023-01-16T00:00:00Z")
+			assert.ErrorContains(t, err, "has more than 10000 partitions")
+		})
+		t.Run("returns error for non positive interval", func(t *testing.T) {
+			_, err := compiler.Partitions("0s", "2023-01-15T00:00:00Z", "2023-01-16T00:00:00Z")
+			assert.ErrorContains(t, err, "partition interval should be positive")
+		})
+	})
+	t.Run("PartitionDates", func(t *testing.T) {
+		t.Run("lists the dates between start and end", func(t *testing.T) {
+			dates, err := compiler.PartitionDates("2023-01-15T00:00:00Z", "2023-01-18T00:00:00Z")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"2023-01-15", "2023-01-16", "2023-01-17"}, dates)
+		})
+	})
+	t.Run("case conversion", func(t *testing.T) {
+		t.Run("converts between cases", func(t *testing.T) {
+			assert.Equal(t, "Hello World", compiler.Title("hello world"))
+			assert.Equal(t, "user_id_hash", compiler.SnakeCase("UserIDHash"))
+			assert.Equal(t, "user-id-hash", compiler.KebabCase("user id_hash"))
+			assert.Equal(t, "userIdHash", compiler.CamelCase("user_id-hash"))
+		})
+	})
+	t.Run("Quote", func(t *testing.T) {
+		t.Run("quotes strings", func(t *testing.T) {
+			assert.Equal(t, `"it\"s"`, compiler.Quote(`it"s`))
+			assert.Equal(t, `'it\'s'`, compiler.SingleQuote(`it's`))
+		})
+	})
+	t.Run("Dict", func(t *testing.T) {
+		t.Run("builds dictionary from pairs", func(t *testing.T) {
+			d, err := compiler.Dict("b", 1, "a", "x")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"a", "b"}, compiler.Keys(d))
+			assert.Equal(t, 1, compiler.Get("b", d))
+			assert.Equal(t, "", compiler.Get("c", d))
+			assert.True(t, compiler.HasKey("a", d))
+		})
+		t.Run("returns error for odd number of arguments", func(t *testing.T) {
+			_, err := compiler.Dict("a")
+			assert.ErrorContains(t, err, "dict expects pairs of key and value")
+		})
+	})
+	t.Run("Div", func(t *testing.T) {
+		t.Run("returns error on division by zero", func(t *testing.T) {
+			_, err := compiler.Div(4, 0)
+			assert.ErrorContains(t, err, "division by zero")
+		})
+	})
+	t.Run("encoding", func(t *testing.T) {
+		t.Run("encodes and decodes json and yaml", func(t *testing.T) {
+			decoded, err := compiler.FromYAML("name: job\ncount: 2")
+			assert.NoError(t, err)
+			encoded, err := compiler.ToJSON(decoded)
+			assert.NoError(t, err)
+			assert.Equal(t, `{"count":2,"name":"job"}`, encoded)
+
+			decoded, err = compiler.FromJSON(`{"name":"job"}`)
+			assert.NoError(t, err)
+			encoded, err = compiler.ToYAML(decoded)
+			assert.NoError(t, err)
+			assert.Equal(t, "name: job", encoded)
+		})
+		t.Run("hashes strings", func(t *testing.T) {
+			assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", compiler.MD5("hello"))
+			assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", compiler.SHA256("hello"))
+		})
+	})
+	t.Run("TemplateFunctions", func(t *testing.T) {
+		t.Run("documents every function of the func map", func(t *testing.T) {
+			functions := compiler.TemplateFunctions()
+			funcMap := compiler.OptimusFuncMap()
+
+			assert.Len(t, functions, len(funcMap))
+			for _, function := range functions {
+				assert.Contains(t, funcMap, function.Name)
+				assert.NotEmpty(t, function.Signature)
+				assert.NotEmpty(t, function.Description)
+			}
+		})
+	})
 }
@@ -0,0 +1,80 @@
+package compiler
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxPartitions bounds the number of partitions a template can generate
+const maxPartitions = 10000
+
+func FormatTime(layout, isoTime string) (string, error) {
+	t, err := time.Parse(ISOTimeFormat, isoTime)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(layout), nil
+}
+
+func ToTimezone(zone, isoTime string) (string, error) {
+	t, err := time.Parse(ISOTimeFormat, isoTime)
+	if err != nil {
+		return "", err
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return "", fmt.Errorf("unknown timezone %s", zone)
+	}
+	return t.In(loc).Format(ISOTimeFormat), nil
+}
+
+func Partitions(interval, startIsoTime, endIsoTime string) ([]string, error) {
+	step, err := time.ParseDuration(interval)
+	if err != nil {
+		return nil, err
+	}
+	times, err := partitionTimes(step, startIsoTime, endIsoTime)
+	if err != nil {
+		return nil, err
+	}
+	partitions := make([]string, len(times))
+	for i, t := range times {
+		partitions[i] = t.Format(ISOTimeFormat)
+	}
+	return partitions, nil
+}
+
+func PartitionDates(startIsoTime, endIsoTime string) ([]string, error) {
+	times, err := partitionTimes(24*time.Hour, startIsoTime, endIsoTime) //nolint:gomnd
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]string, len(times))
+	for i, t := range times {
+		dates[i] = t.Format(ISODateFormat)
+	}
+	return dates, nil
+}
+
+func partitionTimes(step time.Duration, startIsoTime, endIsoTime string) ([]time.Time, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("partition interval should be positive, got %s", step)
+	}
+	start, err := time.Parse(ISOTimeFormat, startIsoTime)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(ISOTimeFormat, endIsoTime)
+	if err != nil {
+		return nil, err
+	}
+	if end.Sub(start)/step > maxPartitions {
+		return nil, fmt.Errorf("range from %s to %s has more than %d partitions", startIsoTime, endIsoTime, maxPartitions)
+	}
+
+	var times []time.Time
+	for t := start; t.Before(end); t = t.Add(step) {
+		times = append(times, t)
+	}
+	return times, nil
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// CalendarConfigPrefix prefixes the project config declaring a calendar, eg. CALENDAR_NYSE, the value
+	// is the business weekdays and the holidays, eg. weekdays=mon-fri;holidays=2023-12-25,2024-01-01
+	CalendarConfigPrefix = "CALENDAR_"
+
+	CalendarDateLayout = "2006-01-02"
+
+	daysInWeek = 7
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Calendar tells the business days, which are the business weekdays that are not holidays
+type Calendar struct {
+	name     string
+	weekdays map[time.Weekday]bool
+	holidays map[string]bool
+}
+
+func NewCalendar(name string, weekdays []time.Weekday, holidays []time.Time) (*Calendar, error) {
+	if len(weekdays) == 0 {
+		return nil, fmt.Errorf("calendar [%s] should have at least one business weekday", name)
+	}
+	calendar := &Calendar{
+		name:     name,
+		weekdays: map[time.Weekday]bool{},
+		holidays: map[string]bool{},
+	}
+	for _, weekday := range weekdays {
+		calendar.weekdays[weekday] = true
+	}
+	for _, holiday := range holidays {
+		calendar.holidays[holiday.Format(CalendarDateLayout)] = true
+	}
+	return calendar, nil
+}
+
+// DefaultCalendar has monday to friday as business days without any holiday
+func DefaultCalendar() *Calendar {
+	calendar, _ := NewCalendar("", []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, nil)
+	return calendar
+}
+
+// CalendarFrom parses the calendar declaration, weekdays are monday to friday when not declared
+func CalendarFrom(name, declaration string) (*Calendar, error) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	var holidays []time.Time
+	for _, part := range strings.Split(declaration, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid declaration [%s] of calendar [%s], expected weekdays=... or holidays=...", part, name)
+		}
+		switch strings.TrimSpace(key) {
+		case "weekdays":
+			parsed, err := weekdaysFrom(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weekdays of calendar [%s]: %w", name, err)
+			}
+			weekdays = parsed
+		case "holidays":
+			parsed, err := holidaysFrom(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid holidays of calendar [%s]: %w", name, err)
+			}
+			holidays = parsed
+		default:
+			return nil, fmt.Errorf("unknown key [%s] in declaration of calendar [%s]", key, name)
+		}
+	}
+	return NewCalendar(name, weekdays, holidays)
+}
+
+// CalendarFromConfig returns the calendar with the given name declared in the config
+func CalendarFromConfig(name string, config map[string]string) (*Calendar, error) {
+	declaration, ok := config[CalendarConfigPrefix+strings.ToUpper(name)]
+	if !ok {
+		return nil, fmt.Errorf("calendar [%s] is not declared, expecting config %s%s", name, CalendarConfigPrefix, strings.ToUpper(name))
+	}
+	return CalendarFrom(name, declaration)
+}
+
+func (c *Calendar) Name() string {
+	return c.name
+}
+
+// Weekdays returns the business weekdays in order, starting from sunday
+func (c *Calendar) Weekdays() []time.Weekday {
+	var weekdays []time.Weekday
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if c.weekdays[weekday] {
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	return weekdays
+}
+
+// Holidays returns the holidays in order, formatted with CalendarDateLayout
+func (c *Calendar) Holidays() []string {
+	holidays := make([]string, 0, len(c.holidays))
+	for holiday := range c.holidays {
+		holidays = append(holidays, holiday)
+	}
+	sort.Strings(holidays)
+	return holidays
+}
+
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return c.weekdays[t.Weekday()] && !c.holidays[t.Format(CalendarDateLayout)]
+}
+
+// AddBusinessDays moves the time by the given number of business days keeping the time of day,
+// moving backward from a non business day lands on the closest business day before it
+func (c *Calendar) AddBusinessDays(t time.Time, days int) time.Time {
+	step := 1
+	if days < 0 {
+		step = -1
+		days = -days
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.IsBusinessDay(t) {
+			days--
+		}
+	}
+	return t
+}
+
+func weekdaysFrom(value string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	for _, declaration := range strings.Split(value, ",") {
+		declaration = strings.ToLower(strings.TrimSpace(declaration))
+		if declaration == "" {
+			continue
+		}
+		from, to, isRange := strings.Cut(declaration, "-")
+		fromDay, ok := weekdayNames[from]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday [%s]", from)
+		}
+		if !isRange {
+			weekdays = append(weekdays, fromDay)
+			continue
+		}
+		toDay, ok := weekdayNames[to]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday [%s]", to)
+		}
+		for day := fromDay; ; day = (day + 1) % daysInWeek {
+			weekdays = append(weekdays, day)
+			if day == toDay {
+				break
+			}
+		}
+	}
+	if len(weekdays) == 0 {
+		return nil, errors.New("no weekday is declared")
+	}
+	return weekdays, nil
+}
+
+func holidaysFrom(value string) ([]time.Time, error) {
+	var holidays []time.Time
+	for _, declaration := range strings.Split(value, ",") {
+		declaration = strings.TrimSpace(declaration)
+		if declaration == "" {
+			continue
+		}
+		holiday, err := time.Parse(CalendarDateLayout, declaration)
+		if err != nil {
+			return nil, fmt.Errorf("holiday [%s] is not in %s format", declaration, CalendarDateLayout)
+		}
+		holidays = append(holidays, holiday)
+	}
+	return holidays, nil
+}
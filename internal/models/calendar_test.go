@@ -0,0 +1,72 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestCalendar(t *testing.T) {
+	t.Run("CalendarFrom", func(t *testing.T) {
+		t.Run("should default to monday to friday when weekdays are not declared", func(t *testing.T) {
+			calendar, err := models.CalendarFrom("exchange", "holidays=2023-01-02")
+			assert.NoError(t, err)
+
+			assert.True(t, calendar.IsBusinessDay(time.Date(2023, 1, 6, 10, 0, 0, 0, time.UTC)))
+			assert.False(t, calendar.IsBusinessDay(time.Date(2023, 1, 7, 10, 0, 0, 0, time.UTC)))
+			assert.False(t, calendar.IsBusinessDay(time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)))
+		})
+		t.Run("should parse weekdays declared as list and range", func(t *testing.T) {
+			calendar, err := models.CalendarFrom("middle-east", "weekdays=sun-wed, sat")
+			assert.NoError(t, err)
+
+			assert.True(t, calendar.IsBusinessDay(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+			assert.True(t, calendar.IsBusinessDay(time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC)))
+			assert.False(t, calendar.IsBusinessDay(time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC)))
+			assert.True(t, calendar.IsBusinessDay(time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC)))
+			assert.Equal(t, []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Saturday}, calendar.Weekdays())
+		})
+		t.Run("should return the holidays in order", func(t *testing.T) {
+			calendar, err := models.CalendarFrom("exchange", "holidays=2023-12-25,2023-01-02")
+			assert.NoError(t, err)
+
+			assert.Equal(t, []string{"2023-01-02", "2023-12-25"}, calendar.Holidays())
+		})
+		t.Run("should return error for invalid declaration", func(t *testing.T) {
+			invalidDeclarations := []string{"mon-fri", "weekdays=monday", "weekdays=", "holidays=2023/01/01", "timezone=UTC"}
+			for _, declaration := range invalidDeclarations {
+				calendar, err := models.CalendarFrom("exchange", declaration)
+				assert.Error(t, err, declaration)
+				assert.Nil(t, calendar, declaration)
+			}
+		})
+	})
+	t.Run("CalendarFromConfig", func(t *testing.T) {
+		t.Run("should return the calendar declared with upper case name", func(t *testing.T) {
+			config := map[string]string{"CALENDAR_EXCHANGE": "weekdays=mon-fri"}
+
+			calendar, err := models.CalendarFromConfig("exchange", config)
+			assert.NoError(t, err)
+			assert.Equal(t, "exchange", calendar.Name())
+		})
+		t.Run("should return error when calendar is not declared", func(t *testing.T) {
+			calendar, err := models.CalendarFromConfig("exchange", map[string]string{})
+			assert.ErrorContains(t, err, "calendar [exchange] is not declared")
+			assert.Nil(t, calendar)
+		})
+	})
+	t.Run("AddBusinessDays", func(t *testing.T) {
+		calendar, err := models.CalendarFrom("exchange", "weekdays=mon-fri;holidays=2023-01-06")
+		assert.NoError(t, err)
+		monday := time.Date(2023, 1, 9, 2, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, monday, calendar.AddBusinessDays(monday, 0))
+		assert.Equal(t, time.Date(2023, 1, 5, 2, 0, 0, 0, time.UTC), calendar.AddBusinessDays(monday, -1))
+		assert.Equal(t, time.Date(2023, 1, 4, 2, 0, 0, 0, time.UTC), calendar.AddBusinessDays(monday, -2))
+		assert.Equal(t, time.Date(2023, 1, 10, 2, 0, 0, 0, time.UTC), calendar.AddBusinessDays(monday, 1))
+		assert.Equal(t, time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC), calendar.AddBusinessDays(time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), 1))
+	})
+}
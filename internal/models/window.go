@@ -25,9 +25,24 @@ func NewWindow(version int, truncateTo, offset, size string) (Window, error) {
 	if version == 2 { // nolint:gomnd
 		return windowV2{truncateTo: truncateTo, offset: offset, size: size}, nil
 	}
+	if version == 3 { // nolint:gomnd
+		return windowV3{truncateTo: truncateTo, offset: offset, size: size}, nil
+	}
 	return nil, fmt.Errorf("window version [%d] is not recognized", version)
 }
 
+// NewWindowWithCalendar initializes the window along with the name of its calendar, which is only supported by
+// window version 3. Window version 3 without a calendar uses the default calendar.
+func NewWindowWithCalendar(version int, truncateTo, offset, size, calendar string) (Window, error) {
+	if calendar == "" {
+		return NewWindow(version, truncateTo, offset, size)
+	}
+	if version != 3 { // nolint:gomnd
+		return nil, fmt.Errorf("calendar is not supported by window version [%d]", version)
+	}
+	return windowV3{truncateTo: truncateTo, offset: offset, size: size, calendarName: calendar}, nil
+}
+
 // GetEndRunDate subtract 1 day to make end inclusive
 func GetEndRunDate(runTime time.Time, window Window) (time.Time, error) {
 	months, nonMonthDurationString, err := monthsAndNonMonthExpression(window.GetSize())
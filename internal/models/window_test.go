@@ -20,6 +20,7 @@ func TestNewWindow(t *testing.T) {
 		}{
 			{version: 1, truncateTo: "h", offset: "1h", size: "1h", testMessage: "version 1"},
 			{version: 2, truncateTo: "h", offset: "1h", size: "1h", testMessage: "version 2"},
+			{version: 3, truncateTo: "d", offset: "-1b", size: "1b", testMessage: "version 3"},
 		}
 
 		for _, tCase := range testCases {
@@ -42,3 +43,25 @@ func TestNewWindow(t *testing.T) {
 		assert.Error(t, actualError)
 	})
 }
+
+func TestNewWindowWithCalendar(t *testing.T) {
+	t.Run("should return window with the calendar for version 3", func(t *testing.T) {
+		window, err := models.NewWindowWithCalendar(3, "d", "", "1b", "exchange")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "exchange", models.CalendarOf(window))
+	})
+	t.Run("should return window without calendar when calendar is empty", func(t *testing.T) {
+		window, err := models.NewWindowWithCalendar(2, "d", "", "24h", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, window.GetVersion())
+		assert.Empty(t, models.CalendarOf(window))
+	})
+	t.Run("should return error when calendar is provided for version other than 3", func(t *testing.T) {
+		window, err := models.NewWindowWithCalendar(2, "d", "", "24h", "exchange")
+
+		assert.Nil(t, window)
+		assert.ErrorContains(t, err, "calendar is not supported by window version [2]")
+	})
+}
@@ -0,0 +1,177 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// windowV3 works like windowV2, additionally offset and size can be a number of business days, eg. -1b,
+// which skips the non business days of the calendar of the window
+type windowV3 struct {
+	truncateTo string
+	offset     string
+	size       string
+
+	calendarName string
+	calendar     *Calendar
+}
+
+// CalendarWindow is a window computed over the business days of a calendar, a named calendar is
+// declared per project and needs to be bound to the window before computing start and end time
+type CalendarWindow interface {
+	Window
+
+	GetCalendar() string
+	WithCalendar(calendar *Calendar) (Window, error)
+	IsBusinessDay(scheduleTime time.Time) bool
+	BusinessCalendar() (*Calendar, error)
+}
+
+func (windowV3) GetVersion() int {
+	return 3 //nolint:gomnd
+}
+
+func (w windowV3) GetTruncateTo() string {
+	return w.truncateTo
+}
+
+func (w windowV3) GetOffset() string {
+	return w.offset
+}
+
+func (w windowV3) GetSize() string {
+	return w.size
+}
+
+func (w windowV3) GetCalendar() string {
+	return w.calendarName
+}
+
+func (w windowV3) WithCalendar(calendar *Calendar) (Window, error) {
+	if calendar == nil {
+		return nil, errors.New("calendar is nil")
+	}
+	if calendar.Name() != w.calendarName {
+		return nil, fmt.Errorf("window refers to calendar [%s], not [%s]", w.calendarName, calendar.Name())
+	}
+	w.calendar = calendar
+	return w, nil
+}
+
+// IsBusinessDay tells whether the schedule time falls on a business day, every day is considered
+// a business day when the named calendar is not bound yet
+func (w windowV3) IsBusinessDay(scheduleTime time.Time) bool {
+	calendar, err := w.getCalendar()
+	if err != nil {
+		return true
+	}
+	return calendar.IsBusinessDay(scheduleTime)
+}
+
+// BusinessCalendar returns the calendar telling the business days of the window, which is the default
+// calendar when the window does not refer to a named calendar
+func (w windowV3) BusinessCalendar() (*Calendar, error) {
+	return w.getCalendar()
+}
+
+func (w windowV3) Validate() error {
+	if err := w.durationWindow().Validate(); err != nil {
+		return err
+	}
+	if days, ok := businessDaysFrom(w.size); ok && days < 0 {
+		return errors.New("error validating size: size cannot be negative")
+	}
+	return nil
+}
+
+func (w windowV3) GetStartTime(scheduleTime time.Time) (time.Time, error) {
+	endTime, err := w.GetEndTime(scheduleTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	days, ok := businessDaysFrom(w.size)
+	if !ok {
+		return w.durationWindow().getStartTime(endTime)
+	}
+	calendar, err := w.getCalendar()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return calendar.AddBusinessDays(endTime, -days), nil
+}
+
+func (w windowV3) GetEndTime(scheduleTime time.Time) (time.Time, error) {
+	if err := w.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	durationWindow := w.durationWindow()
+	truncatedTime := durationWindow.truncateTime(scheduleTime)
+
+	days, ok := businessDaysFrom(w.offset)
+	if !ok {
+		return durationWindow.adjustOffset(truncatedTime)
+	}
+	calendar, err := w.getCalendar()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return calendar.AddBusinessDays(truncatedTime, days), nil
+}
+
+func (w windowV3) getCalendar() (*Calendar, error) {
+	if w.calendar != nil {
+		return w.calendar, nil
+	}
+	if w.calendarName == "" {
+		return DefaultCalendar(), nil
+	}
+	return nil, fmt.Errorf("calendar [%s] of the window is not loaded", w.calendarName)
+}
+
+// durationWindow is the v2 window of the offset and size which are not expressed in business days
+func (w windowV3) durationWindow() windowV2 {
+	durationWindow := windowV2{truncateTo: w.truncateTo, offset: w.offset, size: w.size}
+	if _, ok := businessDaysFrom(w.offset); ok {
+		durationWindow.offset = ""
+	}
+	if _, ok := businessDaysFrom(w.size); ok {
+		durationWindow.size = ""
+	}
+	return durationWindow
+}
+
+func businessDaysFrom(expression string) (int, bool) {
+	if !strings.HasSuffix(expression, "b") {
+		return 0, false
+	}
+	days, err := strconv.Atoi(strings.TrimSuffix(expression, "b"))
+	if err != nil {
+		return 0, false
+	}
+	return days, true
+}
+
+// WindowWithCalendar binds the calendar declared in the config to the window referring to it,
+// any other window is returned as is
+func WindowWithCalendar(window Window, config map[string]string) (Window, error) {
+	calendarWindow, ok := window.(CalendarWindow)
+	if !ok || calendarWindow.GetCalendar() == "" {
+		return window, nil
+	}
+	calendar, err := CalendarFromConfig(calendarWindow.GetCalendar(), config)
+	if err != nil {
+		return nil, err
+	}
+	return calendarWindow.WithCalendar(calendar)
+}
+
+// CalendarOf returns the name of the calendar of the window, which is empty when the window has none
+func CalendarOf(window Window) string {
+	if calendarWindow, ok := window.(CalendarWindow); ok {
+		return calendarWindow.GetCalendar()
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/raystack/optimus/internal/models"
+)
+
+func TestWindowV3(t *testing.T) {
+	exchange, err := models.CalendarFrom("exchange", "weekdays=mon-fri;holidays=2023-01-05")
+	if err != nil {
+		panic(err)
+	}
+	newWindow := func(truncateTo, offset, size string) models.Window {
+		window, err := models.NewWindowWithCalendar(3, truncateTo, offset, size, "exchange")
+		if err != nil {
+			panic(err)
+		}
+		window, err = window.(models.CalendarWindow).WithCalendar(exchange)
+		if err != nil {
+			panic(err)
+		}
+		return window
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		t.Run("should not throw error for window size and offset in business days", func(t *testing.T) {
+			validConfigs := []string{"1b", "0b", "10b", "24h", "1M", ""}
+			for _, config := range validConfigs {
+				assert.NoError(t, newWindow("d", config, config).Validate(), fmt.Sprintf("failed for : %s", config))
+			}
+			assert.NoError(t, newWindow("d", "-1b", "").Validate())
+		})
+		t.Run("should throw error for negative size or invalid business days", func(t *testing.T) {
+			invalidConfigs := []string{"-1b", "1.5b", "b", "1d"}
+			for _, config := range invalidConfigs {
+				assert.Error(t, newWindow("d", "", config).Validate(), fmt.Sprintf("failed for : %s", config))
+			}
+		})
+	})
+	t.Run("GetStartTime and GetEndTime", func(t *testing.T) {
+		t.Run("should return the previous business day skipping weekend", func(t *testing.T) {
+			window := newWindow("d", "", "1b")
+			scheduleTime := time.Date(2023, 1, 9, 2, 0, 0, 0, time.UTC)
+
+			startTime, err := window.GetStartTime(scheduleTime)
+			assert.NoError(t, err)
+			endTime, err := window.GetEndTime(scheduleTime)
+			assert.NoError(t, err)
+
+			assert.Equal(t, time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC), startTime)
+			assert.Equal(t, time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC), endTime)
+		})
+		t.Run("should skip holidays of the calendar", func(t *testing.T) {
+			window := newWindow("d", "", "1b")
+			scheduleTime := time.Date(2023, 1, 6, 2, 0, 0, 0, time.UTC)
+
+			startTime, err := window.GetStartTime(scheduleTime)
+			assert.NoError(t, err)
+
+			assert.Equal(t, time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC), startTime)
+		})
+		t.Run("should shift the end time by the business days of the offset", func(t *testing.T) {
+			window := newWindow("d", "-1b", "2b")
+			scheduleTime := time.Date(2023, 1, 9, 2, 0, 0, 0, time.UTC)
+
+			startTime, err := window.GetStartTime(scheduleTime)
+			assert.NoError(t, err)
+			endTime, err := window.GetEndTime(scheduleTime)
+			assert.NoError(t, err)
+
+			assert.Equal(t, time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), startTime)
+			assert.Equal(t, time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC), endTime)
+		})
+		t.Run("should work like version 2 for size and offset which are not business days", func(t *testing.T) {
+			window := newWindow("d", "1h", "24h")
+			scheduleTime := time.Date(2023, 1, 9, 2, 0, 0, 0, time.UTC)
+
+			startTime, err := window.GetStartTime(scheduleTime)
+			assert.NoError(t, err)
+			endTime, err := window.GetEndTime(scheduleTime)
+			assert.NoError(t, err)
+
+			assert.Equal(t, time.Date(2023, 1, 8, 1, 0, 0, 0, time.UTC), startTime)
+			assert.Equal(t, time.Date(2023, 1, 9, 1, 0, 0, 0, time.UTC), endTime)
+		})
+		t.Run("should use monday to friday when the window has no calendar", func(t *testing.T) {
+			window, err := models.NewWindow(3, "d", "", "1b")
+			assert.NoError(t, err)
+
+			startTime, err := window.GetStartTime(time.Date(2023, 1, 6, 2, 0, 0, 0, time.UTC))
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), startTime)
+		})
+		t.Run("should return error when the calendar of the window is not bound", func(t *testing.T) {
+			window, err := models.NewWindowWithCalendar(3, "d", "", "1b", "exchange")
+			assert.NoError(t, err)
+
+			_, err = window.GetStartTime(time.Date(2023, 1, 6, 2, 0, 0, 0, time.UTC))
+			assert.ErrorContains(t, err, "calendar [exchange] of the window is not loaded")
+		})
+	})
+	t.Run("WithCalendar", func(t *testing.T) {
+		t.Run("should return error when the calendar is not the one referred by the window", func(t *testing.T) {
+			window, err := models.NewWindowWithCalendar(3, "d", "", "1b", "other")
+			assert.NoError(t, err)
+
+			_, err = window.(models.CalendarWindow).WithCalendar(exchange)
+			assert.ErrorContains(t, err, "window refers to calendar [other], not [exchange]")
+		})
+	})
+	t.Run("WindowWithCalendar", func(t *testing.T) {
+		t.Run("should return window as is when it has no calendar", func(t *testing.T) {
+			window, err := models.NewWindow(2, "d", "", "24h")
+			assert.NoError(t, err)
+
+			actualWindow, err := models.WindowWithCalendar(window, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, window, actualWindow)
+		})
+		t.Run("should bind the calendar declared in config", func(t *testing.T) {
+			window, err := models.NewWindowWithCalendar(3, "d", "", "1b", "exchange")
+			assert.NoError(t, err)
+
+			actualWindow, err := models.WindowWithCalendar(window, map[string]string{"CALENDAR_EXCHANGE": "weekdays=mon-thu"})
+			assert.NoError(t, err)
+			assert.False(t, actualWindow.(models.CalendarWindow).IsBusinessDay(time.Date(2023, 1, 6, 2, 0, 0, 0, time.UTC)))
+		})
+	})
+}
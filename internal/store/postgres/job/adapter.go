@@ -29,8 +29,9 @@ type Spec struct {
 
 	Alert json.RawMessage
 
-	StaticUpstreams pq.StringArray
-	HTTPUpstreams   json.RawMessage
+	StaticUpstreams       pq.StringArray
+	HTTPUpstreams         json.RawMessage
+	StaticUpstreamConfigs json.RawMessage `json:",omitempty"`
 
 	TaskName   string
 	TaskConfig map[string]string
@@ -64,6 +65,7 @@ type Window struct {
 	WindowSize       string
 	WindowOffset     string
 	WindowTruncateTo string
+	WindowCalendar   string `json:",omitempty"`
 }
 
 type Retry struct {
@@ -84,8 +86,23 @@ type Asset struct {
 }
 
 type Hook struct {
-	Name   string
-	Config map[string]string
+	Name       string
+	Config     map[string]string
+	DependsOn  []string      `json:",omitempty"`
+	RunIf      string        `json:",omitempty"`
+	RetryCount int           `json:",omitempty"`
+	RetryDelay time.Duration `json:",omitempty"`
+	Timeout    time.Duration `json:",omitempty"`
+}
+
+type UpstreamRunConfig struct {
+	RunOffset        string `json:",omitempty"`
+	WindowSize       string `json:",omitempty"`
+	WindowOffset     string `json:",omitempty"`
+	WindowTruncateTo string `json:",omitempty"`
+
+	Optional bool          `json:",omitempty"`
+	MaxWait  time.Duration `json:",omitempty"`
 }
 
 type Metadata struct {
@@ -128,7 +145,7 @@ func toStorageSpec(jobEntity *job.Job) (*Spec, error) {
 	}
 
 	var staticUpstreams []string
-	var httpUpstreamsInBytes []byte
+	var httpUpstreamsInBytes, staticUpstreamConfigsInBytes []byte
 	if jobSpec.UpstreamSpec() != nil {
 		for _, name := range jobSpec.UpstreamSpec().UpstreamNames() {
 			staticUpstreams = append(staticUpstreams, name.String())
@@ -139,6 +156,10 @@ func toStorageSpec(jobEntity *job.Job) (*Spec, error) {
 				return nil, err
 			}
 		}
+		staticUpstreamConfigsInBytes, err = toStorageUpstreamRunConfigs(jobSpec.UpstreamSpec().RunConfigs())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	sources := make([]string, len(jobEntity.Sources()))
@@ -180,8 +201,9 @@ func toStorageSpec(jobEntity *job.Job) (*Spec, error) {
 
 		Hooks: hooksBytes,
 
-		StaticUpstreams: staticUpstreams,
-		HTTPUpstreams:   httpUpstreamsInBytes,
+		StaticUpstreams:       staticUpstreams,
+		HTTPUpstreams:         httpUpstreamsInBytes,
+		StaticUpstreamConfigs: staticUpstreamConfigsInBytes,
 
 		Destination: jobEntity.Destination().String(),
 		Sources:     sources,
@@ -191,11 +213,52 @@ func toStorageSpec(jobEntity *job.Job) (*Spec, error) {
 	}, nil
 }
 
+func toStorageUpstreamRunConfigs(runConfigs map[job.SpecUpstreamName]*job.UpstreamRunConfig) ([]byte, error) {
+	if len(runConfigs) == 0 {
+		return nil, nil
+	}
+	storageRunConfigs := make(map[string]UpstreamRunConfig, len(runConfigs))
+	for name, runConfig := range runConfigs {
+		storageRunConfigs[name.String()] = UpstreamRunConfig{
+			RunOffset:        runConfig.RunOffset(),
+			WindowSize:       runConfig.WindowSize(),
+			WindowOffset:     runConfig.WindowOffset(),
+			WindowTruncateTo: runConfig.WindowTruncateTo(),
+			Optional:         runConfig.IsOptional(),
+			MaxWait:          runConfig.MaxWait(),
+		}
+	}
+	return json.Marshal(storageRunConfigs)
+}
+
+func fromStorageUpstreamRunConfigs(raw []byte) (map[job.SpecUpstreamName]*job.UpstreamRunConfig, error) {
+	var storageRunConfigs map[string]UpstreamRunConfig
+	if err := json.Unmarshal(raw, &storageRunConfigs); err != nil {
+		return nil, err
+	}
+	runConfigs := make(map[job.SpecUpstreamName]*job.UpstreamRunConfig, len(storageRunConfigs))
+	for name, storageRunConfig := range storageRunConfigs {
+		runConfig, err := job.NewUpstreamRunConfig(storageRunConfig.RunOffset, storageRunConfig.WindowSize,
+			storageRunConfig.WindowOffset, storageRunConfig.WindowTruncateTo)
+		if err != nil {
+			return nil, err
+		}
+		if storageRunConfig.Optional {
+			if runConfig, err = runConfig.WithOptional(storageRunConfig.MaxWait); err != nil {
+				return nil, err
+			}
+		}
+		runConfigs[job.SpecUpstreamNameFrom(name)] = runConfig
+	}
+	return runConfigs, nil
+}
+
 func toStorageWindow(windowSpec models.Window) ([]byte, error) {
 	window := Window{
 		WindowSize:       windowSpec.GetSize(),
 		WindowOffset:     windowSpec.GetOffset(),
 		WindowTruncateTo: windowSpec.GetTruncateTo(),
+		WindowCalendar:   models.CalendarOf(windowSpec),
 	}
 	windowJSON, err := json.Marshal(window)
 	if err != nil {
@@ -222,8 +285,13 @@ func toStorageHooks(hookSpecs []*job.Hook) ([]byte, error) {
 
 func toStorageHook(spec *job.Hook) Hook {
 	return Hook{
-		Name:   spec.Name(),
-		Config: spec.Config(),
+		Name:       spec.Name(),
+		Config:     spec.Config(),
+		DependsOn:  spec.DependsOn(),
+		RunIf:      spec.RunIf(),
+		RetryCount: spec.RetryCount(),
+		RetryDelay: spec.RetryDelay(),
+		Timeout:    spec.Timeout(),
 	}
 }
 
@@ -389,6 +457,14 @@ func fromStorageSpec(jobSpec *Spec) (*job.Spec, error) {
 		upstreamSpecBuilder = upstreamSpecBuilder.WithUpstreamNames(upstreamNames)
 	}
 
+	if jobSpec.StaticUpstreamConfigs != nil {
+		runConfigs, err := fromStorageUpstreamRunConfigs(jobSpec.StaticUpstreamConfigs)
+		if err != nil {
+			return nil, err
+		}
+		upstreamSpecBuilder = upstreamSpecBuilder.WithRunConfigs(runConfigs)
+	}
+
 	if httpUpstreams != nil || upstreamNames != nil {
 		upstreamSpec, err := upstreamSpecBuilder.Build()
 		if err != nil {
@@ -442,11 +518,12 @@ func fromStorageWindow(raw []byte, jobVersion int) (models.Window, error) {
 		return nil, err
 	}
 
-	return models.NewWindow(
+	return models.NewWindowWithCalendar(
 		jobVersion,
 		storageWindow.WindowTruncateTo,
 		storageWindow.WindowOffset,
 		storageWindow.WindowSize,
+		storageWindow.WindowCalendar,
 	)
 }
 
@@ -506,7 +583,12 @@ func fromStorageHook(hook Hook) (*job.Hook, error) {
 	if err != nil {
 		return nil, err
 	}
-	return job.NewHook(hook.Name, config)
+	return job.NewHookBuilder(hook.Name, config).
+		WithDependsOn(hook.DependsOn).
+		WithRunIf(hook.RunIf).
+		WithRetry(hook.RetryCount, hook.RetryDelay).
+		WithTimeout(hook.Timeout).
+		Build()
 }
 
 func fromStorageAlerts(raw []byte) ([]*job.AlertSpec, error) {
@@ -539,7 +621,7 @@ func FromRow(row pgx.Row) (*Spec, error) {
 	var js Spec
 
 	err := row.Scan(&js.ID, &js.Name, &js.Version, &js.Owner, &js.Description,
-		&js.Labels, &js.Schedule, &js.Alert, &js.StaticUpstreams, &js.HTTPUpstreams,
+		&js.Labels, &js.Schedule, &js.Alert, &js.StaticUpstreams, &js.HTTPUpstreams, &js.StaticUpstreamConfigs,
 		&js.TaskName, &js.TaskConfig, &js.WindowSpec, &js.Assets, &js.Hooks, &js.Metadata, &js.Destination, &js.Sources,
 		&js.ProjectName, &js.NamespaceName, &js.CreatedAt, &js.UpdatedAt, &js.DeletedAt)
 	if err != nil {
@@ -558,7 +640,9 @@ func UpstreamFromRow(row pgx.Row) (*JobWithUpstream, error) {
 
 	err := row.Scan(&js.JobName, &js.ProjectName, &js.UpstreamJobName, &js.UpstreamResourceURN,
 		&js.UpstreamProjectName, &js.UpstreamNamespaceName, &js.UpstreamTaskName, &js.UpstreamHost,
-		&js.UpstreamType, &js.UpstreamState, &js.UpstreamExternal)
+		&js.UpstreamType, &js.UpstreamState, &js.UpstreamExternal,
+		&js.UpstreamRunOffset, &js.UpstreamWindowSize, &js.UpstreamWindowOffset, &js.UpstreamWindowTruncateTo,
+		&js.UpstreamOptional, &js.UpstreamMaxWait)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(job.EntityJob, "job upstream not found")
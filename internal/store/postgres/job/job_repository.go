@@ -3,8 +3,10 @@ package job
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -18,9 +20,13 @@ import (
 
 const (
 	jobColumnsToStore = `name, version, owner, description, labels, schedule, alert, static_upstreams, http_upstreams, 
-	task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at`
+	static_upstream_configs, task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at`
 
 	jobColumns = `id, ` + jobColumnsToStore + `, deleted_at`
+
+	disabledJobColumns = `name, project_name, namespace_name, remark, state_changed_by, state_changed_at, updated_at, enable_at`
+
+	specVersionColumns = `h.version, h.spec, h.changed_by, h.change_source, h.created_at`
 )
 
 type JobRepository struct {
@@ -31,11 +37,11 @@ func NewJobRepository(pool *pgxpool.Pool) *JobRepository {
 	return &JobRepository{db: pool}
 }
 
-func (j JobRepository) Add(ctx context.Context, jobs []*job.Job) ([]*job.Job, error) {
+func (j JobRepository) Add(ctx context.Context, jobs []*job.Job, change *job.SpecChange) ([]*job.Job, error) {
 	me := errors.NewMultiError("add jobs errors")
 	var storedJobs []*job.Job
 	for _, jobEntity := range jobs {
-		if err := j.insertJobSpec(ctx, jobEntity); err != nil {
+		if err := j.insertJobSpec(ctx, jobEntity, change); err != nil {
 			me.Append(err)
 			continue
 		}
@@ -44,7 +50,7 @@ func (j JobRepository) Add(ctx context.Context, jobs []*job.Job) ([]*job.Job, er
 	return storedJobs, me.ToErr()
 }
 
-func (j JobRepository) insertJobSpec(ctx context.Context, jobEntity *job.Job) error {
+func (j JobRepository) insertJobSpec(ctx context.Context, jobEntity *job.Job, change *job.SpecChange) error {
 	existingJob, err := j.get(ctx, jobEntity.ProjectName(), jobEntity.Spec().Name(), false)
 	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
 		return errors.NewError(errors.ErrInternalError, job.EntityJob, fmt.Sprintf("failed to check job %s in db: %s", jobEntity.Spec().Name().String(), err.Error()))
@@ -57,12 +63,12 @@ func (j JobRepository) insertJobSpec(ctx context.Context, jobEntity *job.Job) er
 		return errors.NewError(errors.ErrAlreadyExists, job.EntityJob, errorMsg)
 	}
 	if err == nil && existingJob.DeletedAt.Valid && existingJob.NamespaceName == jobEntity.Tenant().NamespaceName().String() {
-		return j.triggerUpdate(ctx, jobEntity)
+		return j.triggerUpdate(ctx, jobEntity, change)
 	}
-	return j.triggerInsert(ctx, jobEntity)
+	return j.triggerInsert(ctx, jobEntity, change)
 }
 
-func (j JobRepository) triggerInsert(ctx context.Context, jobEntity *job.Job) error {
+func (j JobRepository) triggerInsert(ctx context.Context, jobEntity *job.Job, change *job.SpecChange) error {
 	storageJob, err := toStorageSpec(jobEntity)
 	if err != nil {
 		return err
@@ -70,25 +76,37 @@ func (j JobRepository) triggerInsert(ctx context.Context, jobEntity *job.Job) er
 
 	insertJobQuery := `INSERT INTO job (` + jobColumnsToStore + `)
 	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
-	$17, $18, $19, NOW(), NOW());`
+	$17, $18, $19, $20, NOW(), NOW());`
+
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return errors.InternalError(job.EntityJob, "unable to begin transaction", err)
+	}
 
-	tag, err := j.db.Exec(ctx, insertJobQuery,
+	tag, err := tx.Exec(ctx, insertJobQuery,
 		storageJob.Name, storageJob.Version, storageJob.Owner, storageJob.Description, storageJob.Labels,
 		storageJob.Schedule, storageJob.Alert, storageJob.StaticUpstreams, storageJob.HTTPUpstreams,
-		storageJob.TaskName, storageJob.TaskConfig, storageJob.WindowSpec, storageJob.Assets,
+		storageJob.StaticUpstreamConfigs, storageJob.TaskName, storageJob.TaskConfig, storageJob.WindowSpec, storageJob.Assets,
 		storageJob.Hooks, storageJob.Metadata, storageJob.Destination, storageJob.Sources,
 		storageJob.ProjectName, storageJob.NamespaceName)
 	if err != nil {
+		tx.Rollback(ctx)
 		return errors.Wrap(job.EntityJob, "unable to save job spec", err)
 	}
 
 	if tag.RowsAffected() == 0 {
+		tx.Rollback(ctx)
 		return errors.InternalError(job.EntityJob, "unable to save job spec, rows affected 0", nil)
 	}
-	return nil
+
+	if err := insertSpecVersion(ctx, tx, storageJob, change); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	return tx.Commit(ctx)
 }
 
-func (j JobRepository) Update(ctx context.Context, jobs []*job.Job) ([]*job.Job, error) {
+func (j JobRepository) Update(ctx context.Context, jobs []*job.Job, change *job.SpecChange) ([]*job.Job, error) {
 	me := errors.NewMultiError("update jobs errors")
 	var storedJobs []*job.Job
 	for _, jobEntity := range jobs {
@@ -96,7 +114,7 @@ func (j JobRepository) Update(ctx context.Context, jobs []*job.Job) ([]*job.Job,
 			me.Append(err)
 			continue
 		}
-		if err := j.triggerUpdate(ctx, jobEntity); err != nil {
+		if err := j.triggerUpdate(ctx, jobEntity, change); err != nil {
 			me.Append(err)
 			continue
 		}
@@ -105,12 +123,13 @@ func (j JobRepository) Update(ctx context.Context, jobs []*job.Job) ([]*job.Job,
 	return storedJobs, me.ToErr()
 }
 
-func (j JobRepository) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error {
+func (j JobRepository) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, change *job.StateChange) error {
 	updateJobStateQuery := `
-UPDATE job SET state = $1, remark = $2, updated_at = NOW()
-WHERE project_name = $4 AND namespace_name = $5 AND name = any ($3);`
+UPDATE job SET state = $1, remark = $2, state_changed_by = NULLIF($3, ''), state_changed_at = NOW(), enable_at = $4, updated_at = NOW()
+WHERE project_name = $6 AND namespace_name = $7 AND name = any ($5);`
 
-	tag, err := j.db.Exec(ctx, updateJobStateQuery, jobState, remark, jobNames, jobTenant.ProjectName(), jobTenant.NamespaceName())
+	tag, err := j.db.Exec(ctx, updateJobStateQuery, change.State, change.Remark, change.ChangedBy, change.EnableAt, jobNames,
+		jobTenant.ProjectName(), jobTenant.NamespaceName())
 	if err != nil {
 		return errors.Wrap(job.EntityJob, "error during job state update", err)
 	}
@@ -120,22 +139,91 @@ WHERE project_name = $4 AND namespace_name = $5 AND name = any ($3);`
 	return nil
 }
 
+// GetDisabledJobs returns the disabled jobs of the project, or of the namespace when given
+func (j JobRepository) GetDisabledJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName string) ([]*job.DisabledJob, error) {
+	query := `SELECT ` + disabledJobColumns + ` FROM job
+WHERE project_name = $1 AND ($2 = '' OR namespace_name = $2) AND state = 'disabled' AND deleted_at IS NULL
+ORDER BY namespace_name, name`
+
+	rows, err := j.db.Query(ctx, query, projectName, namespaceName)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting disabled jobs", err)
+	}
+	defer rows.Close()
+
+	return disabledJobsFromRows(rows)
+}
+
+// GetJobsToEnable returns the disabled jobs of every project which are to be enabled back by the given time
+func (j JobRepository) GetJobsToEnable(ctx context.Context, until time.Time) ([]*job.DisabledJob, error) {
+	query := `SELECT ` + disabledJobColumns + ` FROM job
+WHERE state = 'disabled' AND enable_at <= $1 AND deleted_at IS NULL
+ORDER BY project_name, namespace_name, name`
+
+	rows, err := j.db.Query(ctx, query, until)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting jobs to enable", err)
+	}
+	defer rows.Close()
+
+	return disabledJobsFromRows(rows)
+}
+
+func disabledJobsFromRows(rows pgx.Rows) ([]*job.DisabledJob, error) {
+	var disabledJobs []*job.DisabledJob
+	for rows.Next() {
+		var name, projectName, namespaceName string
+		var remark, disabledBy sql.NullString
+		var updatedAt time.Time
+		var stateChangedAt, enableAt sql.NullTime
+		if err := rows.Scan(&name, &projectName, &namespaceName, &remark, &disabledBy, &stateChangedAt, &updatedAt, &enableAt); err != nil {
+			return nil, errors.Wrap(job.EntityJob, "error while scanning disabled job", err)
+		}
+
+		jobTenant, err := tenant.NewTenant(projectName, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+		// jobs disabled before the state change was recorded fall back to their last update
+		disabledAt := updatedAt
+		if stateChangedAt.Valid {
+			disabledAt = stateChangedAt.Time
+		}
+		disabledJob := &job.DisabledJob{
+			Name:       job.Name(name),
+			Tenant:     jobTenant,
+			Remark:     remark.String,
+			DisabledBy: disabledBy.String,
+			DisabledAt: disabledAt,
+		}
+		if enableAt.Valid {
+			disabledJob.EnableAt = &enableAt.Time
+		}
+		disabledJobs = append(disabledJobs, disabledJob)
+	}
+	return disabledJobs, nil
+}
+
 func (j JobRepository) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	tx, err := j.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	updateJobStateQuery := `
-UPDATE job SET state = $1
+	enableJobQuery := `
+UPDATE job SET state = $1, enable_at = NULL
 WHERE project_name = $2 AND namespace_name = $3 AND name = any ($4);`
 
-	_, err = tx.Exec(ctx, updateJobStateQuery, job.ENABLED, jobTenant.ProjectName(), jobTenant.NamespaceName(), enabledJobNames)
+	_, err = tx.Exec(ctx, enableJobQuery, job.ENABLED, jobTenant.ProjectName(), jobTenant.NamespaceName(), enabledJobNames)
 	if err != nil {
 		tx.Rollback(ctx)
 		return errors.Wrap(job.EntityJob, "error during job state enable sync", err)
 	}
 
-	_, err = j.db.Exec(ctx, updateJobStateQuery, job.DISABLED, jobTenant.ProjectName(), jobTenant.NamespaceName(), disabledJobNames)
+	disableJobQuery := `
+UPDATE job SET state = $1
+WHERE project_name = $2 AND namespace_name = $3 AND name = any ($4);`
+
+	_, err = tx.Exec(ctx, disableJobQuery, job.DISABLED, jobTenant.ProjectName(), jobTenant.NamespaceName(), disabledJobNames)
 	if err != nil {
 		tx.Rollback(ctx)
 		return errors.Wrap(job.EntityJob, "error during job state disable sync", err)
@@ -246,7 +334,7 @@ func (j JobRepository) preCheckUpdate(ctx context.Context, jobEntity *job.Job) e
 	return nil
 }
 
-func (j JobRepository) triggerUpdate(ctx context.Context, jobEntity *job.Job) error {
+func (j JobRepository) triggerUpdate(ctx context.Context, jobEntity *job.Job, change *job.SpecChange) error {
 	storageJob, err := toStorageSpec(jobEntity)
 	if err != nil {
 		return err
@@ -255,30 +343,132 @@ func (j JobRepository) triggerUpdate(ctx context.Context, jobEntity *job.Job) er
 	updateJobQuery := `
 UPDATE job SET
 	version = $1, owner = $2, description = $3, labels = $4, schedule = $5, alert = $6,
-	static_upstreams = $7, http_upstreams = $8, task_name = $9, task_config = $10,
-	window_spec = $11, assets = $12, hooks = $13, metadata = $14, destination = $15, sources = $16,
+	static_upstreams = $7, http_upstreams = $8, static_upstream_configs = $9, task_name = $10, task_config = $11,
+	window_spec = $12, assets = $13, hooks = $14, metadata = $15, destination = $16, sources = $17,
 	updated_at = NOW(), deleted_at = null
 WHERE
-	name = $17 AND
-	project_name = $18;`
+	name = $18 AND
+	project_name = $19;`
 
-	tag, err := j.db.Exec(ctx, updateJobQuery,
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return errors.InternalError(job.EntityJob, "unable to begin transaction", err)
+	}
+
+	tag, err := tx.Exec(ctx, updateJobQuery,
 		storageJob.Version, storageJob.Owner, storageJob.Description,
 		storageJob.Labels, storageJob.Schedule, storageJob.Alert,
-		storageJob.StaticUpstreams, storageJob.HTTPUpstreams, storageJob.TaskName, storageJob.TaskConfig,
+		storageJob.StaticUpstreams, storageJob.HTTPUpstreams, storageJob.StaticUpstreamConfigs,
+		storageJob.TaskName, storageJob.TaskConfig,
 		storageJob.WindowSpec, storageJob.Assets, storageJob.Hooks, storageJob.Metadata,
 		storageJob.Destination, storageJob.Sources,
 		storageJob.Name, storageJob.ProjectName)
 	if err != nil {
+		tx.Rollback(ctx)
 		return errors.Wrap(job.EntityJob, "unable to update job spec", err)
 	}
 
 	if tag.RowsAffected() == 0 {
+		tx.Rollback(ctx)
 		return errors.InternalError(job.EntityJob, "unable to update job spec, rows affected 0", nil)
 	}
+
+	if err := insertSpecVersion(ctx, tx, storageJob, change); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// insertSpecVersion writes the snapshot of the stored job as its next version, unless it is the same as the latest one
+func insertSpecVersion(ctx context.Context, tx pgx.Tx, storageJob *Spec, change *job.SpecChange) error {
+	spec, err := json.Marshal(storageJob)
+	if err != nil {
+		return errors.Wrap(job.EntityJob, "unable to marshal job spec version", err)
+	}
+
+	insertSpecVersionQuery := `
+INSERT INTO job_spec_history (job_id, version, spec, changed_by, change_source, created_at)
+SELECT job.id, COALESCE(latest.version, 0) + 1, $1, NULLIF($2, ''), $3, NOW()
+FROM job
+	LEFT JOIN LATERAL (
+		SELECT h.version, h.spec FROM job_spec_history h WHERE h.job_id = job.id ORDER BY h.version DESC LIMIT 1
+	) latest ON TRUE
+WHERE job.name = $4 AND job.project_name = $5 AND (latest.spec IS NULL OR latest.spec <> $1::jsonb);`
+
+	if _, err := tx.Exec(ctx, insertSpecVersionQuery, spec, change.ChangedBy, change.Source,
+		storageJob.Name, storageJob.ProjectName); err != nil {
+		return errors.Wrap(job.EntityJob, "unable to save job spec version", err)
+	}
 	return nil
 }
 
+// GetSpecVersions returns every version of the job spec, latest first
+func (j JobRepository) GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	query := `SELECT ` + specVersionColumns + ` FROM job_spec_history h JOIN job ON h.job_id = job.id
+WHERE job.project_name = $1 AND job.name = $2
+ORDER BY h.version DESC`
+
+	rows, err := j.db.Query(ctx, query, projectName, jobName)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting job spec versions", err)
+	}
+	defer rows.Close()
+
+	var specVersions []*job.SpecVersion
+	for rows.Next() {
+		specVersion, err := specVersionFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		specVersions = append(specVersions, specVersion)
+	}
+	return specVersions, nil
+}
+
+// GetSpecVersion returns the given version of the job spec
+func (j JobRepository) GetSpecVersion(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, version int) (*job.SpecVersion, error) {
+	query := `SELECT ` + specVersionColumns + ` FROM job_spec_history h JOIN job ON h.job_id = job.id
+WHERE job.project_name = $1 AND job.name = $2 AND h.version = $3`
+
+	specVersion, err := specVersionFromRow(j.db.QueryRow(ctx, query, projectName, jobName, version))
+	if errors.IsErrorType(err, errors.ErrNotFound) {
+		err = errors.NotFound(job.EntityJob, fmt.Sprintf("version %d of job %s is not found", version, jobName))
+	}
+	return specVersion, err
+}
+
+func specVersionFromRow(row pgx.Row) (*job.SpecVersion, error) {
+	var version int
+	var rawSpec []byte
+	var changedBy sql.NullString
+	var source string
+	var createdAt time.Time
+	if err := row.Scan(&version, &rawSpec, &changedBy, &source, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(job.EntityJob, "job spec version not found")
+		}
+		return nil, errors.Wrap(job.EntityJob, "error in reading row for job spec version", err)
+	}
+
+	var storageJob Spec
+	if err := json.Unmarshal(rawSpec, &storageJob); err != nil {
+		return nil, errors.Wrap(job.EntityJob, "unable to unmarshal job spec version", err)
+	}
+	jobEntity, err := specToJob(&storageJob)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job.SpecVersion{
+		Version:   version,
+		Job:       jobEntity,
+		ChangedBy: changedBy.String,
+		Source:    job.ChangeSource(source),
+		CreatedAt: createdAt,
+	}, nil
+}
+
 func (j JobRepository) get(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, onlyActiveJob bool) (*Spec, error) {
 	getJobByNameAtProject := `SELECT ` + jobColumns + ` FROM job WHERE name = $1 AND project_name = $2`
 
@@ -426,8 +616,14 @@ func (JobRepository) toUpstreams(storeUpstreams []*JobWithUpstream) ([]*job.Upst
 			upstreamProjectName, _ = tenant.ProjectNameFrom(storeUpstream.UpstreamProjectName.String)
 		}
 
+		runConfig, err := storeUpstream.getRunConfig()
+		if err != nil {
+			me.Append(errors.Wrap(job.EntityJob, fmt.Sprintf("failed to get run config for upstream %s of job %s", storeUpstream.UpstreamJobName.String, storeUpstream.JobName), err))
+			continue
+		}
+
 		if storeUpstream.UpstreamState == job.UpstreamStateUnresolved.String() && storeUpstream.UpstreamJobName.Valid {
-			upstreams = append(upstreams, job.NewUpstreamUnresolvedStatic(upstreamName, upstreamProjectName))
+			upstreams = append(upstreams, job.NewUpstreamUnresolvedStatic(upstreamName, upstreamProjectName).WithRunConfig(runConfig))
 			continue
 		}
 
@@ -437,7 +633,6 @@ func (JobRepository) toUpstreams(storeUpstreams []*JobWithUpstream) ([]*job.Upst
 		}
 
 		var upstreamTenant tenant.Tenant
-		var err error
 		if storeUpstream.UpstreamProjectName.Valid && storeUpstream.UpstreamNamespaceName.Valid {
 			upstreamTenant, err = tenant.NewTenant(storeUpstream.UpstreamProjectName.String, storeUpstream.UpstreamNamespaceName.String)
 			if err != nil {
@@ -471,7 +666,7 @@ func (JobRepository) toUpstreams(storeUpstreams []*JobWithUpstream) ([]*job.Upst
 		}
 
 		upstream := job.NewUpstreamResolved(upstreamName, upstreamHost, resourceURN, upstreamTenant, upstreamType, taskName, upstreamExternal)
-		upstreams = append(upstreams, upstream)
+		upstreams = append(upstreams, upstream.WithRunConfig(runConfig))
 	}
 	if err := me.ToErr(); err != nil {
 		return nil, err
@@ -589,12 +784,37 @@ type JobWithUpstream struct {
 	UpstreamType          string         `json:"upstream_type"`
 	UpstreamState         string         `json:"upstream_state"`
 	UpstreamExternal      sql.NullBool   `json:"upstream_external"`
+
+	UpstreamRunOffset        sql.NullString `json:"upstream_run_offset"`
+	UpstreamWindowSize       sql.NullString `json:"upstream_window_size"`
+	UpstreamWindowOffset     sql.NullString `json:"upstream_window_offset"`
+	UpstreamWindowTruncateTo sql.NullString `json:"upstream_window_truncate_to"`
+	UpstreamOptional         bool           `json:"upstream_optional"`
+	UpstreamMaxWait          sql.NullString `json:"upstream_max_wait"`
 }
 
 func (j *JobWithUpstream) getJobFullName() string {
 	return j.ProjectName + "/" + j.JobName
 }
 
+func (j *JobWithUpstream) getRunConfig() (*job.UpstreamRunConfig, error) {
+	if !j.UpstreamRunOffset.Valid && !j.UpstreamWindowSize.Valid && !j.UpstreamOptional {
+		return nil, nil
+	}
+	runConfig, err := job.NewUpstreamRunConfig(j.UpstreamRunOffset.String, j.UpstreamWindowSize.String,
+		j.UpstreamWindowOffset.String, j.UpstreamWindowTruncateTo.String)
+	if err != nil || !j.UpstreamOptional {
+		return runConfig, err
+	}
+	var maxWait time.Duration
+	if j.UpstreamMaxWait.Valid {
+		if maxWait, err = time.ParseDuration(j.UpstreamMaxWait.String); err != nil {
+			return nil, err
+		}
+	}
+	return runConfig.WithOptional(maxWait)
+}
+
 func (j JobRepository) ReplaceUpstreams(ctx context.Context, jobsWithUpstreams []*job.WithUpstream) error {
 	var jobUpstreams []*JobWithUpstream
 	for _, jobWithUpstreams := range jobsWithUpstreams {
@@ -633,6 +853,8 @@ INSERT INTO job_upstream (
 	upstream_project_name, upstream_namespace_name, upstream_host,
 	upstream_task_name, upstream_external,
 	upstream_type, upstream_state,
+	upstream_run_offset, upstream_window_size, upstream_window_offset, upstream_window_truncate_to,
+	upstream_optional, upstream_max_wait,
 	created_at
 )
 VALUES (
@@ -641,6 +863,8 @@ VALUES (
 	$5, $6, $7,
 	$8, $9,
 	$10, $11,
+	$12, $13, $14, $15,
+	$16, $17,
 	NOW()
 );`
 
@@ -649,12 +873,16 @@ INSERT INTO job_upstream (
 	job_id, job_name, project_name,
 	upstream_job_name, upstream_resource_urn, upstream_project_name,
 	upstream_type, upstream_state,
+	upstream_run_offset, upstream_window_size, upstream_window_offset, upstream_window_truncate_to,
+	upstream_optional, upstream_max_wait,
 	created_at
 )
 VALUES (
 	(select id FROM job WHERE name = $1 and project_name = $2), $1, $2, 
 	$3, $4, $5,
 	$6, $7, 
+	$8, $9, $10, $11,
+	$12, $13,
 	NOW()
 );
 `
@@ -668,12 +896,16 @@ VALUES (
 				upstream.UpstreamJobName, upstream.UpstreamResourceURN,
 				upstream.UpstreamProjectName, upstream.UpstreamNamespaceName, upstream.UpstreamHost,
 				upstream.UpstreamTaskName, upstream.UpstreamExternal,
-				upstream.UpstreamType, upstream.UpstreamState)
+				upstream.UpstreamType, upstream.UpstreamState,
+				upstream.UpstreamRunOffset, upstream.UpstreamWindowSize, upstream.UpstreamWindowOffset, upstream.UpstreamWindowTruncateTo,
+				upstream.UpstreamOptional, upstream.UpstreamMaxWait)
 		} else {
 			tag, err = tx.Exec(ctx, insertUnresolvedUpstreamQuery,
 				upstream.JobName, upstream.ProjectName,
 				upstream.UpstreamJobName, upstream.UpstreamResourceURN, upstream.UpstreamProjectName,
-				upstream.UpstreamType, upstream.UpstreamState)
+				upstream.UpstreamType, upstream.UpstreamState,
+				upstream.UpstreamRunOffset, upstream.UpstreamWindowSize, upstream.UpstreamWindowOffset, upstream.UpstreamWindowTruncateTo,
+				upstream.UpstreamOptional, upstream.UpstreamMaxWait)
 		}
 
 		if err != nil {
@@ -711,7 +943,7 @@ func toJobUpstream(jobWithUpstream *job.WithUpstream) []*JobWithUpstream {
 		if upstream.NamespaceName() != "" {
 			upstreamNamespaceName = upstream.NamespaceName().String()
 		}
-		jobUpstreams = append(jobUpstreams, &JobWithUpstream{
+		jobUpstream := &JobWithUpstream{
 			JobName:               jobWithUpstream.Name().String(),
 			ProjectName:           jobWithUpstream.Job().ProjectName().String(),
 			UpstreamJobName:       toNullString(upstream.Name().String()),
@@ -723,7 +955,18 @@ func toJobUpstream(jobWithUpstream *job.WithUpstream) []*JobWithUpstream {
 			UpstreamType:          upstream.Type().String(),
 			UpstreamState:         upstream.State().String(),
 			UpstreamExternal:      toNullBool(upstream.External()),
-		})
+		}
+		if runConfig := upstream.RunConfig(); runConfig != nil {
+			jobUpstream.UpstreamRunOffset = toNullString(runConfig.RunOffset())
+			jobUpstream.UpstreamWindowSize = toNullString(runConfig.WindowSize())
+			jobUpstream.UpstreamWindowOffset = toNullString(runConfig.WindowOffset())
+			jobUpstream.UpstreamWindowTruncateTo = toNullString(runConfig.WindowTruncateTo())
+			jobUpstream.UpstreamOptional = runConfig.IsOptional()
+			if runConfig.MaxWait() > 0 {
+				jobUpstream.UpstreamMaxWait = toNullString(runConfig.MaxWait().String())
+			}
+		}
+		jobUpstreams = append(jobUpstreams, jobUpstream)
 	}
 	return jobUpstreams
 }
@@ -819,7 +1062,9 @@ func (j JobRepository) GetUpstreams(ctx context.Context, projectName tenant.Proj
 	query := `
 SELECT
 	job_name, project_name, upstream_job_name, upstream_resource_urn, upstream_project_name,
-	upstream_namespace_name, upstream_task_name, upstream_host, upstream_type, upstream_state, upstream_external
+	upstream_namespace_name, upstream_task_name, upstream_host, upstream_type, upstream_state, upstream_external,
+	upstream_run_offset, upstream_window_size, upstream_window_offset, upstream_window_truncate_to,
+	upstream_optional, upstream_max_wait
 FROM job_upstream
 WHERE project_name=$1 AND job_name=$2;`
 
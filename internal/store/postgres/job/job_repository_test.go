@@ -5,6 +5,7 @@ package job_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +20,7 @@ import (
 
 func TestPostgresJobRepository(t *testing.T) {
 	ctx := context.Background()
+	change := job.NewSpecChange("optimus@example.io", job.ChangeSourceAPI)
 
 	proj, err := tenant.NewProject("test-proj",
 		map[string]string{
@@ -150,7 +152,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA, jobB}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 
@@ -168,7 +170,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 		})
@@ -180,14 +182,14 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"resource-3"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 
 			jobSpecB, err := job.NewSpecBuilder(jobVersion, "sample-job-B", jobOwner, jobSchedule, jobWindow, jobTask).WithDescription(jobDescription).Build()
 			assert.NoError(t, err)
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"resource-3"})
 
-			addedJobs, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			addedJobs, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.ErrorContains(t, err, "already exists")
 			assert.EqualValues(t, []*job.Job{jobB}, addedJobs)
 		})
@@ -203,10 +205,10 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"resource-3"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
-			addedJobs, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			addedJobs, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.ErrorContains(t, err, "already exists")
 			assert.Nil(t, addedJobs)
 		})
@@ -267,14 +269,14 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA, jobB}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 
 			err = jobRepo.Delete(ctx, proj.Name(), jobA.Spec().Name(), false)
 			assert.NoError(t, err)
 
-			addedJobs, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			addedJobs, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, []*job.Job{jobA}, addedJobs)
 		})
@@ -324,7 +326,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 
@@ -335,7 +337,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			assert.NoError(t, err)
 
 			jobAToReAdd := job.NewJob(otherTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"resource-3"})
-			addedJobs, err = jobRepo.Add(ctx, []*job.Job{jobAToReAdd})
+			addedJobs, err = jobRepo.Add(ctx, []*job.Job{jobAToReAdd}, change)
 			assert.ErrorContains(t, err, "already exists and soft deleted in namespace test-ns")
 			assert.Nil(t, addedJobs)
 		})
@@ -356,7 +358,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA, jobB}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 
@@ -369,7 +371,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobBToUpdate := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"resource-4"})
 			jobsToUpdate := []*job.Job{jobAToUpdate, jobBToUpdate}
 
-			updatedJobs, err := jobRepo.Update(ctx, jobsToUpdate)
+			updatedJobs, err := jobRepo.Update(ctx, jobsToUpdate, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobsToUpdate, updatedJobs)
 		})
@@ -381,7 +383,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"resource-3"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 
 			jobSpecAToUpdate, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, jobWindow, jobTask).
@@ -395,7 +397,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobBToUpdate := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"resource-4"})
 			jobsToUpdate := []*job.Job{jobAToUpdate, jobBToUpdate}
 
-			updatedJobs, err := jobRepo.Update(ctx, jobsToUpdate)
+			updatedJobs, err := jobRepo.Update(ctx, jobsToUpdate, change)
 			assert.ErrorContains(t, err, "not exists yet")
 			assert.EqualValues(t, []*job.Job{jobAToUpdate}, updatedJobs)
 		})
@@ -411,7 +413,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"resource-3"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Update(ctx, []*job.Job{jobA, jobB})
+			addedJobs, err := jobRepo.Update(ctx, []*job.Job{jobA, jobB}, change)
 			assert.Error(t, err)
 			assert.Nil(t, addedJobs)
 		})
@@ -425,21 +427,21 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 
 			err = jobRepo.Delete(ctx, proj.Name(), jobSpecA.Name(), false)
 			assert.NoError(t, err)
 
-			updatedJobs, err := jobRepo.Update(ctx, []*job.Job{jobA})
+			updatedJobs, err := jobRepo.Update(ctx, []*job.Job{jobA}, change)
 			assert.ErrorContains(t, err, "update is not allowed as job sample-job-A has been soft deleted")
 			assert.Nil(t, updatedJobs)
 
 			otherTenant, err := tenant.NewTenant(proj.Name().String(), otherNamespace.Name().String())
 			assert.NoError(t, err)
 			jobToUpdate := job.NewJob(otherTenant, jobSpecA, "", nil)
-			updatedJobs, err = jobRepo.Update(ctx, []*job.Job{jobToUpdate})
+			updatedJobs, err = jobRepo.Update(ctx, []*job.Job{jobToUpdate}, change)
 			assert.ErrorContains(t, err, "already exists and soft deleted in namespace test-ns")
 			assert.Nil(t, updatedJobs)
 		})
@@ -453,7 +455,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobs := []*job.Job{jobA}
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJobs, err := jobRepo.Add(ctx, jobs)
+			addedJobs, err := jobRepo.Add(ctx, jobs, change)
 			assert.NoError(t, err)
 			assert.EqualValues(t, jobs, addedJobs)
 
@@ -461,7 +463,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			assert.NoError(t, err)
 			jobAToUpdate := job.NewJob(otherTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"resource-3"})
 
-			updatedJobs, err := jobRepo.Update(ctx, []*job.Job{jobAToUpdate})
+			updatedJobs, err := jobRepo.Update(ctx, []*job.Job{jobAToUpdate}, change)
 			assert.ErrorContains(t, err, "job sample-job-A already exists in namespace test-ns")
 			assert.Nil(t, updatedJobs)
 		})
@@ -483,7 +485,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			expectedUpstream := job.NewUpstreamResolved(jobSpecB.Name(), "", jobB.Destination(), tnnt, "inferred", taskName, false)
@@ -511,7 +513,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			expectedUpstream := job.NewUpstreamResolved(jobSpecB.Name(), "", jobB.Destination(), tnnt, "static", taskName, false)
@@ -543,7 +545,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobC := job.NewJob(sampleTenant, jobSpecC, "dev.resource.sample_c", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			upstreamB := job.NewUpstreamResolved(jobSpecB.Name(), "", jobB.Destination(), tenantDetails.ToTenant(), "static", taskName, false)
@@ -589,7 +591,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobE := job.NewJob(otherTenant, jobSpecE, "dev.resource.sample_e", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC, jobD, jobE})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC, jobD, jobE}, change)
 			assert.NoError(t, err)
 
 			upstreamB := job.NewUpstreamResolved(jobSpecB.Name(), "", jobB.Destination(), sampleTenant, "static", taskName, false)
@@ -627,7 +629,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			upstreamB := job.NewUpstreamResolved(jobSpecB.Name(), "", jobB.Destination(), tnnt, "static", taskName, false)
@@ -664,7 +666,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobWithUpstream := job.NewWithUpstream(jobA, upstreams)
 
 			jobUpstreamRepo := postgres.NewJobRepository(db)
-			_, err := jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err := jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			assert.Nil(t, jobUpstreamRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobWithUpstream}))
@@ -679,7 +681,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobWithUpstream := job.NewWithUpstream(jobA, upstreams)
 
 			jobUpstreamRepo := postgres.NewJobRepository(db)
-			_, err := jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err := jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 			assert.Nil(t, jobUpstreamRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobWithUpstream}))
 		})
@@ -690,7 +692,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			upstreamC := job.NewUpstreamResolved("jobC", host, "resource-C", sampleTenant, upstreamType, taskName, false)
 
 			jobUpstreamRepo := postgres.NewJobRepository(db)
-			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
@@ -713,7 +715,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			upstreamB := job.NewUpstreamResolved("jobB", host, "resource-B", sampleTenant, upstreamType, taskName, false)
 
 			jobUpstreamRepo := postgres.NewJobRepository(db)
-			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
@@ -739,7 +741,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobWithUpstream := job.NewWithUpstream(jobA, upstreams)
 
 			jobUpstreamRepo := postgres.NewJobRepository(db)
-			_, err := jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err := jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			otherTenant, err := tenant.NewTenant(otherProj.Name().String(), otherNamespace.Name().String())
@@ -747,7 +749,7 @@ func TestPostgresJobRepository(t *testing.T) {
 
 			otherProjectJobA := job.NewJob(otherTenant, jobSpecA, "dev-external.resource.sample_a", []job.ResourceURN{"dev-external.resource.sample_c"})
 			otherProjectJobB := job.NewJob(otherTenant, jobSpecB, "dev-external.resource.sample_b", nil)
-			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{otherProjectJobA, otherProjectJobB})
+			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{otherProjectJobA, otherProjectJobB}, change)
 			assert.NoError(t, err)
 
 			assert.Nil(t, jobUpstreamRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobWithUpstream}))
@@ -767,7 +769,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			db := dbSetup()
 
 			jobRepo := postgres.NewJobRepository(db)
-			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 			assert.NotNil(t, addedJob)
 
@@ -807,7 +809,7 @@ func TestPostgresJobRepository(t *testing.T) {
 
 			jobRepo := postgres.NewJobRepository(db)
 
-			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA})
+			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 			assert.NotNil(t, addedJob)
 
@@ -815,7 +817,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			assert.NoError(t, err)
 
 			// update failure with proper log message shows job has been soft deleted
-			_, err = jobRepo.Update(ctx, []*job.Job{jobA})
+			_, err = jobRepo.Update(ctx, []*job.Job{jobA}, change)
 			assert.ErrorContains(t, err, "update is not allowed as job sample-job-A has been soft deleted")
 		})
 		t.Run("should return error if the soft delete failed", func(t *testing.T) {
@@ -838,7 +840,7 @@ func TestPostgresJobRepository(t *testing.T) {
 
 			jobRepo := postgres.NewJobRepository(db)
 
-			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA})
+			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 			assert.NotNil(t, addedJob)
 
@@ -846,7 +848,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			assert.NoError(t, err)
 
 			// update failure with proper log message shows job has been hard deleted
-			_, err = jobRepo.Update(ctx, []*job.Job{jobA})
+			_, err = jobRepo.Update(ctx, []*job.Job{jobA}, change)
 			assert.ErrorContains(t, err, "job sample-job-A not exists yet")
 		})
 		t.Run("should return error if the hard delete failed", func(t *testing.T) {
@@ -873,7 +875,7 @@ func TestPostgresJobRepository(t *testing.T) {
 
 			jobRepo := postgres.NewJobRepository(db)
 
-			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA, jobX})
+			addedJob, err := jobRepo.Add(ctx, []*job.Job{jobA, jobX}, change)
 			assert.NoError(t, err)
 			assert.NotNil(t, addedJob)
 
@@ -893,7 +895,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			assert.NoError(t, err)
 
 			// should succeed adding as job already cleaned earlier
-			addedJob, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			addedJob, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 			assert.NotNil(t, addedJob)
 
@@ -913,7 +915,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"dev.resource.sample_b", "dev.resource.sample_c"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 
 			actual, err := jobRepo.GetByJobName(ctx, sampleTenant.ProjectName(), "sample-job-A")
@@ -929,7 +931,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"dev.resource.sample_b", "dev.resource.sample_c"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
 			assert.NoError(t, err)
 
 			actual, err := jobRepo.GetByJobName(ctx, sampleTenant.ProjectName(), "sample-job-A")
@@ -946,6 +948,163 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("UpdateState", func(t *testing.T) {
+		t.Run("disables jobs until the enable time, which are reported as disabled and to enable", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, jobWindow, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+			jobSpecB, err := job.NewSpecBuilder(jobVersion, "sample-job-B", jobOwner, jobSchedule, jobWindow, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", nil)
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
+			assert.NoError(t, err)
+
+			enableAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			disableUntil, err := job.NewStateChange(job.DISABLED, "maintenance", "optimus@example.io", &enableAt)
+			assert.NoError(t, err)
+			err = jobRepo.UpdateState(ctx, sampleTenant, []job.Name{"sample-job-A"}, disableUntil)
+			assert.NoError(t, err)
+			disable, err := job.NewStateChange(job.DISABLED, "deprecated", "", nil)
+			assert.NoError(t, err)
+			err = jobRepo.UpdateState(ctx, sampleTenant, []job.Name{"sample-job-B"}, disable)
+			assert.NoError(t, err)
+
+			disabledJobs, err := jobRepo.GetDisabledJobs(ctx, sampleTenant.ProjectName(), sampleTenant.NamespaceName().String())
+			assert.NoError(t, err)
+			assert.Len(t, disabledJobs, 2)
+			assert.Equal(t, job.Name("sample-job-A"), disabledJobs[0].Name)
+			assert.Equal(t, sampleTenant, disabledJobs[0].Tenant)
+			assert.Equal(t, "maintenance", disabledJobs[0].Remark)
+			assert.Equal(t, "optimus@example.io", disabledJobs[0].DisabledBy)
+			assert.True(t, enableAt.Equal(*disabledJobs[0].EnableAt))
+			assert.False(t, disabledJobs[0].DisabledAt.IsZero())
+			assert.Empty(t, disabledJobs[1].DisabledBy)
+			assert.Nil(t, disabledJobs[1].EnableAt)
+
+			jobsToEnable, err := jobRepo.GetJobsToEnable(ctx, time.Now())
+			assert.NoError(t, err)
+			assert.Empty(t, jobsToEnable)
+
+			jobsToEnable, err = jobRepo.GetJobsToEnable(ctx, enableAt)
+			assert.NoError(t, err)
+			assert.Len(t, jobsToEnable, 1)
+			assert.Equal(t, job.Name("sample-job-A"), jobsToEnable[0].Name)
+
+			enable, err := job.NewStateChange(job.ENABLED, "maintenance done", "optimus@example.io", nil)
+			assert.NoError(t, err)
+			err = jobRepo.UpdateState(ctx, sampleTenant, []job.Name{"sample-job-A"}, enable)
+			assert.NoError(t, err)
+
+			disabledJobs, err = jobRepo.GetDisabledJobs(ctx, sampleTenant.ProjectName(), "")
+			assert.NoError(t, err)
+			assert.Len(t, disabledJobs, 1)
+			assert.Equal(t, job.Name("sample-job-B"), disabledJobs[0].Name)
+		})
+	})
+
+	t.Run("GetSpecVersions", func(t *testing.T) {
+		t.Run("records a version on every changed spec and returns them latest first", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, jobWindow, jobTask).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
+			assert.NoError(t, err)
+
+			// an update without any change to the spec does not add a version
+			_, err = jobRepo.Update(ctx, []*job.Job{jobA}, job.NewSpecChange("", job.ChangeSourceRefresh))
+			assert.NoError(t, err)
+
+			jobSpecAToUpdate, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, jobWindow, jobTask).
+				WithDescription(jobDescription).
+				Build()
+			assert.NoError(t, err)
+			jobAToUpdate := job.NewJob(sampleTenant, jobSpecAToUpdate, "dev.resource.sample_a", nil)
+			_, err = jobRepo.Update(ctx, []*job.Job{jobAToUpdate}, job.NewSpecChange("", job.ChangeSourceReplaceAll))
+			assert.NoError(t, err)
+
+			versions, err := jobRepo.GetSpecVersions(ctx, sampleTenant.ProjectName(), jobSpecA.Name())
+			assert.NoError(t, err)
+			assert.Len(t, versions, 2)
+			assert.Equal(t, 2, versions[0].Version)
+			assert.Equal(t, job.ChangeSourceReplaceAll, versions[0].Source)
+			assert.Empty(t, versions[0].ChangedBy)
+			assert.Equal(t, jobDescription, versions[0].Job.Spec().Description())
+			assert.Equal(t, 1, versions[1].Version)
+			assert.Equal(t, job.ChangeSourceAPI, versions[1].Source)
+			assert.Equal(t, "optimus@example.io", versions[1].ChangedBy)
+			assert.Equal(t, sampleTenant, versions[1].Job.Tenant())
+			assert.Empty(t, versions[1].Job.Spec().Description())
+
+			version, err := jobRepo.GetSpecVersion(ctx, sampleTenant.ProjectName(), jobSpecA.Name(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, versions[1].Job.Spec(), version.Job.Spec())
+		})
+		t.Run("returns not found error if the version does not exist", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, jobWindow, jobTask).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA}, change)
+			assert.NoError(t, err)
+
+			version, err := jobRepo.GetSpecVersion(ctx, sampleTenant.ProjectName(), jobSpecA.Name(), 5)
+			assert.ErrorContains(t, err, "version 5 of job sample-job-A is not found")
+			assert.Nil(t, version)
+		})
+	})
+
+	t.Run("TemplateLibrary", func(t *testing.T) {
+		t.Run("saves next version of the library and returns the latest version visible to the namespace", func(t *testing.T) {
+			db := dbSetup()
+			jobRepo := postgres.NewJobRepository(db)
+
+			dates, err := job.NewTemplateLibrary(proj.Name(), "", "dates", `{{ .DSTART }}`)
+			assert.NoError(t, err)
+			saved, err := jobRepo.SaveTemplateLibrary(ctx, dates)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, saved.Version)
+
+			datesV2, err := job.NewTemplateLibrary(proj.Name(), "", "dates", `{{ .DEND }}`)
+			assert.NoError(t, err)
+			saved, err = jobRepo.SaveTemplateLibrary(ctx, datesV2)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, saved.Version)
+
+			namespaceDates, err := job.NewTemplateLibrary(proj.Name(), namespace.Name().String(), "dates", `{{ .EXECUTION_TIME }}`)
+			assert.NoError(t, err)
+			saved, err = jobRepo.SaveTemplateLibrary(ctx, namespaceDates)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, saved.Version)
+
+			libraries, err := jobRepo.GetTemplateLibraries(ctx, proj.Name(), "")
+			assert.NoError(t, err)
+			assert.Len(t, libraries, 1)
+			assert.Equal(t, `{{ .DEND }}`, libraries[0].Content)
+
+			libraries, err = jobRepo.GetTemplateLibraries(ctx, proj.Name(), namespace.Name().String())
+			assert.NoError(t, err)
+			assert.Len(t, libraries, 2)
+			assert.Equal(t, map[string]string{"dates": `{{ .EXECUTION_TIME }}`}, libraries.ContentsFor(namespace.Name().String()))
+
+			versions, err := jobRepo.GetTemplateLibraryVersions(ctx, proj.Name(), "", "dates")
+			assert.NoError(t, err)
+			assert.Len(t, versions, 2)
+			assert.Equal(t, 2, versions[0].Version)
+			assert.Equal(t, `{{ .DSTART }}`, versions[1].Content)
+		})
+	})
+
 	t.Run("GetAllByProjectName", func(t *testing.T) {
 		t.Run("returns no error when get all jobs success", func(t *testing.T) {
 			db := dbSetup()
@@ -958,7 +1117,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"dev.resource.sample_c"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			actual, err := jobRepo.GetAllByProjectName(ctx, sampleTenant.ProjectName())
@@ -978,7 +1137,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", []job.ResourceURN{"dev.resource.sample_c"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			err = jobRepo.Delete(ctx, sampleTenant.ProjectName(), jobSpecB.Name(), false)
@@ -1002,7 +1161,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_general", []job.ResourceURN{"dev.resource.sample_c"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			actual, err := jobRepo.GetAllByResourceDestination(ctx, "dev.resource.sample_general")
@@ -1022,7 +1181,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_general", []job.ResourceURN{"dev.resource.sample_c"})
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			err = jobRepo.Delete(ctx, sampleTenant.ProjectName(), jobSpecB.Name(), false)
@@ -1053,7 +1212,7 @@ func TestPostgresJobRepository(t *testing.T) {
 
 			jobRepo := postgres.NewJobRepository(db)
 
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 
 			err = jobRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobAWithUpstream})
@@ -1083,7 +1242,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobC := job.NewJob(sampleTenant, jobSpecC, "dev.resource.sample_c", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			expectedDownstream := []*job.Downstream{
@@ -1112,7 +1271,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB}, change)
 			assert.NoError(t, err)
 			err = jobRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobAWithUpstream})
 			assert.NoError(t, err)
@@ -1157,7 +1316,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			jobC := job.NewJob(sampleTenant, jobSpecC, "dev.resource.sample_f", nil)
 
 			jobRepo := postgres.NewJobRepository(db)
-			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC}, change)
 			assert.NoError(t, err)
 
 			jobAAsDownstream := job.NewDownstream(jobAName, sampleTenant.ProjectName(), sampleTenant.NamespaceName(), jobTask.Name())
@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/raystack/optimus/core/job"
+	"github.com/raystack/optimus/core/tenant"
+	"github.com/raystack/optimus/internal/errors"
+)
+
+const templateLibraryColumns = `project_name, namespace_name, name, version, content, created_at`
+
+// SaveTemplateLibrary stores the library as its next version, and returns it along with the version
+func (j JobRepository) SaveTemplateLibrary(ctx context.Context, library *job.TemplateLibrary) (*job.TemplateLibrary, error) {
+	insertQuery := `INSERT INTO template_library (` + templateLibraryColumns + `)
+SELECT $1, $2, $3, COALESCE(MAX(version), 0) + 1, $4, NOW()
+FROM template_library WHERE project_name = $1 AND namespace_name = $2 AND name = $3
+RETURNING ` + templateLibraryColumns
+
+	saved, err := templateLibraryFromRow(j.db.QueryRow(ctx, insertQuery, library.ProjectName, library.NamespaceName, library.Name, library.Content))
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, fmt.Sprintf("unable to save template library %s", library.Name), err)
+	}
+	return saved, nil
+}
+
+// GetTemplateLibraries returns the latest version of the libraries of the project, along with the libraries
+// of the namespace when namespace name is provided
+func (j JobRepository) GetTemplateLibraries(ctx context.Context, projectName tenant.ProjectName, namespaceName string) (job.TemplateLibraries, error) {
+	getQuery := `SELECT DISTINCT ON (namespace_name, name) ` + templateLibraryColumns + ` FROM template_library
+WHERE project_name = $1 AND (namespace_name = '' OR namespace_name = $2)
+ORDER BY namespace_name, name, version DESC`
+
+	return j.getTemplateLibraries(ctx, getQuery, projectName, namespaceName)
+}
+
+// GetTemplateLibraryVersions returns every version of the library, latest first
+func (j JobRepository) GetTemplateLibraryVersions(ctx context.Context, projectName tenant.ProjectName, namespaceName, name string) (job.TemplateLibraries, error) {
+	getQuery := `SELECT ` + templateLibraryColumns + ` FROM template_library
+WHERE project_name = $1 AND namespace_name = $2 AND name = $3
+ORDER BY version DESC`
+
+	return j.getTemplateLibraries(ctx, getQuery, projectName, namespaceName, name)
+}
+
+func (j JobRepository) getTemplateLibraries(ctx context.Context, query string, args ...any) (job.TemplateLibraries, error) {
+	rows, err := j.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting template libraries", err)
+	}
+	defer rows.Close()
+
+	var libraries job.TemplateLibraries
+	for rows.Next() {
+		library, err := templateLibraryFromRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		libraries = append(libraries, library)
+	}
+	return libraries, nil
+}
+
+func templateLibraryFromRow(row pgx.Row) (*job.TemplateLibrary, error) {
+	var library job.TemplateLibrary
+	if err := row.Scan(&library.ProjectName, &library.NamespaceName, &library.Name, &library.Version, &library.Content, &library.CreatedAt); err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error in reading row for template library", err)
+	}
+	return &library, nil
+}
@@ -0,0 +1,3 @@
+ALTER TABLE job_run
+    DROP COLUMN IF EXISTS state_changed_by,
+    DROP COLUMN IF EXISTS state_change_reason;
@@ -0,0 +1,3 @@
+ALTER TABLE job_run
+    ADD COLUMN IF NOT EXISTS state_changed_by    VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS state_change_reason TEXT;
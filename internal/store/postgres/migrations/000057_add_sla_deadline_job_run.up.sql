@@ -0,0 +1,2 @@
+ALTER TABLE job_run
+    ADD COLUMN IF NOT EXISTS sla_deadline_missed BOOLEAN NOT NULL DEFAULT FALSE;
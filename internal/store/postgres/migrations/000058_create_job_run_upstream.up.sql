@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS job_run_upstream (
+    job_run_id UUID NOT NULL,
+
+    upstream_project_name   VARCHAR NOT NULL,
+    upstream_namespace_name VARCHAR NOT NULL,
+    upstream_job_name       VARCHAR NOT NULL,
+    upstream_scheduled_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (job_run_id, upstream_project_name, upstream_job_name, upstream_scheduled_at),
+    CONSTRAINT job_run_upstream_job_run_id_fkey
+        FOREIGN KEY(job_run_id)
+        REFERENCES job_run(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS job_run_upstream_upstream_idx ON job_run_upstream(upstream_project_name, upstream_job_name, upstream_scheduled_at);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS job_run_metric (
+    job_run_id UUID PRIMARY KEY,
+
+    rows_written    BIGINT,
+    bytes_processed BIGINT,
+    slot_millis     BIGINT,
+    cost            DOUBLE PRECISION,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT job_run_metric_job_run_id_fkey
+        FOREIGN KEY(job_run_id)
+        REFERENCES job_run(id)
+        ON DELETE CASCADE
+);
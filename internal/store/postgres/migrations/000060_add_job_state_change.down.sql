@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS job_enable_at_idx;
+
+ALTER TABLE job
+    DROP COLUMN IF EXISTS state_changed_by,
+    DROP COLUMN IF EXISTS state_changed_at,
+    DROP COLUMN IF EXISTS enable_at;
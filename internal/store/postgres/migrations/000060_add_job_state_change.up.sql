@@ -0,0 +1,6 @@
+ALTER TABLE job
+    ADD COLUMN IF NOT EXISTS state_changed_by VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS state_changed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS enable_at        TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS job_enable_at_idx ON job (enable_at) WHERE enable_at IS NOT NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS job_spec_history (
+    job_id  UUID    NOT NULL,
+    version INTEGER NOT NULL,
+
+    spec JSONB NOT NULL,
+
+    changed_by    VARCHAR(100),
+    change_source VARCHAR(30) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (job_id, version),
+    CONSTRAINT job_spec_history_job_id_fkey
+        FOREIGN KEY(job_id)
+        REFERENCES job(id)
+        ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS template_library (
+    project_name   VARCHAR(100) NOT NULL,
+    namespace_name VARCHAR(100) NOT NULL DEFAULT '',
+    name           VARCHAR(100) NOT NULL,
+    version        INTEGER      NOT NULL,
+
+    content TEXT NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, namespace_name, name, version),
+    FOREIGN KEY (project_name) REFERENCES project (name)
+);
@@ -0,0 +1,8 @@
+ALTER TABLE job_upstream
+    DROP COLUMN IF EXISTS upstream_run_offset,
+    DROP COLUMN IF EXISTS upstream_window_size,
+    DROP COLUMN IF EXISTS upstream_window_offset,
+    DROP COLUMN IF EXISTS upstream_window_truncate_to;
+
+ALTER TABLE job
+    DROP COLUMN IF EXISTS static_upstream_configs;
@@ -0,0 +1,8 @@
+ALTER TABLE job
+    ADD COLUMN IF NOT EXISTS static_upstream_configs JSONB;
+
+ALTER TABLE job_upstream
+    ADD COLUMN IF NOT EXISTS upstream_run_offset         VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS upstream_window_size        VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS upstream_window_offset      VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS upstream_window_truncate_to VARCHAR(10);
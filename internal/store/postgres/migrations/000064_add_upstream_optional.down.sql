@@ -0,0 +1,3 @@
+ALTER TABLE job_upstream
+    DROP COLUMN IF EXISTS upstream_optional,
+    DROP COLUMN IF EXISTS upstream_max_wait;
@@ -0,0 +1,3 @@
+ALTER TABLE job_upstream
+    ADD COLUMN IF NOT EXISTS upstream_optional BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS upstream_max_wait VARCHAR(50);
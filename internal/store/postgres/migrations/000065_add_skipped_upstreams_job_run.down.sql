@@ -0,0 +1,2 @@
+ALTER TABLE job_run
+    DROP COLUMN IF EXISTS skipped_upstreams;
@@ -0,0 +1,2 @@
+ALTER TABLE job_run
+    ADD COLUMN IF NOT EXISTS skipped_upstreams JSONB;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS sla_deadline_alert (
+    project_name   VARCHAR NOT NULL,
+    namespace_name VARCHAR NOT NULL,
+    job_name       VARCHAR NOT NULL,
+    scheduled_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, job_name, scheduled_at)
+);
@@ -23,14 +23,25 @@ import (
 
 const (
 	jobColumns = `id, name, version, owner, description, labels, schedule, alert, static_upstreams, http_upstreams,
-				  task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at`
+				  task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at, state`
 	upstreamColumns = `
     job_name, project_name, upstream_job_name, upstream_project_name, upstream_host,
-    upstream_namespace_name, upstream_resource_urn, upstream_task_name, upstream_type, upstream_external, upstream_state`
+    upstream_namespace_name, upstream_resource_urn, upstream_task_name, upstream_type, upstream_external, upstream_state,
+    upstream_run_offset, upstream_window_size, upstream_window_offset, upstream_window_truncate_to,
+    upstream_optional, upstream_max_wait`
 )
 
+const jobStateDisabled = "disabled"
+
 type JobRepository struct {
 	db *pgxpool.Pool
+
+	projectGetter ProjectGetter
+}
+
+// ProjectGetter gets the project declaring the calendars referred by the windows of the jobs
+type ProjectGetter interface {
+	Get(ctx context.Context, name tenant.ProjectName) (*tenant.Project, error)
 }
 
 type Schedule struct {
@@ -61,6 +72,13 @@ type JobUpstreams struct {
 	UpstreamState         string
 	UpstreamExternal      sql.NullBool
 
+	UpstreamRunOffset        sql.NullString
+	UpstreamWindowSize       sql.NullString
+	UpstreamWindowOffset     sql.NullString
+	UpstreamWindowTruncateTo sql.NullString
+	UpstreamOptional         bool
+	UpstreamMaxWait          sql.NullString
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -71,6 +89,13 @@ func (j *JobUpstreams) toJobUpstreams() (*scheduler.JobUpstream, error) {
 		return nil, err
 	}
 
+	var maxWait time.Duration
+	if j.UpstreamMaxWait.Valid {
+		if maxWait, err = time.ParseDuration(j.UpstreamMaxWait.String); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "invalid max wait of upstream "+j.UpstreamJobName.String, err)
+		}
+	}
+
 	return &scheduler.JobUpstream{
 		JobName:        j.UpstreamJobName.String,
 		Host:           j.UpstreamHost.String,
@@ -80,6 +105,13 @@ func (j *JobUpstreams) toJobUpstreams() (*scheduler.JobUpstream, error) {
 		Type:           j.UpstreamType,
 		External:       j.UpstreamExternal.Bool,
 		State:          j.UpstreamState,
+
+		RunOffset:        j.UpstreamRunOffset.String,
+		WindowSize:       j.UpstreamWindowSize.String,
+		WindowOffset:     j.UpstreamWindowOffset.String,
+		WindowTruncateTo: j.UpstreamWindowTruncateTo.String,
+		Optional:         j.UpstreamOptional,
+		MaxWait:          maxWait,
 	}, nil
 }
 
@@ -117,11 +149,14 @@ type Job struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt sql.NullTime
+
+	State string
 }
 type Window struct {
 	WindowSize       string
 	WindowOffset     string
 	WindowTruncateTo string
+	WindowCalendar   string `json:",omitempty"`
 }
 
 func fromStorageWindow(raw []byte, jobVersion int) (models.Window, error) {
@@ -130,11 +165,12 @@ func fromStorageWindow(raw []byte, jobVersion int) (models.Window, error) {
 		return nil, err
 	}
 
-	return models.NewWindow(
+	return models.NewWindowWithCalendar(
 		jobVersion,
 		storageWindow.WindowTruncateTo,
 		storageWindow.WindowOffset,
 		storageWindow.WindowSize,
+		storageWindow.WindowCalendar,
 	)
 }
 
@@ -253,6 +289,8 @@ func (j *Job) toJobWithDetails() (*scheduler.JobWithDetails, error) {
 			Interval:      storageSchedule.Interval,
 		},
 		RuntimeConfig: runtimeConfig,
+		UpdatedAt:     j.UpdatedAt,
+		Disabled:      j.State == jobStateDisabled,
 	}
 	if !(storageSchedule.EndDate == nil || storageSchedule.EndDate.IsZero()) {
 		schedulerJobWithDetails.Schedule.EndDate = storageSchedule.EndDate
@@ -283,7 +321,7 @@ func FromRow(row pgx.Row) (*Job, error) {
 	err := row.Scan(&js.ID, &js.Name, &js.Version, &js.Owner, &js.Description,
 		&js.Labels, &js.Schedule, &js.Alert, &js.StaticUpstreams, &js.HTTPUpstreams,
 		&js.TaskName, &js.TaskConfig, &js.WindowSpec, &js.Assets, &js.Hooks, &js.Metadata, &js.Destination, &js.Sources,
-		&js.ProjectName, &js.NamespaceName, &js.CreatedAt, &js.UpdatedAt)
+		&js.ProjectName, &js.NamespaceName, &js.CreatedAt, &js.UpdatedAt, &js.State)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(job.EntityJob, "job not found")
@@ -301,7 +339,14 @@ func (j *JobRepository) GetJob(ctx context.Context, projectName tenant.ProjectNa
 	if err != nil {
 		return nil, err
 	}
-	return spec.toJob()
+	schedulerJob, err := spec.toJob()
+	if err != nil {
+		return nil, err
+	}
+	if err := j.bindWindowCalendar(ctx, projectName, schedulerJob); err != nil {
+		return nil, err
+	}
+	return schedulerJob, nil
 }
 
 func (j *JobRepository) GetJobDetails(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) (*scheduler.JobWithDetails, error) {
@@ -310,7 +355,64 @@ func (j *JobRepository) GetJobDetails(ctx context.Context, projectName tenant.Pr
 	if err != nil {
 		return nil, err
 	}
-	return spec.toJobWithDetails()
+	jobWithDetails, err := spec.toJobWithDetails()
+	if err != nil {
+		return nil, err
+	}
+	if err := j.bindWindowCalendar(ctx, projectName, jobWithDetails.Job); err != nil {
+		return nil, err
+	}
+	return jobWithDetails, nil
+}
+
+// bindWindowCalendar binds the calendar referred by the window of the jobs, which is declared in the project config
+func (j *JobRepository) bindWindowCalendar(ctx context.Context, projectName tenant.ProjectName, schedulerJobs ...*scheduler.Job) error {
+	me := errors.NewMultiError("errorInBindWindowCalendar")
+	var project *tenant.Project
+	for _, schedulerJob := range schedulerJobs {
+		if models.CalendarOf(schedulerJob.Window) == "" {
+			continue
+		}
+		if project == nil {
+			var err error
+			project, err = j.projectGetter.Get(ctx, projectName)
+			if err != nil {
+				return errors.AddErrContext(err, scheduler.EntityJobRun, "error getting project "+projectName.String())
+			}
+		}
+
+		window, err := models.WindowWithCalendar(schedulerJob.Window, project.GetConfigs())
+		if err != nil {
+			me.Append(errors.InvalidArgument(scheduler.EntityJobRun, "job "+schedulerJob.Name.String()+": "+err.Error()))
+			continue
+		}
+		schedulerJob.Window = window
+	}
+	return me.ToErr()
+}
+
+// GetTemplateLibraries returns the content of the latest libraries available to the tenant mapped by library name,
+// the library of the namespace takes precedence over the one of the project with the same name
+func (j *JobRepository) GetTemplateLibraries(ctx context.Context, tnnt tenant.Tenant) (map[string]string, error) {
+	getLibraries := `SELECT DISTINCT ON (name) name, content FROM template_library
+WHERE project_name = $1 AND (namespace_name = '' OR namespace_name = $2)
+ORDER BY name, namespace_name DESC, version DESC`
+
+	rows, err := j.db.Query(ctx, getLibraries, tnnt.ProjectName(), tnnt.NamespaceName())
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error getting template libraries of "+tnnt.ProjectName().String(), err)
+	}
+	defer rows.Close()
+
+	libraries := make(map[string]string)
+	for rows.Next() {
+		var name, content string
+		if err := rows.Scan(&name, &content); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error reading template library", err)
+		}
+		libraries[name] = content
+	}
+	return libraries, nil
 }
 
 func groupUpstreamsByJobName(jobUpstreams []*JobUpstreams) (map[string][]*scheduler.JobUpstream, error) {
@@ -347,7 +449,9 @@ func (j *JobRepository) getJobsUpstreams(ctx context.Context, projectName tenant
 	for rows.Next() {
 		var jwu JobUpstreams
 		err := rows.Scan(&jwu.JobName, &jwu.ProjectName, &jwu.UpstreamJobName, &jwu.UpstreamProjectName, &jwu.UpstreamHost,
-			&jwu.UpstreamNamespaceName, &jwu.UpstreamResourceUrn, &jwu.UpstreamTaskName, &jwu.UpstreamType, &jwu.UpstreamExternal, &jwu.UpstreamState)
+			&jwu.UpstreamNamespaceName, &jwu.UpstreamResourceUrn, &jwu.UpstreamTaskName, &jwu.UpstreamType, &jwu.UpstreamExternal, &jwu.UpstreamState,
+			&jwu.UpstreamRunOffset, &jwu.UpstreamWindowSize, &jwu.UpstreamWindowOffset, &jwu.UpstreamWindowTruncateTo,
+			&jwu.UpstreamOptional, &jwu.UpstreamMaxWait)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return nil, errors.NotFound(scheduler.EntityJobRun, "job upstream not found")
@@ -391,6 +495,8 @@ func (j *JobRepository) GetAll(ctx context.Context, projectName tenant.ProjectNa
 		return nil, errors.NotFound(scheduler.EntityJobRun, "unable to find jobs in project:"+projectName.String())
 	}
 
+	multiError.Append(j.bindWindowCalendar(ctx, projectName, jobsOf(jobsMap)...))
+
 	jobUpstreamGroupedByName, err := j.getJobsUpstreams(ctx, projectName, jobNameList)
 	multiError.Append(err)
 
@@ -433,6 +539,8 @@ func (j *JobRepository) GetJobs(ctx context.Context, projectName tenant.ProjectN
 		}
 	}
 
+	multiError.Append(j.bindWindowCalendar(ctx, projectName, jobsOf(jobsMap)...))
+
 	jobUpstreamGroupedByName, err := j.getJobsUpstreams(ctx, projectName, jobNameList)
 	multiError.Append(err)
 
@@ -443,8 +551,51 @@ func (j *JobRepository) GetJobs(ctx context.Context, projectName tenant.ProjectN
 	return utils.MapToList[*scheduler.JobWithDetails](jobsMap), errors.MultiToError(multiError)
 }
 
-func NewJobProviderRepository(pool *pgxpool.Pool) *JobRepository {
+// GetDownstreams returns the jobs of any project which have one of the given jobs as their upstream
+func (j *JobRepository) GetDownstreams(ctx context.Context, projectName tenant.ProjectName, jobNames []string) ([]*scheduler.JobWithDetails, error) {
+	getDownstreamNames := `SELECT DISTINCT u.project_name, u.job_name FROM job_upstream u
+JOIN job ON job.project_name = u.project_name AND job.name = u.job_name
+WHERE u.upstream_project_name = $1 AND u.upstream_job_name = any ($2) AND u.upstream_external = false AND job.deleted_at IS NULL`
+	rows, err := j.db.Query(ctx, getDownstreamNames, projectName, jobNames)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting downstream jobs", err)
+	}
+	defer rows.Close()
+
+	downstreamNamesByProject := map[string][]string{}
+	var projectNames []string
+	for rows.Next() {
+		var downstreamProjectName, downstreamJobName string
+		if err := rows.Scan(&downstreamProjectName, &downstreamJobName); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error in reading row for downstream job", err)
+		}
+		if _, ok := downstreamNamesByProject[downstreamProjectName]; !ok {
+			projectNames = append(projectNames, downstreamProjectName)
+		}
+		downstreamNamesByProject[downstreamProjectName] = append(downstreamNamesByProject[downstreamProjectName], downstreamJobName)
+	}
+
+	var downstreams []*scheduler.JobWithDetails
+	multiError := errors.NewMultiError("errorInGetDownstreams")
+	for _, downstreamProjectName := range projectNames {
+		jobs, err := j.GetJobs(ctx, tenant.ProjectName(downstreamProjectName), downstreamNamesByProject[downstreamProjectName])
+		multiError.Append(err)
+		downstreams = append(downstreams, jobs...)
+	}
+	return downstreams, errors.MultiToError(multiError)
+}
+
+func jobsOf(jobsMap map[string]*scheduler.JobWithDetails) []*scheduler.Job {
+	jobs := make([]*scheduler.Job, 0, len(jobsMap))
+	for _, jobWithDetails := range jobsMap {
+		jobs = append(jobs, jobWithDetails.Job)
+	}
+	return jobs
+}
+
+func NewJobProviderRepository(pool *pgxpool.Pool, projectGetter ProjectGetter) *JobRepository {
 	return &JobRepository{
-		db: pool,
+		db:            pool,
+		projectGetter: projectGetter,
 	}
 }
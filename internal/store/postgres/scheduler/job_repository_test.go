@@ -13,6 +13,7 @@ import (
 	"github.com/raystack/optimus/core/job"
 	"github.com/raystack/optimus/core/scheduler"
 	"github.com/raystack/optimus/core/tenant"
+	tService "github.com/raystack/optimus/core/tenant/service"
 	"github.com/raystack/optimus/internal/errors"
 	"github.com/raystack/optimus/internal/models"
 	jobRepo "github.com/raystack/optimus/internal/store/postgres/job"
@@ -35,7 +36,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			db := dbSetup()
 			jobs := addJobs(ctx, t, db)
 
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 
 			allJobs, err := jobProviderRepo.GetAll(ctx, tnnt.ProjectName())
 			assert.Nil(t, err)
@@ -65,7 +66,7 @@ func TestPostgresJobRepository(t *testing.T) {
 
 		t.Run("return not found error when jobs not found", func(t *testing.T) {
 			db := dbSetup()
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 			allJobs, err := jobProviderRepo.GetAll(ctx, "some-other-project-1")
 			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
 			assert.Nil(t, allJobs)
@@ -77,7 +78,7 @@ func TestPostgresJobRepository(t *testing.T) {
 			db := dbSetup()
 			jobs := addJobs(ctx, t, db)
 
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 
 			jobWithDetails, err := jobProviderRepo.GetJobDetails(ctx, tnnt.ProjectName(), jobAName)
 			assert.Nil(t, err)
@@ -85,7 +86,7 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 		t.Run("returns not found error when job not found", func(t *testing.T) {
 			db := dbSetup()
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 			jobObject, err := jobProviderRepo.GetJobDetails(ctx, tnnt.ProjectName(), "some-other-job")
 			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
 			assert.Nil(t, jobObject)
@@ -95,7 +96,7 @@ func TestPostgresJobRepository(t *testing.T) {
 		t.Run("returns one job", func(t *testing.T) {
 			db := dbSetup()
 			jobs := addJobs(ctx, t, db)
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 
 			jobObject, err := jobProviderRepo.GetJob(ctx, tnnt.ProjectName(), jobAName)
 			assert.Nil(t, err)
@@ -103,17 +104,38 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 		t.Run("returns not found error when job not found", func(t *testing.T) {
 			db := dbSetup()
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 			jobObject, err := jobProviderRepo.GetJob(ctx, tnnt.ProjectName(), "some-other-job")
 			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
 			assert.Nil(t, jobObject)
 		})
 	})
+	t.Run("GetDownstreams", func(t *testing.T) {
+		t.Run("returns the jobs having the given jobs as upstream", func(t *testing.T) {
+			db := dbSetup()
+			jobs := addJobs(ctx, t, db)
+			jobProviderRepo := newJobProviderRepository(db)
+
+			downstreams, err := jobProviderRepo.GetDownstreams(ctx, tnnt.ProjectName(), []string{jobBName})
+			assert.Nil(t, err)
+			assert.Len(t, downstreams, 1)
+			assert.True(t, compareEqualJobWithDetails(jobs[jobAName], downstreams[0]))
+		})
+		t.Run("returns empty when no job depends on the given jobs", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobProviderRepo := newJobProviderRepository(db)
+
+			downstreams, err := jobProviderRepo.GetDownstreams(ctx, tnnt.ProjectName(), []string{jobAName})
+			assert.Nil(t, err)
+			assert.Empty(t, downstreams)
+		})
+	})
 	t.Run("GetJobs", func(t *testing.T) {
 		t.Run("returns multiple job", func(t *testing.T) {
 			db := dbSetup()
 			jobs := addJobs(ctx, t, db)
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 
 			jobObjects, err := jobProviderRepo.GetJobs(ctx, tnnt.ProjectName(), []string{jobAName, jobBName})
 			assert.Nil(t, err)
@@ -124,7 +146,7 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 		t.Run("returns not found error when jobs are not found", func(t *testing.T) {
 			db := dbSetup()
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 			jobObject, err := jobProviderRepo.GetJobs(ctx, tnnt.ProjectName(), []string{"some-other-job"})
 			assert.ErrorContains(t, err, "unable to find job")
 			assert.Nil(t, jobObject)
@@ -132,7 +154,7 @@ func TestPostgresJobRepository(t *testing.T) {
 		t.Run("returns the found job when some other job is not found", func(t *testing.T) {
 			db := dbSetup()
 			jobs := addJobs(ctx, t, db)
-			jobProviderRepo := postgres.NewJobProviderRepository(db)
+			jobProviderRepo := newJobProviderRepository(db)
 			jobObjects, err := jobProviderRepo.GetJobs(ctx, tnnt.ProjectName(), []string{jobAName, "some-other-job"})
 			assert.ErrorContains(t, err, "unable to find job")
 			assert.Equal(t, 1, len(jobObjects))
@@ -141,6 +163,34 @@ func TestPostgresJobRepository(t *testing.T) {
 			}
 		})
 	})
+	t.Run("GetTemplateLibraries", func(t *testing.T) {
+		t.Run("returns the latest libraries of the tenant preferring the ones of the namespace", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			libraryRepo := jobRepo.NewJobRepository(db)
+
+			for _, library := range []struct{ namespace, name, content string }{
+				{"", "dates", "project dates v1"},
+				{"", "dates", "project dates v2"},
+				{"", "tables", "project tables"},
+				{tnnt.NamespaceName().String(), "tables", "namespace tables"},
+			} {
+				templateLibrary, err := job.NewTemplateLibrary(tnnt.ProjectName(), library.namespace, library.name, library.content)
+				assert.NoError(t, err)
+				_, err = libraryRepo.SaveTemplateLibrary(ctx, templateLibrary)
+				assert.NoError(t, err)
+			}
+
+			jobProviderRepo := newJobProviderRepository(db)
+			libraries, err := jobProviderRepo.GetTemplateLibraries(ctx, tnnt)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"dates": "project dates v2", "tables": "namespace tables"}, libraries)
+		})
+	})
+}
+
+func newJobProviderRepository(pool *pgxpool.Pool) *postgres.JobRepository {
+	return postgres.NewJobProviderRepository(pool, tService.NewProjectService(tenantPostgres.NewProjectRepository(pool)))
 }
 
 func dbSetup() *pgxpool.Pool {
@@ -240,7 +290,7 @@ func addJobs(ctx context.Context, t *testing.T, pool *pgxpool.Pool) map[string]*
 	jobs := []*job.Job{jobA, jobB}
 
 	jobRepository := jobRepo.NewJobRepository(pool)
-	addedJobs, err := jobRepository.Add(ctx, jobs)
+	addedJobs, err := jobRepository.Add(ctx, jobs, job.NewSpecChange("", job.ChangeSourceAPI))
 	assert.NoError(t, err)
 	assert.Nil(t, err)
 	assert.EqualValues(t, jobs, addedJobs)
@@ -17,7 +17,11 @@ import (
 
 const (
 	columnsToStore = `job_name, namespace_name, project_name, scheduled_at, start_time, end_time, status, sla_definition, sla_alert`
-	jobRunColumns  = `id, ` + columnsToStore + `, monitoring`
+	jobRunColumns  = `id, ` + columnsToStore + `, monitoring, sla_deadline_missed, skipped_upstreams,
+COALESCE(state_changed_by, ''), COALESCE(state_change_reason, '')`
+
+	jobRunStatsFilter = `j.project_name = $1 AND ($2::text = '' OR j.namespace_name = $2) AND ($3::text = '' OR j.job_name = $3)
+AND j.scheduled_at >= $4 AND j.scheduled_at <= $5`
 )
 
 type JobRunRepository struct {
@@ -35,14 +39,26 @@ type jobRun struct {
 	StartTime   time.Time
 	EndTime     time.Time
 
-	Status        string
-	SLAAlert      bool
-	SLADefinition int64
+	Status            string
+	SLAAlert          bool
+	SLADefinition     int64
+	SLADeadlineMissed bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	Monitoring json.RawMessage
+	Monitoring       json.RawMessage
+	SkippedUpstreams json.RawMessage
+
+	StateChangedBy    string
+	StateChangeReason string
+}
+
+type skippedUpstream struct {
+	ProjectName   string
+	NamespaceName string
+	JobName       string
+	Reason        string
 }
 
 func (j *jobRun) toJobRun() (*scheduler.JobRun, error) {
@@ -60,6 +76,10 @@ func (j *jobRun) toJobRun() (*scheduler.JobRun, error) {
 			return nil, errors.AddErrContext(err, scheduler.EntityJobRun, "invalid monitoring values in database")
 		}
 	}
+	skippedUpstreams, err := j.toSkippedUpstreams()
+	if err != nil {
+		return nil, err
+	}
 	return &scheduler.JobRun{
 		ID:          j.ID,
 		JobName:     scheduler.JobName(j.JobName),
@@ -70,15 +90,44 @@ func (j *jobRun) toJobRun() (*scheduler.JobRun, error) {
 		SLAAlert:    j.SLAAlert,
 		EndTime:     j.EndTime,
 		Monitoring:  monitoring,
+
+		SLADeadlineMissed: j.SLADeadlineMissed,
+		SkippedUpstreams:  skippedUpstreams,
+		StateChangedBy:    j.StateChangedBy,
+		StateChangeReason: j.StateChangeReason,
 	}, nil
 }
 
+func (j *jobRun) toSkippedUpstreams() ([]*scheduler.SkippedUpstream, error) {
+	if j.SkippedUpstreams == nil {
+		return nil, nil
+	}
+	var storedUpstreams []skippedUpstream
+	if err := json.Unmarshal(j.SkippedUpstreams, &storedUpstreams); err != nil {
+		return nil, errors.AddErrContext(err, scheduler.EntityJobRun, "invalid skipped upstreams in database")
+	}
+	skippedUpstreams := make([]*scheduler.SkippedUpstream, len(storedUpstreams))
+	for i, stored := range storedUpstreams {
+		t, err := tenant.NewTenant(stored.ProjectName, stored.NamespaceName)
+		if err != nil {
+			return nil, err
+		}
+		skippedUpstreams[i] = &scheduler.SkippedUpstream{
+			JobName: scheduler.JobName(stored.JobName),
+			Tenant:  t,
+			Reason:  stored.Reason,
+		}
+	}
+	return skippedUpstreams, nil
+}
+
 func (j *JobRunRepository) GetByID(ctx context.Context, id scheduler.JobRunID) (*scheduler.JobRun, error) {
 	var jr jobRun
 	getJobRunByID := `SELECT ` + jobRunColumns + ` FROM job_run where id = $1`
 	err := j.db.QueryRow(ctx, getJobRunByID, id.UUID()).
 		Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
-			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring)
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.SLADeadlineMissed, &jr.SkippedUpstreams,
+			&jr.StateChangedBy, &jr.StateChangeReason)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(scheduler.EntityJobRun, "no record for job run id "+id.UUID().String())
@@ -93,7 +142,8 @@ func (j *JobRunRepository) GetByScheduledAt(ctx context.Context, t tenant.Tenant
 	getJobRunByID := `SELECT ` + jobRunColumns + `, created_at FROM job_run j where project_name = $1 and namespace_name = $2 and job_name = $3 and scheduled_at = $4 order by created_at desc limit 1`
 	err := j.db.QueryRow(ctx, getJobRunByID, t.ProjectName(), t.NamespaceName(), jobName, scheduledAt).
 		Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
-			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.CreatedAt)
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.SLADeadlineMissed, &jr.SkippedUpstreams,
+			&jr.StateChangedBy, &jr.StateChangeReason, &jr.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(scheduler.EntityJobRun, "no record for job:"+jobName.String()+" scheduled at: "+scheduledAt.String())
@@ -115,6 +165,20 @@ func (j *JobRunRepository) Update(ctx context.Context, jobRunID uuid.UUID, endTi
 	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to update job run", err)
 }
 
+// UpdateStateManually overrides the status of a job run, ending the run if it is still running
+func (j *JobRunRepository) UpdateStateManually(ctx context.Context, jobRunID uuid.UUID, change *scheduler.JobRunStateChange) error {
+	updateJobRun := `update job_run set status = $1, end_time = LEAST(end_time, NOW()), state_changed_by = $2, state_change_reason = $3, updated_at = NOW() where id = $4`
+	_, err := j.db.Exec(ctx, updateJobRun, change.State, change.ChangedBy, change.Reason, jobRunID)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to update job run state", err)
+}
+
+// RestoreState sets back the status, end time and state change of a job run to the ones of the given run
+func (j *JobRunRepository) RestoreState(ctx context.Context, previous *scheduler.JobRun) error {
+	restoreJobRun := `update job_run set status = $1, end_time = $2, state_changed_by = NULLIF($3, ''), state_change_reason = NULLIF($4, ''), updated_at = NOW() where id = $5`
+	_, err := j.db.Exec(ctx, restoreJobRun, previous.State, previous.EndTime, previous.StateChangedBy, previous.StateChangeReason, previous.ID)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to restore job run state", err)
+}
+
 func (j *JobRunRepository) UpdateSLA(ctx context.Context, slaObjects []*scheduler.SLAObject) error {
 	var jobIDListString string
 	totalIds := len(slaObjects)
@@ -129,6 +193,102 @@ func (j *JobRunRepository) UpdateSLA(ctx context.Context, slaObjects []*schedule
 	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to update SLA", err)
 }
 
+// GetRunsScheduledSince returns the latest run per job and scheduled time of the project scheduled since the given time
+func (j *JobRunRepository) GetRunsScheduledSince(ctx context.Context, projectName tenant.ProjectName, since time.Time) ([]*scheduler.JobRun, error) {
+	query := `SELECT DISTINCT ON (job_name, scheduled_at) ` + jobRunColumns + ` FROM job_run
+WHERE project_name = $1 AND scheduled_at >= $2
+ORDER BY job_name, scheduled_at, created_at DESC`
+	rows, err := j.db.Query(ctx, query, projectName, since)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting job runs scheduled since "+since.String(), err)
+	}
+	defer rows.Close()
+
+	var jobRuns []*scheduler.JobRun
+	for rows.Next() {
+		var jr jobRun
+		if err := rows.Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.SLADeadlineMissed, &jr.SkippedUpstreams,
+			&jr.StateChangedBy, &jr.StateChangeReason); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning job run", err)
+		}
+		run, err := jr.toJobRun()
+		if err != nil {
+			return nil, err
+		}
+		jobRuns = append(jobRuns, run)
+	}
+	return jobRuns, nil
+}
+
+// GetRunsScheduledBetween returns the latest run per scheduled time of the job scheduled within the given time range
+func (j *JobRunRepository) GetRunsScheduledBetween(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.JobRun, error) {
+	query := `SELECT DISTINCT ON (scheduled_at) ` + jobRunColumns + ` FROM job_run
+WHERE project_name = $1 AND namespace_name = $2 AND job_name = $3 AND scheduled_at >= $4 AND scheduled_at <= $5
+ORDER BY scheduled_at, created_at DESC`
+	rows, err := j.db.Query(ctx, query, t.ProjectName(), t.NamespaceName(), jobName, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting job runs of "+jobName.String(), err)
+	}
+	defer rows.Close()
+
+	var jobRuns []*scheduler.JobRun
+	for rows.Next() {
+		var jr jobRun
+		if err := rows.Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.SLADeadlineMissed, &jr.SkippedUpstreams,
+			&jr.StateChangedBy, &jr.StateChangeReason); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning job run", err)
+		}
+		run, err := jr.toJobRun()
+		if err != nil {
+			return nil, err
+		}
+		jobRuns = append(jobRuns, run)
+	}
+	return jobRuns, nil
+}
+
+func (j *JobRunRepository) UpdateSLADeadlineMissed(ctx context.Context, jobRunID uuid.UUID) error {
+	query := `update job_run set sla_deadline_missed = TRUE, updated_at = NOW() where id = $1`
+	_, err := j.db.Exec(ctx, query, jobRunID)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to update sla deadline missed", err)
+}
+
+// GetSLADeadlineAlerts returns the scheduled times per job of the project, since the given time, which are alerted
+// for missing their sla deadline without a job run
+func (j *JobRunRepository) GetSLADeadlineAlerts(ctx context.Context, projectName tenant.ProjectName, since time.Time) (map[scheduler.JobName][]time.Time, error) {
+	query := `SELECT job_name, scheduled_at FROM sla_deadline_alert WHERE project_name = $1 AND scheduled_at >= $2`
+	rows, err := j.db.Query(ctx, query, projectName, since)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting sla deadline alerts", err)
+	}
+	defer rows.Close()
+
+	alerts := make(map[scheduler.JobName][]time.Time)
+	for rows.Next() {
+		var jobName string
+		var scheduledAt time.Time
+		if err := rows.Scan(&jobName, &scheduledAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning sla deadline alert", err)
+		}
+		alerts[scheduler.JobName(jobName)] = append(alerts[scheduler.JobName(jobName)], scheduledAt)
+	}
+	return alerts, nil
+}
+
+// AddSLADeadlineAlert records the alert of a scheduled time missing its sla deadline without a job run, it returns
+// false when the scheduled time is already alerted
+func (j *JobRunRepository) AddSLADeadlineAlert(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (bool, error) {
+	query := `INSERT INTO sla_deadline_alert (project_name, namespace_name, job_name, scheduled_at, created_at)
+VALUES ($1, $2, $3, $4, NOW()) ON CONFLICT DO NOTHING`
+	tag, err := j.db.Exec(ctx, query, t.ProjectName(), t.NamespaceName(), jobName, scheduledAt)
+	if err != nil {
+		return false, errors.Wrap(scheduler.EntityJobRun, "unable to add sla deadline alert", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
 func (j *JobRunRepository) UpdateMonitoring(ctx context.Context, jobRunID uuid.UUID, monitoringValues map[string]any) error {
 	monitoringBytes, err := json.Marshal(monitoringValues)
 	if err != nil {
@@ -139,12 +299,336 @@ func (j *JobRunRepository) UpdateMonitoring(ctx context.Context, jobRunID uuid.U
 	return errors.WrapIfErr(scheduler.EntityJobRun, "cannot update monitoring", err)
 }
 
+// UpsertMetrics stores the metrics of the job run, replacing the metrics stored before
+func (j *JobRunRepository) UpsertMetrics(ctx context.Context, jobRunID uuid.UUID, metrics *scheduler.JobRunMetrics) error {
+	query := `INSERT INTO job_run_metric (job_run_id, rows_written, bytes_processed, slot_millis, cost, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+ON CONFLICT (job_run_id) DO UPDATE SET rows_written = EXCLUDED.rows_written, bytes_processed = EXCLUDED.bytes_processed,
+slot_millis = EXCLUDED.slot_millis, cost = EXCLUDED.cost, updated_at = NOW()`
+	_, err := j.db.Exec(ctx, query, jobRunID, countMetric(metrics, scheduler.MetricRowsWritten),
+		countMetric(metrics, scheduler.MetricBytesProcessed), countMetric(metrics, scheduler.MetricSlotMillis),
+		valueMetric(metrics, scheduler.MetricCost))
+	return errors.WrapIfErr(scheduler.EntityJobRun, "cannot upsert job run metrics", err)
+}
+
+// GetMetrics returns the metrics of the latest run per scheduled time of the job within the range
+func (j *JobRunRepository) GetMetrics(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, startDate, endDate time.Time) ([]*scheduler.JobRunMetrics, error) {
+	query := `SELECT DISTINCT ON (r.scheduled_at) r.scheduled_at, m.rows_written, m.bytes_processed, m.slot_millis, m.cost
+FROM job_run_metric m JOIN job_run r ON r.id = m.job_run_id
+WHERE r.project_name = $1 AND r.job_name = $2 AND r.scheduled_at >= $3 AND r.scheduled_at <= $4
+ORDER BY r.scheduled_at, r.created_at DESC`
+	rows, err := j.db.Query(ctx, query, projectName, jobName, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting job run metrics", err)
+	}
+	defer rows.Close()
+
+	var runMetrics []*scheduler.JobRunMetrics
+	for rows.Next() {
+		var scheduledAt time.Time
+		var rowsWritten, bytesProcessed, slotMillis *int64
+		var cost *float64
+		if err := rows.Scan(&scheduledAt, &rowsWritten, &bytesProcessed, &slotMillis, &cost); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning job run metrics", err)
+		}
+
+		values := map[scheduler.MetricName]float64{}
+		for name, count := range map[scheduler.MetricName]*int64{
+			scheduler.MetricRowsWritten:    rowsWritten,
+			scheduler.MetricBytesProcessed: bytesProcessed,
+			scheduler.MetricSlotMillis:     slotMillis,
+		} {
+			if count != nil {
+				values[name] = float64(*count)
+			}
+		}
+		if cost != nil {
+			values[scheduler.MetricCost] = *cost
+		}
+		runMetrics = append(runMetrics, &scheduler.JobRunMetrics{ScheduledAt: scheduledAt, Values: values})
+	}
+	return runMetrics, nil
+}
+
+func countMetric(metrics *scheduler.JobRunMetrics, name scheduler.MetricName) *int64 {
+	value, ok := metrics.Values[name]
+	if !ok {
+		return nil
+	}
+	count := int64(value)
+	return &count
+}
+
+func valueMetric(metrics *scheduler.JobRunMetrics, name scheduler.MetricName) *float64 {
+	value, ok := metrics.Values[name]
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
 func (j *JobRunRepository) Create(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time, slaDefinitionInSec int64) error {
 	insertJobRun := `INSERT INTO job_run (` + columnsToStore + `, created_at, updated_at) values ($1, $2, $3, $4, NOW(), TIMESTAMP '3000-01-01 00:00:00', $5, $6, FALSE, NOW(), NOW()) ON CONFLICT DO NOTHING`
 	_, err := j.db.Exec(ctx, insertJobRun, jobName, t.NamespaceName(), t.ProjectName(), scheduledAt, scheduler.StateRunning, slaDefinitionInSec)
 	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to create job run", err)
 }
 
+// GetJobDurations returns the median duration of successful runs of the given jobs, scheduled since the given time
+func (j *JobRunRepository) GetJobDurations(ctx context.Context, projectName tenant.ProjectName, jobNames []string, since time.Time) (map[scheduler.JobName]time.Duration, error) {
+	query := `SELECT job_name, percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (end_time - start_time)))
+FROM job_run
+WHERE project_name = $1 AND job_name = any ($2) AND scheduled_at >= $3 AND status = $4 AND end_time > start_time
+GROUP BY job_name`
+	rows, err := j.db.Query(ctx, query, projectName, jobNames, since, scheduler.StateSuccess)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting job durations", err)
+	}
+	defer rows.Close()
+
+	durations := make(map[scheduler.JobName]time.Duration)
+	for rows.Next() {
+		var jobName string
+		var durationInSec float64
+		if err := rows.Scan(&jobName, &durationInSec); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning job duration", err)
+		}
+		durations[scheduler.JobName(jobName)] = time.Duration(durationInSec * float64(time.Second))
+	}
+	return durations, nil
+}
+
+// GetRecentDurations returns the durations of the latest successful runs of a job scheduled before the given time
+func (j *JobRunRepository) GetRecentDurations(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, before time.Time, limit int) ([]time.Duration, error) {
+	query := `SELECT EXTRACT(EPOCH FROM (end_time - start_time))
+FROM job_run
+WHERE project_name = $1 AND namespace_name = $2 AND job_name = $3 AND scheduled_at < $4 AND status = $5 AND end_time > start_time
+ORDER BY scheduled_at DESC
+LIMIT $6`
+	rows, err := j.db.Query(ctx, query, t.ProjectName(), t.NamespaceName(), jobName, before, scheduler.StateSuccess, limit)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting recent job run durations", err)
+	}
+	defer rows.Close()
+
+	var durations []time.Duration
+	for rows.Next() {
+		var durationInSec float64
+		if err := rows.Scan(&durationInSec); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning job run duration", err)
+		}
+		durations = append(durations, time.Duration(durationInSec*float64(time.Second)))
+	}
+	return durations, nil
+}
+
+// AddUpstreamRuns links the job run with the upstream runs it consumed the data of
+func (j *JobRunRepository) AddUpstreamRuns(ctx context.Context, jobRunID uuid.UUID, upstreamRuns []*scheduler.JobRunRef) error {
+	batch := pgx.Batch{}
+	for _, upstreamRun := range upstreamRuns {
+		insertUpstreamRun := `INSERT INTO job_run_upstream (job_run_id, upstream_project_name, upstream_namespace_name, upstream_job_name, upstream_scheduled_at, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW()) ON CONFLICT DO NOTHING`
+		batch.Queue(insertUpstreamRun, jobRunID, upstreamRun.Tenant.ProjectName(), upstreamRun.Tenant.NamespaceName(),
+			upstreamRun.JobName, upstreamRun.ScheduledAt)
+	}
+
+	results := j.db.SendBatch(ctx, &batch)
+	defer results.Close()
+
+	multiErr := errors.NewMultiError("error adding upstream runs")
+	for range upstreamRuns {
+		_, err := results.Exec()
+		multiErr.Append(errors.WrapIfErr(scheduler.EntityJobRun, "unable to add upstream run", err))
+	}
+	return multiErr.ToErr()
+}
+
+// AddSkippedUpstream records the optional upstream the job run proceeded without
+func (j *JobRunRepository) AddSkippedUpstream(ctx context.Context, jobRunID uuid.UUID, skipped *scheduler.SkippedUpstream) error {
+	skippedBytes, err := json.Marshal([]skippedUpstream{{
+		ProjectName:   skipped.Tenant.ProjectName().String(),
+		NamespaceName: skipped.Tenant.NamespaceName().String(),
+		JobName:       skipped.JobName.String(),
+		Reason:        skipped.Reason,
+	}})
+	if err != nil {
+		return errors.Wrap(scheduler.EntityJobRun, "error marshalling skipped upstream", err)
+	}
+	query := `update job_run set skipped_upstreams = COALESCE(skipped_upstreams, '[]'::jsonb) || $1::jsonb, updated_at = NOW() where id = $2`
+	_, err = j.db.Exec(ctx, query, skippedBytes, jobRunID)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to add skipped upstream", err)
+}
+
+// GetUpstreamRuns returns the upstream runs linked to the job run
+func (j *JobRunRepository) GetUpstreamRuns(ctx context.Context, jobRunID uuid.UUID) ([]*scheduler.JobRunRef, error) {
+	query := `SELECT upstream_project_name, upstream_namespace_name, upstream_job_name, upstream_scheduled_at
+FROM job_run_upstream WHERE job_run_id = $1 ORDER BY upstream_job_name, upstream_scheduled_at`
+	rows, err := j.db.Query(ctx, query, jobRunID)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting upstream runs", err)
+	}
+	defer rows.Close()
+
+	var upstreamRuns []*scheduler.JobRunRef
+	for rows.Next() {
+		var projectName, namespaceName, jobName string
+		var scheduledAt time.Time
+		if err := rows.Scan(&projectName, &namespaceName, &jobName, &scheduledAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning upstream run", err)
+		}
+		t, err := tenant.NewTenant(projectName, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+		upstreamRuns = append(upstreamRuns, &scheduler.JobRunRef{
+			JobName:     scheduler.JobName(jobName),
+			Tenant:      t,
+			ScheduledAt: scheduledAt,
+		})
+	}
+	return upstreamRuns, nil
+}
+
+// GetDownstreamRuns returns the job runs linked to the upstream run
+func (j *JobRunRepository) GetDownstreamRuns(ctx context.Context, upstreamRun *scheduler.JobRunRef) ([]*scheduler.JobRun, error) {
+	query := `SELECT ` + jobRunColumns + ` FROM job_run
+WHERE id IN (
+	SELECT job_run_id FROM job_run_upstream
+	WHERE upstream_project_name = $1 AND upstream_job_name = $2 AND upstream_scheduled_at = $3
+) ORDER BY job_name, scheduled_at`
+	rows, err := j.db.Query(ctx, query, upstreamRun.Tenant.ProjectName(), upstreamRun.JobName, upstreamRun.ScheduledAt)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting downstream runs", err)
+	}
+	defer rows.Close()
+
+	var jobRuns []*scheduler.JobRun
+	for rows.Next() {
+		var jr jobRun
+		if err := rows.Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.SLADeadlineMissed, &jr.SkippedUpstreams,
+			&jr.StateChangedBy, &jr.StateChangeReason); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning downstream run", err)
+		}
+		run, err := jr.toJobRun()
+		if err != nil {
+			return nil, err
+		}
+		jobRuns = append(jobRuns, run)
+	}
+	return jobRuns, nil
+}
+
+// GetSuccessfulRuns returns the scheduled times of the successful runs per job of the project within the given time range
+func (j *JobRunRepository) GetSuccessfulRuns(ctx context.Context, projectName tenant.ProjectName, startTime, endTime time.Time) (map[scheduler.JobName][]time.Time, error) {
+	query := `SELECT DISTINCT job_name, scheduled_at
+FROM job_run
+WHERE project_name = $1 AND scheduled_at >= $2 AND scheduled_at <= $3 AND status = $4`
+	rows, err := j.db.Query(ctx, query, projectName, startTime, endTime, scheduler.StateSuccess)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting successful job runs", err)
+	}
+	defer rows.Close()
+
+	successfulRuns := make(map[scheduler.JobName][]time.Time)
+	for rows.Next() {
+		var jobName string
+		var scheduledAt time.Time
+		if err := rows.Scan(&jobName, &scheduledAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning successful job run", err)
+		}
+		successfulRuns[scheduler.JobName(jobName)] = append(successfulRuns[scheduler.JobName(jobName)], scheduledAt)
+	}
+	return successfulRuns, nil
+}
+
+// GetJobRunStats calculates the stats of the job runs scheduled within the criteria date range
+func (j *JobRunRepository) GetJobRunStats(ctx context.Context, criteria *scheduler.JobRunStatsCriteria) (*scheduler.JobRunStats, error) {
+	filterArgs := []any{criteria.ProjectName, criteria.NamespaceName, criteria.JobName, criteria.StartDate, criteria.EndDate}
+
+	summaryQuery := `SELECT COUNT(*), COUNT(*) FILTER (WHERE status = $6), COUNT(*) FILTER (WHERE status = $7), COUNT(*) FILTER (WHERE sla_alert)
+FROM job_run j
+WHERE ` + jobRunStatsFilter
+	stats := &scheduler.JobRunStats{}
+	err := j.db.QueryRow(ctx, summaryQuery, append(filterArgs, scheduler.StateSuccess, scheduler.StateFailed)...).
+		Scan(&stats.TotalRuns, &stats.SuccessRuns, &stats.FailedRuns, &stats.SLAMisses)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting job run stats", err)
+	}
+
+	stats.Operators, err = j.getOperatorRunStats(ctx, filterArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.Trend, err = j.getJobRunStatsTrend(ctx, filterArgs)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// getOperatorRunStats calculates the durations of finished operator runs, every attempt after the first one of an operator is a retry
+func (j *JobRunRepository) getOperatorRunStats(ctx context.Context, filterArgs []any) ([]*scheduler.OperatorRunStats, error) {
+	query := `SELECT o.operator_type,
+	COUNT(DISTINCT (o.job_run_id, o.name)),
+	COUNT(*) - COUNT(DISTINCT (o.job_run_id, o.name)),
+	COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (o.end_time - o.start_time))) FILTER (WHERE o.status IN ($6, $7)), 0),
+	COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (o.end_time - o.start_time))) FILTER (WHERE o.status IN ($6, $7)), 0)
+FROM (
+	SELECT '` + scheduler.OperatorSensor.String() + `' AS operator_type, job_run_id, name, status, start_time, end_time FROM ` + sensorRunTableName + `
+	UNION ALL
+	SELECT '` + scheduler.OperatorTask.String() + `', job_run_id, name, status, start_time, end_time FROM ` + taskRunTableName + `
+	UNION ALL
+	SELECT '` + scheduler.OperatorHook.String() + `', job_run_id, name, status, start_time, end_time FROM ` + hookRunTableName + `
+) o JOIN job_run j ON o.job_run_id = j.id
+WHERE ` + jobRunStatsFilter + `
+GROUP BY o.operator_type
+ORDER BY o.operator_type`
+	rows, err := j.db.Query(ctx, query, append(filterArgs, scheduler.StateSuccess, scheduler.StateFailed)...)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting operator run stats", err)
+	}
+	defer rows.Close()
+
+	var operators []*scheduler.OperatorRunStats
+	for rows.Next() {
+		var operatorType string
+		var p50InSec, p95InSec float64
+		operator := &scheduler.OperatorRunStats{}
+		if err := rows.Scan(&operatorType, &operator.Runs, &operator.Retries, &p50InSec, &p95InSec); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning operator run stats", err)
+		}
+		operator.OperatorType = scheduler.OperatorType(operatorType)
+		operator.P50Duration = time.Duration(p50InSec * float64(time.Second))
+		operator.P95Duration = time.Duration(p95InSec * float64(time.Second))
+		operators = append(operators, operator)
+	}
+	return operators, nil
+}
+
+func (j *JobRunRepository) getJobRunStatsTrend(ctx context.Context, filterArgs []any) ([]*scheduler.JobRunStatsTrend, error) {
+	query := `SELECT date_trunc('day', scheduled_at AT TIME ZONE 'UTC') AS day, COUNT(*), COUNT(*) FILTER (WHERE status = $6), COUNT(*) FILTER (WHERE sla_alert)
+FROM job_run j
+WHERE ` + jobRunStatsFilter + `
+GROUP BY day
+ORDER BY day`
+	rows, err := j.db.Query(ctx, query, append(filterArgs, scheduler.StateSuccess)...)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting job run stats trend", err)
+	}
+	defer rows.Close()
+
+	var trend []*scheduler.JobRunStatsTrend
+	for rows.Next() {
+		day := &scheduler.JobRunStatsTrend{}
+		if err := rows.Scan(&day.Date, &day.TotalRuns, &day.SuccessRuns, &day.SLAMisses); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while scanning job run stats trend", err)
+		}
+		trend = append(trend, day)
+	}
+	return trend, nil
+}
+
 func NewJobRunRepository(pool *pgxpool.Pool) *JobRunRepository {
 	return &JobRunRepository{
 		db: pool,
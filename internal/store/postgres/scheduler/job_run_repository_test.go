@@ -69,6 +69,292 @@ func TestPostgresJobRunRepository(t *testing.T) {
 			assert.Equal(t, jobEndTime.UTC().Format(time.RFC1123), jobRunByID.EndTime.UTC().Format(time.RFC1123))
 		})
 	})
+	t.Run("UpdateStateManually", func(t *testing.T) {
+		t.Run("overrides the state of a running job run and ends it", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+
+			change, err := scheduler.NewJobRunStateChange("success", "optimus@example.io", "upstream incident")
+			assert.Nil(t, err)
+			err = jobRunRepo.UpdateStateManually(ctx, jobRun.ID, change)
+			assert.Nil(t, err)
+
+			jobRunByID, err := jobRunRepo.GetByID(ctx, scheduler.JobRunID(jobRun.ID))
+			assert.Nil(t, err)
+			assert.EqualValues(t, scheduler.StateSuccess, jobRunByID.State)
+			assert.True(t, jobRunByID.EndTime.Before(time.Now().Add(time.Minute)))
+			assert.Equal(t, "optimus@example.io", jobRunByID.StateChangedBy)
+			assert.Equal(t, "upstream incident", jobRunByID.StateChangeReason)
+		})
+	})
+	t.Run("RestoreState", func(t *testing.T) {
+		t.Run("sets back the state, end time and state change of the job run", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			previousRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+
+			change, err := scheduler.NewJobRunStateChange("success", "optimus@example.io", "upstream incident")
+			assert.Nil(t, err)
+			err = jobRunRepo.UpdateStateManually(ctx, previousRun.ID, change)
+			assert.Nil(t, err)
+
+			err = jobRunRepo.RestoreState(ctx, previousRun)
+			assert.Nil(t, err)
+
+			jobRunByID, err := jobRunRepo.GetByID(ctx, scheduler.JobRunID(previousRun.ID))
+			assert.Nil(t, err)
+			assert.EqualValues(t, previousRun.State, jobRunByID.State)
+			assert.Equal(t, previousRun.EndTime.UTC().Format(time.RFC1123), jobRunByID.EndTime.UTC().Format(time.RFC1123))
+			assert.Empty(t, jobRunByID.StateChangedBy)
+			assert.Empty(t, jobRunByID.StateChangeReason)
+		})
+	})
+	t.Run("GetRunsScheduledBetween", func(t *testing.T) {
+		t.Run("returns the runs of the job scheduled within the range", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			err = jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt.Add(time.Hour*24), slaDefinitionInSec)
+			assert.Nil(t, err)
+
+			jobRuns, err := jobRunRepo.GetRunsScheduledBetween(ctx, tnnt, jobAName, scheduledAt, scheduledAt.Add(time.Hour))
+			assert.Nil(t, err)
+			assert.Len(t, jobRuns, 1)
+			assert.True(t, scheduledAt.Equal(jobRuns[0].ScheduledAt))
+		})
+	})
+	t.Run("GetJobDurations", func(t *testing.T) {
+		t.Run("returns median duration of successful job runs", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			err = jobRunRepo.Update(ctx, jobRun.ID, jobRun.StartTime.Add(time.Hour), scheduler.StateSuccess)
+			assert.Nil(t, err)
+
+			durations, err := jobRunRepo.GetJobDurations(ctx, tnnt.ProjectName(), []string{jobAName}, scheduledAt.Add(-time.Hour))
+			assert.Nil(t, err)
+			assert.Len(t, durations, 1)
+			assert.InDelta(t, time.Hour.Seconds(), durations[scheduler.JobName(jobAName)].Seconds(), 1)
+
+			durations, err = jobRunRepo.GetJobDurations(ctx, tnnt.ProjectName(), []string{jobBName}, scheduledAt.Add(-time.Hour))
+			assert.Nil(t, err)
+			assert.Empty(t, durations)
+		})
+	})
+	t.Run("GetRecentDurations", func(t *testing.T) {
+		t.Run("returns durations of successful job runs scheduled before the given time", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			err = jobRunRepo.Update(ctx, jobRun.ID, jobRun.StartTime.Add(time.Hour), scheduler.StateSuccess)
+			assert.Nil(t, err)
+
+			durations, err := jobRunRepo.GetRecentDurations(ctx, tnnt, jobAName, scheduledAt.Add(time.Minute), 10)
+			assert.Nil(t, err)
+			assert.Len(t, durations, 1)
+			assert.InDelta(t, time.Hour.Seconds(), durations[0].Seconds(), 1)
+
+			durations, err = jobRunRepo.GetRecentDurations(ctx, tnnt, jobAName, scheduledAt, 10)
+			assert.Nil(t, err)
+			assert.Empty(t, durations)
+		})
+	})
+	t.Run("AddUpstreamRuns", func(t *testing.T) {
+		t.Run("links the job run with upstream runs, which are walked up and down", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			upstreamScheduledAt := scheduledAt.Add(-time.Hour)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, upstreamScheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			err = jobRunRepo.Create(ctx, tnnt, jobBName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			downstreamRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobBName, scheduledAt)
+			assert.Nil(t, err)
+
+			remoteTnnt, _ := tenant.NewTenant("remote-proj", "remote-ns")
+			upstreamRef := &scheduler.JobRunRef{JobName: jobAName, Tenant: tnnt, ScheduledAt: upstreamScheduledAt}
+			remoteRef := &scheduler.JobRunRef{JobName: "remote-job", Tenant: remoteTnnt, ScheduledAt: upstreamScheduledAt}
+			err = jobRunRepo.AddUpstreamRuns(ctx, downstreamRun.ID, []*scheduler.JobRunRef{upstreamRef, remoteRef})
+			assert.Nil(t, err)
+			err = jobRunRepo.AddUpstreamRuns(ctx, downstreamRun.ID, []*scheduler.JobRunRef{upstreamRef})
+			assert.Nil(t, err)
+
+			upstreamRuns, err := jobRunRepo.GetUpstreamRuns(ctx, downstreamRun.ID)
+			assert.Nil(t, err)
+			assert.Len(t, upstreamRuns, 2)
+			assert.Equal(t, scheduler.JobName("remote-job"), upstreamRuns[0].JobName)
+			assert.Equal(t, remoteTnnt, upstreamRuns[0].Tenant)
+			assert.Equal(t, scheduler.JobName(jobAName), upstreamRuns[1].JobName)
+			assert.True(t, upstreamScheduledAt.Equal(upstreamRuns[1].ScheduledAt))
+
+			downstreamRuns, err := jobRunRepo.GetDownstreamRuns(ctx, upstreamRef)
+			assert.Nil(t, err)
+			assert.Len(t, downstreamRuns, 1)
+			assert.Equal(t, downstreamRun.ID, downstreamRuns[0].ID)
+		})
+	})
+	t.Run("AddSkippedUpstream", func(t *testing.T) {
+		t.Run("records the optional upstreams the job run proceeded without", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobBName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobBName, scheduledAt)
+			assert.Nil(t, err)
+			assert.Empty(t, jobRun.SkippedUpstreams)
+
+			remoteTnnt, _ := tenant.NewTenant("remote-proj", "remote-ns")
+			skippedA := &scheduler.SkippedUpstream{JobName: jobAName, Tenant: tnnt, Reason: "upstream is disabled"}
+			skippedRemote := &scheduler.SkippedUpstream{JobName: "remote-job", Tenant: remoteTnnt, Reason: "max wait of 2h0m0s exceeded"}
+			err = jobRunRepo.AddSkippedUpstream(ctx, jobRun.ID, skippedA)
+			assert.Nil(t, err)
+			err = jobRunRepo.AddSkippedUpstream(ctx, jobRun.ID, skippedRemote)
+			assert.Nil(t, err)
+
+			jobRun, err = jobRunRepo.GetByID(ctx, scheduler.JobRunID(jobRun.ID))
+			assert.Nil(t, err)
+			assert.Equal(t, []*scheduler.SkippedUpstream{skippedA, skippedRemote}, jobRun.SkippedUpstreams)
+		})
+	})
+	t.Run("UpsertMetrics", func(t *testing.T) {
+		t.Run("stores the latest metrics of a run, which are returned per scheduled time", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			nextScheduledAt := scheduledAt.Add(time.Hour * 24)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			err = jobRunRepo.Create(ctx, tnnt, jobAName, nextScheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			nextJobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, nextScheduledAt)
+			assert.Nil(t, err)
+
+			err = jobRunRepo.UpsertMetrics(ctx, jobRun.ID, &scheduler.JobRunMetrics{
+				ScheduledAt: scheduledAt,
+				Values:      map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 10},
+			})
+			assert.Nil(t, err)
+			err = jobRunRepo.UpsertMetrics(ctx, jobRun.ID, &scheduler.JobRunMetrics{
+				ScheduledAt: scheduledAt,
+				Values:      map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 100, scheduler.MetricCost: 0.5},
+			})
+			assert.Nil(t, err)
+			err = jobRunRepo.UpsertMetrics(ctx, nextJobRun.ID, &scheduler.JobRunMetrics{
+				ScheduledAt: nextScheduledAt,
+				Values:      map[scheduler.MetricName]float64{scheduler.MetricSlotMillis: 3000},
+			})
+			assert.Nil(t, err)
+
+			runMetrics, err := jobRunRepo.GetMetrics(ctx, tnnt.ProjectName(), jobAName, scheduledAt, nextScheduledAt)
+			assert.Nil(t, err)
+			assert.Len(t, runMetrics, 2)
+			assert.True(t, scheduledAt.Equal(runMetrics[0].ScheduledAt))
+			assert.Equal(t, map[scheduler.MetricName]float64{scheduler.MetricRowsWritten: 100, scheduler.MetricCost: 0.5}, runMetrics[0].Values)
+			assert.Equal(t, map[scheduler.MetricName]float64{scheduler.MetricSlotMillis: 3000}, runMetrics[1].Values)
+
+			runMetrics, err = jobRunRepo.GetMetrics(ctx, tnnt.ProjectName(), jobBName, scheduledAt, nextScheduledAt)
+			assert.Nil(t, err)
+			assert.Empty(t, runMetrics)
+		})
+	})
+	t.Run("GetSuccessfulRuns", func(t *testing.T) {
+		t.Run("returns scheduled times of successful job runs within the range per job", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			err = jobRunRepo.Update(ctx, jobRun.ID, jobRun.StartTime.Add(time.Hour), scheduler.StateSuccess)
+			assert.Nil(t, err)
+			err = jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt.Add(time.Hour*24), slaDefinitionInSec)
+			assert.Nil(t, err)
+
+			successfulRuns, err := jobRunRepo.GetSuccessfulRuns(ctx, tnnt.ProjectName(), scheduledAt.Add(-time.Hour), scheduledAt.Add(time.Hour*48))
+			assert.Nil(t, err)
+			assert.Len(t, successfulRuns, 1)
+			assert.Len(t, successfulRuns[scheduler.JobName(jobAName)], 1)
+			assert.True(t, scheduledAt.Equal(successfulRuns[scheduler.JobName(jobAName)][0]))
+
+			successfulRuns, err = jobRunRepo.GetSuccessfulRuns(ctx, tnnt.ProjectName(), scheduledAt.Add(time.Minute), scheduledAt.Add(time.Hour*48))
+			assert.Nil(t, err)
+			assert.Empty(t, successfulRuns)
+		})
+	})
+	t.Run("GetJobRunStats", func(t *testing.T) {
+		t.Run("returns stats of job runs and operator runs within the range", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			err = jobRunRepo.Update(ctx, jobRun.ID, jobRun.StartTime.Add(time.Hour), scheduler.StateSuccess)
+			assert.Nil(t, err)
+
+			operatorRunRepo := postgres.NewOperatorRunRepository(db)
+			err = operatorRunRepo.CreateOperatorRun(ctx, "some-operator-name", scheduler.OperatorTask, jobRun.ID, scheduledAt)
+			assert.Nil(t, err)
+			operatorRun, err := operatorRunRepo.GetOperatorRun(ctx, "some-operator-name", scheduler.OperatorTask, jobRun.ID)
+			assert.Nil(t, err)
+			err = operatorRunRepo.UpdateOperatorRun(ctx, scheduler.OperatorTask, operatorRun.ID, scheduledAt.Add(time.Minute*10), scheduler.StateSuccess)
+			assert.Nil(t, err)
+
+			criteria, err := scheduler.NewJobRunStatsCriteria(tnnt.ProjectName(), "", jobAName, scheduledAt.Add(-time.Hour), scheduledAt.Add(time.Hour))
+			assert.Nil(t, err)
+			stats, err := jobRunRepo.GetJobRunStats(ctx, criteria)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, stats.TotalRuns)
+			assert.Equal(t, 1, stats.SuccessRuns)
+			assert.Equal(t, 0, stats.FailedRuns)
+			assert.Len(t, stats.Operators, 1)
+			assert.Equal(t, scheduler.OperatorTask, stats.Operators[0].OperatorType)
+			assert.Equal(t, 1, stats.Operators[0].Runs)
+			assert.InDelta(t, (time.Minute * 10).Seconds(), stats.Operators[0].P50Duration.Seconds(), 1)
+			assert.Len(t, stats.Trend, 1)
+			assert.Equal(t, 1, stats.Trend[0].TotalRuns)
+		})
+		t.Run("returns empty stats when no job run is scheduled within the range", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+
+			criteria, err := scheduler.NewJobRunStatsCriteria(tnnt.ProjectName(), tnnt.NamespaceName().String(), "", scheduledAt.Add(time.Hour), scheduledAt.Add(time.Hour*2))
+			assert.Nil(t, err)
+			stats, err := jobRunRepo.GetJobRunStats(ctx, criteria)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, stats.TotalRuns)
+			assert.Empty(t, stats.Operators)
+			assert.Empty(t, stats.Trend)
+		})
+	})
 	t.Run("UpdateSLA", func(t *testing.T) {
 		t.Run("updates jobs sla alert firing status", func(t *testing.T) {
 			db := dbSetup()
@@ -93,6 +379,56 @@ func TestPostgresJobRunRepository(t *testing.T) {
 			assert.True(t, jobRunByID.SLAAlert)
 		})
 	})
+	t.Run("GetRunsScheduledSince", func(t *testing.T) {
+		t.Run("returns the latest runs of the project scheduled since the given time", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			err = jobRunRepo.Create(ctx, tnnt, jobBName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			err = jobRunRepo.Create(ctx, tnnt, jobBName, scheduledAt.Add(-time.Hour*2), slaDefinitionInSec)
+			assert.Nil(t, err)
+			finishedRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobBName, scheduledAt)
+			assert.Nil(t, err)
+			err = jobRunRepo.Update(ctx, finishedRun.ID, currentTime, scheduler.StateSuccess)
+			assert.Nil(t, err)
+			err = jobRunRepo.UpdateSLADeadlineMissed(ctx, finishedRun.ID)
+			assert.Nil(t, err)
+
+			jobRuns, err := jobRunRepo.GetRunsScheduledSince(ctx, tnnt.ProjectName(), scheduledAt.Add(-time.Hour))
+			assert.Nil(t, err)
+			assert.Len(t, jobRuns, 2)
+			assert.Equal(t, jobAName, jobRuns[0].JobName.String())
+			assert.False(t, jobRuns[0].SLADeadlineMissed)
+			assert.Equal(t, jobBName, jobRuns[1].JobName.String())
+			assert.Equal(t, scheduler.StateSuccess, jobRuns[1].State)
+			assert.True(t, jobRuns[1].SLADeadlineMissed)
+		})
+	})
+	t.Run("AddSLADeadlineAlert", func(t *testing.T) {
+		t.Run("adds the alert of a scheduled time once", func(t *testing.T) {
+			db := dbSetup()
+			jobRunRepo := postgres.NewJobRunRepository(db)
+
+			added, err := jobRunRepo.AddSLADeadlineAlert(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			assert.True(t, added)
+			added, err = jobRunRepo.AddSLADeadlineAlert(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+			assert.False(t, added)
+			added, err = jobRunRepo.AddSLADeadlineAlert(ctx, tnnt, jobBName, scheduledAt.Add(-time.Hour*24))
+			assert.Nil(t, err)
+			assert.True(t, added)
+
+			alerts, err := jobRunRepo.GetSLADeadlineAlerts(ctx, tnnt.ProjectName(), scheduledAt.Add(-time.Hour))
+			assert.Nil(t, err)
+			assert.Len(t, alerts, 1)
+			assert.Len(t, alerts[jobAName], 1)
+			assert.True(t, scheduledAt.Equal(alerts[jobAName][0]))
+		})
+	})
 	t.Run("UpdateMonitoring", func(t *testing.T) {
 		t.Run("updates job run monitoring", func(t *testing.T) {
 			db := dbSetup()
@@ -30,6 +30,22 @@ type JobRunServiceClient interface {
 	RegisterJobEvent(ctx context.Context, in *RegisterJobEventRequest, opts ...grpc.CallOption) (*RegisterJobEventResponse, error)
 	// UploadToScheduler comiles jobSpec from database into DAGs and uploads the generated DAGs to scheduler
 	UploadToScheduler(ctx context.Context, in *UploadToSchedulerRequest, opts ...grpc.CallOption) (*UploadToSchedulerResponse, error)
+	// UpdateJobRunState sets the state of job runs on the scheduler and optimus, eg. to mark runs success after an incident
+	UpdateJobRunState(ctx context.Context, in *UpdateJobRunStateRequest, opts ...grpc.CallOption) (*UpdateJobRunStateResponse, error)
+	// GetCompiledJob compiles the stored job specification to the artifact deployed on the scheduler
+	GetCompiledJob(ctx context.Context, in *GetCompiledJobRequest, opts ...grpc.CallOption) (*GetCompiledJobResponse, error)
+	// ReconcileSchedulerJobs compares the jobs deployed on the scheduler with the stored jobs of the project
+	ReconcileSchedulerJobs(ctx context.Context, in *ReconcileSchedulerJobsRequest, opts ...grpc.CallOption) (*ReconcileSchedulerJobsResponse, error)
+	// GetJobRunStats calculates the stats of job runs of a job, namespace or project within a time range
+	GetJobRunStats(ctx context.Context, in *GetJobRunStatsRequest, opts ...grpc.CallOption) (*GetJobRunStatsResponse, error)
+	// GetJobRunGaps finds the scheduled times of the enabled jobs without a successful run within a time range
+	GetJobRunGaps(ctx context.Context, in *GetJobRunGapsRequest, opts ...grpc.CallOption) (*GetJobRunGapsResponse, error)
+	// GetJobRunLineage walks the upstream runs a job run consumed the data of, or the downstream runs consumed its data
+	GetJobRunLineage(ctx context.Context, in *GetJobRunLineageRequest, opts ...grpc.CallOption) (*GetJobRunLineageResponse, error)
+	// GetJobRunMetrics returns the time series of the metrics reported by the runs of a job
+	GetJobRunMetrics(ctx context.Context, in *GetJobRunMetricsRequest, opts ...grpc.CallOption) (*GetJobRunMetricsResponse, error)
+	// WatchJobRuns streams the state changes of the job runs matching the filters as they happen
+	WatchJobRuns(ctx context.Context, in *WatchJobRunsRequest, opts ...grpc.CallOption) (JobRunService_WatchJobRunsClient, error)
 }
 
 type jobRunServiceClient struct {
@@ -76,6 +92,101 @@ func (c *jobRunServiceClient) UploadToScheduler(ctx context.Context, in *UploadT
 	return out, nil
 }
 
+func (c *jobRunServiceClient) UpdateJobRunState(ctx context.Context, in *UpdateJobRunStateRequest, opts ...grpc.CallOption) (*UpdateJobRunStateResponse, error) {
+	out := new(UpdateJobRunStateResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/UpdateJobRunState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetCompiledJob(ctx context.Context, in *GetCompiledJobRequest, opts ...grpc.CallOption) (*GetCompiledJobResponse, error) {
+	out := new(GetCompiledJobResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/GetCompiledJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) ReconcileSchedulerJobs(ctx context.Context, in *ReconcileSchedulerJobsRequest, opts ...grpc.CallOption) (*ReconcileSchedulerJobsResponse, error) {
+	out := new(ReconcileSchedulerJobsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/ReconcileSchedulerJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetJobRunStats(ctx context.Context, in *GetJobRunStatsRequest, opts ...grpc.CallOption) (*GetJobRunStatsResponse, error) {
+	out := new(GetJobRunStatsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetJobRunGaps(ctx context.Context, in *GetJobRunGapsRequest, opts ...grpc.CallOption) (*GetJobRunGapsResponse, error) {
+	out := new(GetJobRunGapsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunGaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetJobRunLineage(ctx context.Context, in *GetJobRunLineageRequest, opts ...grpc.CallOption) (*GetJobRunLineageResponse, error) {
+	out := new(GetJobRunLineageResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunLineage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetJobRunMetrics(ctx context.Context, in *GetJobRunMetricsRequest, opts ...grpc.CallOption) (*GetJobRunMetricsResponse, error) {
+	out := new(GetJobRunMetricsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) WatchJobRuns(ctx context.Context, in *WatchJobRunsRequest, opts ...grpc.CallOption) (JobRunService_WatchJobRunsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JobRunService_ServiceDesc.Streams[0], "/raystack.optimus.core.v1beta1.JobRunService/WatchJobRuns", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobRunServiceWatchJobRunsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JobRunService_WatchJobRunsClient interface {
+	Recv() (*WatchJobRunsResponse, error)
+	grpc.ClientStream
+}
+
+type jobRunServiceWatchJobRunsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobRunServiceWatchJobRunsClient) Recv() (*WatchJobRunsResponse, error) {
+	m := new(WatchJobRunsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // JobRunServiceServer is the server API for JobRunService service.
 // All implementations must embed UnimplementedJobRunServiceServer
 // for forward compatibility
@@ -88,6 +199,22 @@ type JobRunServiceServer interface {
 	RegisterJobEvent(context.Context, *RegisterJobEventRequest) (*RegisterJobEventResponse, error)
 	// UploadToScheduler comiles jobSpec from database into DAGs and uploads the generated DAGs to scheduler
 	UploadToScheduler(context.Context, *UploadToSchedulerRequest) (*UploadToSchedulerResponse, error)
+	// UpdateJobRunState sets the state of job runs on the scheduler and optimus, eg. to mark runs success after an incident
+	UpdateJobRunState(context.Context, *UpdateJobRunStateRequest) (*UpdateJobRunStateResponse, error)
+	// GetCompiledJob compiles the stored job specification to the artifact deployed on the scheduler
+	GetCompiledJob(context.Context, *GetCompiledJobRequest) (*GetCompiledJobResponse, error)
+	// ReconcileSchedulerJobs compares the jobs deployed on the scheduler with the stored jobs of the project
+	ReconcileSchedulerJobs(context.Context, *ReconcileSchedulerJobsRequest) (*ReconcileSchedulerJobsResponse, error)
+	// GetJobRunStats calculates the stats of job runs of a job, namespace or project within a time range
+	GetJobRunStats(context.Context, *GetJobRunStatsRequest) (*GetJobRunStatsResponse, error)
+	// GetJobRunGaps finds the scheduled times of the enabled jobs without a successful run within a time range
+	GetJobRunGaps(context.Context, *GetJobRunGapsRequest) (*GetJobRunGapsResponse, error)
+	// GetJobRunLineage walks the upstream runs a job run consumed the data of, or the downstream runs consumed its data
+	GetJobRunLineage(context.Context, *GetJobRunLineageRequest) (*GetJobRunLineageResponse, error)
+	// GetJobRunMetrics returns the time series of the metrics reported by the runs of a job
+	GetJobRunMetrics(context.Context, *GetJobRunMetricsRequest) (*GetJobRunMetricsResponse, error)
+	// WatchJobRuns streams the state changes of the job runs matching the filters as they happen
+	WatchJobRuns(*WatchJobRunsRequest, JobRunService_WatchJobRunsServer) error
 	mustEmbedUnimplementedJobRunServiceServer()
 }
 
@@ -107,6 +234,30 @@ func (UnimplementedJobRunServiceServer) RegisterJobEvent(context.Context, *Regis
 func (UnimplementedJobRunServiceServer) UploadToScheduler(context.Context, *UploadToSchedulerRequest) (*UploadToSchedulerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadToScheduler not implemented")
 }
+func (UnimplementedJobRunServiceServer) UpdateJobRunState(context.Context, *UpdateJobRunStateRequest) (*UpdateJobRunStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobRunState not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetCompiledJob(context.Context, *GetCompiledJobRequest) (*GetCompiledJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompiledJob not implemented")
+}
+func (UnimplementedJobRunServiceServer) ReconcileSchedulerJobs(context.Context, *ReconcileSchedulerJobsRequest) (*ReconcileSchedulerJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileSchedulerJobs not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetJobRunStats(context.Context, *GetJobRunStatsRequest) (*GetJobRunStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunStats not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetJobRunGaps(context.Context, *GetJobRunGapsRequest) (*GetJobRunGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunGaps not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetJobRunLineage(context.Context, *GetJobRunLineageRequest) (*GetJobRunLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunLineage not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetJobRunMetrics(context.Context, *GetJobRunMetricsRequest) (*GetJobRunMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunMetrics not implemented")
+}
+func (UnimplementedJobRunServiceServer) WatchJobRuns(*WatchJobRunsRequest, JobRunService_WatchJobRunsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobRuns not implemented")
+}
 func (UnimplementedJobRunServiceServer) mustEmbedUnimplementedJobRunServiceServer() {}
 
 // UnsafeJobRunServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +343,153 @@ func _JobRunService_UploadToScheduler_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobRunService_UpdateJobRunState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobRunStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).UpdateJobRunState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/UpdateJobRunState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).UpdateJobRunState(ctx, req.(*UpdateJobRunStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetCompiledJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompiledJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetCompiledJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/GetCompiledJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetCompiledJob(ctx, req.(*GetCompiledJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_ReconcileSchedulerJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileSchedulerJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).ReconcileSchedulerJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/ReconcileSchedulerJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).ReconcileSchedulerJobs(ctx, req.(*ReconcileSchedulerJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetJobRunStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetJobRunStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetJobRunStats(ctx, req.(*GetJobRunStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetJobRunGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetJobRunGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunGaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetJobRunGaps(ctx, req.(*GetJobRunGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetJobRunLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetJobRunLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunLineage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetJobRunLineage(ctx, req.(*GetJobRunLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetJobRunMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetJobRunMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobRunService/GetJobRunMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetJobRunMetrics(ctx, req.(*GetJobRunMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_WatchJobRuns_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobRunsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobRunServiceServer).WatchJobRuns(m, &jobRunServiceWatchJobRunsServer{stream})
+}
+
+type JobRunService_WatchJobRunsServer interface {
+	Send(*WatchJobRunsResponse) error
+	grpc.ServerStream
+}
+
+type jobRunServiceWatchJobRunsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobRunServiceWatchJobRunsServer) Send(m *WatchJobRunsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // JobRunService_ServiceDesc is the grpc.ServiceDesc for JobRunService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,7 +513,41 @@ var JobRunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadToScheduler",
 			Handler:    _JobRunService_UploadToScheduler_Handler,
 		},
+		{
+			MethodName: "UpdateJobRunState",
+			Handler:    _JobRunService_UpdateJobRunState_Handler,
+		},
+		{
+			MethodName: "GetCompiledJob",
+			Handler:    _JobRunService_GetCompiledJob_Handler,
+		},
+		{
+			MethodName: "ReconcileSchedulerJobs",
+			Handler:    _JobRunService_ReconcileSchedulerJobs_Handler,
+		},
+		{
+			MethodName: "GetJobRunStats",
+			Handler:    _JobRunService_GetJobRunStats_Handler,
+		},
+		{
+			MethodName: "GetJobRunGaps",
+			Handler:    _JobRunService_GetJobRunGaps_Handler,
+		},
+		{
+			MethodName: "GetJobRunLineage",
+			Handler:    _JobRunService_GetJobRunLineage_Handler,
+		},
+		{
+			MethodName: "GetJobRunMetrics",
+			Handler:    _JobRunService_GetJobRunMetrics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchJobRuns",
+			Handler:       _JobRunService_WatchJobRuns_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "raystack/optimus/core/v1beta1/job_run.proto",
 }
@@ -67,6 +67,18 @@ type JobSpecificationServiceClient interface {
 	UpdateJobsState(ctx context.Context, in *UpdateJobsStateRequest, opts ...grpc.CallOption) (*UpdateJobsStateResponse, error)
 	// SyncJobsState enable / disable job on scheuler
 	SyncJobsState(ctx context.Context, in *SyncJobsStateRequest, opts ...grpc.CallOption) (*SyncJobsStateResponse, error)
+	// GetDisabledJobs returns the disabled jobs along with who disabled them, why, since when and until when
+	GetDisabledJobs(ctx context.Context, in *GetDisabledJobsRequest, opts ...grpc.CallOption) (*GetDisabledJobsResponse, error)
+	// GetJobSpecificationHistory returns every version of a job specification written by a change
+	GetJobSpecificationHistory(ctx context.Context, in *GetJobSpecificationHistoryRequest, opts ...grpc.CallOption) (*GetJobSpecificationHistoryResponse, error)
+	// RollbackJobSpecification re-applies a previous version of a job specification
+	RollbackJobSpecification(ctx context.Context, in *RollbackJobSpecificationRequest, opts ...grpc.CallOption) (*RollbackJobSpecificationResponse, error)
+	// RegisterTemplateLibrary registers a new version of the template library to be used by job assets
+	RegisterTemplateLibrary(ctx context.Context, in *RegisterTemplateLibraryRequest, opts ...grpc.CallOption) (*RegisterTemplateLibraryResponse, error)
+	// GetTemplateLibraries returns the template libraries of a project or a namespace
+	GetTemplateLibraries(ctx context.Context, in *GetTemplateLibrariesRequest, opts ...grpc.CallOption) (*GetTemplateLibrariesResponse, error)
+	// GetTemplateFunctions returns the documentation of the functions available to templates
+	GetTemplateFunctions(ctx context.Context, in *GetTemplateFunctionsRequest, opts ...grpc.CallOption) (*GetTemplateFunctionsResponse, error)
 }
 
 type jobSpecificationServiceClient struct {
@@ -338,6 +350,60 @@ func (c *jobSpecificationServiceClient) SyncJobsState(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *jobSpecificationServiceClient) GetDisabledJobs(ctx context.Context, in *GetDisabledJobsRequest, opts ...grpc.CallOption) (*GetDisabledJobsResponse, error) {
+	out := new(GetDisabledJobsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobSpecificationService/GetDisabledJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) GetJobSpecificationHistory(ctx context.Context, in *GetJobSpecificationHistoryRequest, opts ...grpc.CallOption) (*GetJobSpecificationHistoryResponse, error) {
+	out := new(GetJobSpecificationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobSpecificationService/GetJobSpecificationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) RollbackJobSpecification(ctx context.Context, in *RollbackJobSpecificationRequest, opts ...grpc.CallOption) (*RollbackJobSpecificationResponse, error) {
+	out := new(RollbackJobSpecificationResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobSpecificationService/RollbackJobSpecification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) RegisterTemplateLibrary(ctx context.Context, in *RegisterTemplateLibraryRequest, opts ...grpc.CallOption) (*RegisterTemplateLibraryResponse, error) {
+	out := new(RegisterTemplateLibraryResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobSpecificationService/RegisterTemplateLibrary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) GetTemplateLibraries(ctx context.Context, in *GetTemplateLibrariesRequest, opts ...grpc.CallOption) (*GetTemplateLibrariesResponse, error) {
+	out := new(GetTemplateLibrariesResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobSpecificationService/GetTemplateLibraries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) GetTemplateFunctions(ctx context.Context, in *GetTemplateFunctionsRequest, opts ...grpc.CallOption) (*GetTemplateFunctionsResponse, error) {
+	out := new(GetTemplateFunctionsResponse)
+	err := c.cc.Invoke(ctx, "/raystack.optimus.core.v1beta1.JobSpecificationService/GetTemplateFunctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobSpecificationServiceServer is the server API for JobSpecificationService service.
 // All implementations must embed UnimplementedJobSpecificationServiceServer
 // for forward compatibility
@@ -387,6 +453,18 @@ type JobSpecificationServiceServer interface {
 	UpdateJobsState(context.Context, *UpdateJobsStateRequest) (*UpdateJobsStateResponse, error)
 	// SyncJobsState enable / disable job on scheuler
 	SyncJobsState(context.Context, *SyncJobsStateRequest) (*SyncJobsStateResponse, error)
+	// GetDisabledJobs returns the disabled jobs along with who disabled them, why, since when and until when
+	GetDisabledJobs(context.Context, *GetDisabledJobsRequest) (*GetDisabledJobsResponse, error)
+	// GetJobSpecificationHistory returns every version of a job specification written by a change
+	GetJobSpecificationHistory(context.Context, *GetJobSpecificationHistoryRequest) (*GetJobSpecificationHistoryResponse, error)
+	// RollbackJobSpecification re-applies a previous version of a job specification
+	RollbackJobSpecification(context.Context, *RollbackJobSpecificationRequest) (*RollbackJobSpecificationResponse, error)
+	// RegisterTemplateLibrary registers a new version of the template library to be used by job assets
+	RegisterTemplateLibrary(context.Context, *RegisterTemplateLibraryRequest) (*RegisterTemplateLibraryResponse, error)
+	// GetTemplateLibraries returns the template libraries of a project or a namespace
+	GetTemplateLibraries(context.Context, *GetTemplateLibrariesRequest) (*GetTemplateLibrariesResponse, error)
+	// GetTemplateFunctions returns the documentation of the functions available to templates
+	GetTemplateFunctions(context.Context, *GetTemplateFunctionsRequest) (*GetTemplateFunctionsResponse, error)
 	mustEmbedUnimplementedJobSpecificationServiceServer()
 }
 
@@ -451,6 +529,24 @@ func (UnimplementedJobSpecificationServiceServer) UpdateJobsState(context.Contex
 func (UnimplementedJobSpecificationServiceServer) SyncJobsState(context.Context, *SyncJobsStateRequest) (*SyncJobsStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncJobsState not implemented")
 }
+func (UnimplementedJobSpecificationServiceServer) GetDisabledJobs(context.Context, *GetDisabledJobsRequest) (*GetDisabledJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDisabledJobs not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) GetJobSpecificationHistory(context.Context, *GetJobSpecificationHistoryRequest) (*GetJobSpecificationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSpecificationHistory not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) RollbackJobSpecification(context.Context, *RollbackJobSpecificationRequest) (*RollbackJobSpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackJobSpecification not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) RegisterTemplateLibrary(context.Context, *RegisterTemplateLibraryRequest) (*RegisterTemplateLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTemplateLibrary not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) GetTemplateLibraries(context.Context, *GetTemplateLibrariesRequest) (*GetTemplateLibrariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateLibraries not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) GetTemplateFunctions(context.Context, *GetTemplateFunctionsRequest) (*GetTemplateFunctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateFunctions not implemented")
+}
 func (UnimplementedJobSpecificationServiceServer) mustEmbedUnimplementedJobSpecificationServiceServer() {
 }
 
@@ -829,6 +925,114 @@ func _JobSpecificationService_SyncJobsState_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobSpecificationService_GetDisabledJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisabledJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).GetDisabledJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobSpecificationService/GetDisabledJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).GetDisabledJobs(ctx, req.(*GetDisabledJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_GetJobSpecificationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobSpecificationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).GetJobSpecificationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobSpecificationService/GetJobSpecificationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).GetJobSpecificationHistory(ctx, req.(*GetJobSpecificationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_RollbackJobSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackJobSpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).RollbackJobSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobSpecificationService/RollbackJobSpecification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).RollbackJobSpecification(ctx, req.(*RollbackJobSpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_RegisterTemplateLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTemplateLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).RegisterTemplateLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobSpecificationService/RegisterTemplateLibrary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).RegisterTemplateLibrary(ctx, req.(*RegisterTemplateLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_GetTemplateLibraries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateLibrariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).GetTemplateLibraries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobSpecificationService/GetTemplateLibraries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).GetTemplateLibraries(ctx, req.(*GetTemplateLibrariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_GetTemplateFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateFunctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).GetTemplateFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/raystack.optimus.core.v1beta1.JobSpecificationService/GetTemplateFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).GetTemplateFunctions(ctx, req.(*GetTemplateFunctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobSpecificationService_ServiceDesc is the grpc.ServiceDesc for JobSpecificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -896,6 +1100,30 @@ var JobSpecificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncJobsState",
 			Handler:    _JobSpecificationService_SyncJobsState_Handler,
 		},
+		{
+			MethodName: "GetDisabledJobs",
+			Handler:    _JobSpecificationService_GetDisabledJobs_Handler,
+		},
+		{
+			MethodName: "GetJobSpecificationHistory",
+			Handler:    _JobSpecificationService_GetJobSpecificationHistory_Handler,
+		},
+		{
+			MethodName: "RollbackJobSpecification",
+			Handler:    _JobSpecificationService_RollbackJobSpecification_Handler,
+		},
+		{
+			MethodName: "RegisterTemplateLibrary",
+			Handler:    _JobSpecificationService_RegisterTemplateLibrary_Handler,
+		},
+		{
+			MethodName: "GetTemplateLibraries",
+			Handler:    _JobSpecificationService_GetTemplateLibraries_Handler,
+		},
+		{
+			MethodName: "GetTemplateFunctions",
+			Handler:    _JobSpecificationService_GetTemplateFunctions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -28,7 +28,6 @@ import (
 	rHandler "github.com/raystack/optimus/core/resource/handler/v1beta1"
 	rService "github.com/raystack/optimus/core/resource/service"
 	schedulerHandler "github.com/raystack/optimus/core/scheduler/handler/v1beta1"
-	schedulerResolver "github.com/raystack/optimus/core/scheduler/resolver"
 	schedulerService "github.com/raystack/optimus/core/scheduler/service"
 	tHandler "github.com/raystack/optimus/core/tenant/handler/v1beta1"
 	tService "github.com/raystack/optimus/core/tenant/service"
@@ -286,7 +285,7 @@ func (s *OptimusServer) setupHandlers() error {
 	// Scheduler bounded context
 	jobRunRepo := schedulerRepo.NewJobRunRepository(s.dbPool)
 	operatorRunRepository := schedulerRepo.NewOperatorRunRepository(s.dbPool)
-	jobProviderRepo := schedulerRepo.NewJobProviderRepository(s.dbPool)
+	jobProviderRepo := schedulerRepo.NewJobProviderRepository(s.dbPool, tProjectService)
 
 	notificationContext, cancelNotifiers := context.WithCancel(context.Background())
 	s.cleanupFn = append(s.cleanupFn, cancelNotifiers)
@@ -310,11 +309,14 @@ func (s *OptimusServer) setupHandlers() error {
 
 	newEngine := compiler.NewEngine()
 
-	newPriorityResolver := schedulerResolver.NewSimpleResolver()
-	assetCompiler := schedulerService.NewJobAssetsCompiler(newEngine, s.pluginRepo, s.logger)
+	newPriorityResolver, err := NewPriorityResolver(s.conf.Scheduler.PriorityResolver, jobProviderRepo, jobRunRepo)
+	if err != nil {
+		return err
+	}
+	assetCompiler := schedulerService.NewJobAssetsCompiler(newEngine, s.pluginRepo, jobProviderRepo, s.logger)
 	jobInputCompiler := schedulerService.NewJobInputCompiler(tenantService, newEngine, assetCompiler, s.logger)
-	notificationService := schedulerService.NewNotifyService(s.logger, jobProviderRepo, tenantService, notifierChanels)
-	newScheduler, err := NewScheduler(s.logger, s.conf, s.pluginRepo, tProjectService, tSecretService)
+	notificationService := schedulerService.NewNotifyService(s.logger, jobProviderRepo, jobRunRepo, tenantService, notifierChanels)
+	newScheduler, err := NewScheduler(s.logger, s.conf, s.pluginRepo, tProjectService, tSecretService, tenantService)
 	if err != nil {
 		return err
 	}
@@ -328,15 +330,23 @@ func (s *OptimusServer) setupHandlers() error {
 	replayValidator := schedulerService.NewValidator(replayRepository, newScheduler, jobProviderRepo)
 	replayService := schedulerService.NewReplayService(replayRepository, jobProviderRepo, replayValidator, s.logger)
 
-	newJobRunService := schedulerService.NewJobRunService(s.logger, jobProviderRepo, jobRunRepo, replayRepository, operatorRunRepository, newScheduler, newPriorityResolver, jobInputCompiler, s.eventHandler)
+	jobRunWatcher := schedulerService.NewJobRunWatcher(s.logger, jobProviderRepo, s.eventHandler)
+	newJobRunService := schedulerService.NewJobRunService(s.logger, jobProviderRepo, jobRunRepo, replayRepository, operatorRunRepository, newScheduler, newPriorityResolver, jobInputCompiler, jobRunWatcher)
+	reconcileService := schedulerService.NewReconcileService(s.logger, jobProviderRepo, tNamespaceService, newScheduler, newPriorityResolver)
+	reconcileManager := schedulerService.NewReconcileManager(s.logger, tProjectService, reconcileService, s.conf.Scheduler.DriftCheckInterval)
+	slaDeadlineChecker := schedulerService.NewSLADeadlineChecker(s.logger, tProjectService, jobProviderRepo, jobRunRepo, notificationService, s.conf.Scheduler.SLADeadlineCheckInterval)
+	gapDetection := s.conf.Scheduler.GapDetection
+	jobRunGapManager := schedulerService.NewJobRunGapManager(s.logger, tProjectService, newJobRunService, notificationService,
+		gapDetection.Interval, gapDetection.Lookback, gapDetection.GracePeriod)
 
 	// Job Bounded Context Setup
 	jJobRepo := jRepo.NewJobRepository(s.dbPool)
-	jPluginService := jService.NewJobPluginService(s.pluginRepo, newEngine, s.logger)
+	jPluginService := jService.NewJobPluginService(s.pluginRepo, jJobRepo, newEngine, s.logger)
 	jExternalUpstreamResolver, _ := jResolver.NewExternalUpstreamResolver(s.conf.ResourceManagers)
 	jInternalUpstreamResolver := jResolver.NewInternalUpstreamResolver(jJobRepo)
 	jUpstreamResolver := jResolver.NewUpstreamResolver(jJobRepo, jExternalUpstreamResolver, jInternalUpstreamResolver)
 	jJobService := jService.NewJobService(jJobRepo, jJobRepo, jJobRepo, jPluginService, jUpstreamResolver, tenantService, s.eventHandler, s.logger, newJobRunService, newScheduler)
+	jobEnabler := jService.NewJobEnabler(s.logger, jJobRepo, jJobService, s.conf.Scheduler.JobEnableCheckInterval)
 
 	// Resource Bounded Context
 	resourceRepository := resource.NewRepository(s.dbPool)
@@ -358,7 +368,7 @@ func (s *OptimusServer) setupHandlers() error {
 	// Resource Handler
 	pb.RegisterResourceServiceServer(s.grpcServer, rHandler.NewResourceHandler(s.logger, resourceService))
 
-	pb.RegisterJobRunServiceServer(s.grpcServer, schedulerHandler.NewJobRunHandler(s.logger, newJobRunService, notificationService))
+	pb.RegisterJobRunServiceServer(s.grpcServer, schedulerHandler.NewJobRunHandler(s.logger, newJobRunService, notificationService, reconcileService, jobRunWatcher))
 
 	// backup service
 	pb.RegisterBackupServiceServer(s.grpcServer, rHandler.NewBackupHandler(s.logger, backupService))
@@ -371,7 +381,15 @@ func (s *OptimusServer) setupHandlers() error {
 
 	pb.RegisterReplayServiceServer(s.grpcServer, schedulerHandler.NewReplayHandler(s.logger, replayService))
 	replayManager.Initialize()
-
+	reconcileManager.Initialize()
+	slaDeadlineChecker.Initialize()
+	jobRunGapManager.Initialize()
+	jobEnabler.Initialize()
+
+	s.cleanupFn = append(s.cleanupFn, reconcileManager.Close)
+	s.cleanupFn = append(s.cleanupFn, slaDeadlineChecker.Close)
+	s.cleanupFn = append(s.cleanupFn, jobRunGapManager.Close)
+	s.cleanupFn = append(s.cleanupFn, jobEnabler.Close)
 	s.cleanupFn = append(s.cleanupFn, func() {
 		err = notificationService.Close()
 		if err != nil {
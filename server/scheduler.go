@@ -1,16 +1,21 @@
 package server
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/raystack/salt/log"
 
 	"github.com/raystack/optimus/config"
+	"github.com/raystack/optimus/core/scheduler/resolver"
+	"github.com/raystack/optimus/core/scheduler/service"
 	"github.com/raystack/optimus/ext/scheduler/airflow"
 	"github.com/raystack/optimus/ext/scheduler/airflow/bucket"
 	"github.com/raystack/optimus/ext/scheduler/airflow/dag"
 )
 
 func NewScheduler(l log.Logger, conf *config.ServerConfig, pluginRepo dag.PluginRepo, projecGetter airflow.ProjectGetter,
-	secretGetter airflow.SecretGetter,
+	secretGetter airflow.SecretGetter, tenantGetter airflow.TenantGetter,
 ) (*airflow.Scheduler, error) {
 	bucketFactory := bucket.NewFactory(projecGetter, secretGetter)
 
@@ -20,6 +25,19 @@ func NewScheduler(l log.Logger, conf *config.ServerConfig, pluginRepo dag.Plugin
 	}
 
 	client := airflow.NewAirflowClient()
-	scheduler := airflow.NewScheduler(l, bucketFactory, client, dagCompiler, projecGetter, secretGetter)
+	scheduler := airflow.NewScheduler(l, bucketFactory, client, dagCompiler, projecGetter, secretGetter, tenantGetter)
 	return scheduler, nil
 }
+
+func NewPriorityResolver(conf config.PriorityResolverConfig, jobRepo resolver.JobRepository, durationRepo resolver.JobRunDurationRepository) (service.PriorityResolver, error) {
+	switch conf.Type {
+	case "", "simple":
+		return resolver.NewSimpleResolver(), nil
+	case "critical_path":
+		return resolver.NewCriticalPathResolver(jobRepo, durationRepo, conf.DurationLookback, func() time.Time {
+			return time.Now().UTC()
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown priority resolver type: %s", conf.Type)
+	}
+}
@@ -41,6 +41,7 @@ func BenchmarkJobRepository(b *testing.B) {
 	assert.NoError(b, err)
 
 	ctx := context.Background()
+	change := serviceJob.NewSpecChange("", serviceJob.ChangeSourceAPI)
 	dbSetup := func(b *testing.B) *pgxpool.Pool {
 		b.Helper()
 
@@ -74,7 +75,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				jobs[j] = setup.NewDummyJobBuilder().OverrideName(jobName).Build(tnnt)
 			}
 
-			actualStoredJobs, actualError := repo.Add(ctx, jobs)
+			actualStoredJobs, actualError := repo.Add(ctx, jobs, change)
 			assert.Len(b, actualStoredJobs, maxNumberOfJobs)
 			assert.NoError(b, actualError)
 		}
@@ -91,14 +92,14 @@ func BenchmarkJobRepository(b *testing.B) {
 
 			jobs[i] = setup.NewDummyJobBuilder().OverrideName(jobName).Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
 		b.ResetTimer()
 
 		for i := 0; i < b.N; i++ {
-			actualUpdatedJobs, actualError := repo.Update(ctx, jobs)
+			actualUpdatedJobs, actualError := repo.Update(ctx, jobs, change)
 			assert.Len(b, actualUpdatedJobs, maxNumberOfJobs)
 			assert.NoError(b, actualError)
 		}
@@ -141,7 +142,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				jobTreatedAsStaticUpstream,
 				jobTreatedAsInferredUpstream,
 				currentJob,
-			})
+			}, change)
 			assert.Len(b, storedJobs, 3)
 			assert.NoError(b, err)
 
@@ -172,7 +173,7 @@ func BenchmarkJobRepository(b *testing.B) {
 
 			jobs[i] = setup.NewDummyJobBuilder().OverrideName(jobName).Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -199,7 +200,7 @@ func BenchmarkJobRepository(b *testing.B) {
 
 			jobs[i] = setup.NewDummyJobBuilder().OverrideName(jobName).Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -227,7 +228,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideDestinationURN(destination).
 				Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -258,7 +259,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideDestinationURN(destination).
 				Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -300,7 +301,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideName(jobName).
 				Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -332,7 +333,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideName(jobName).
 				Build(tnnt)
 		}
-		storedJobs, err := repo.Add(ctx, jobs)
+		storedJobs, err := repo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -353,7 +354,7 @@ func BenchmarkJobRepository(b *testing.B) {
 		assert.NoError(b, err)
 		currentJob := setup.NewDummyJobBuilder().OverrideName(jobName).Build(tnnt)
 
-		_, err = repo.Add(ctx, []*serviceJob.Job{currentJob})
+		_, err = repo.Add(ctx, []*serviceJob.Job{currentJob}, change)
 		assert.NoError(b, err)
 
 		upstreams := make([]*serviceJob.Upstream, maxNumberOfUpstreams)
@@ -387,7 +388,7 @@ func BenchmarkJobRepository(b *testing.B) {
 			OverrideDestinationURN(rootJobDestination).
 			Build(tnnt)
 
-		_, err = repo.Add(ctx, []*serviceJob.Job{rootJob})
+		_, err = repo.Add(ctx, []*serviceJob.Job{rootJob}, change)
 		assert.NoError(b, err)
 
 		for i := 0; i < maxNumberOfDownstreams; i++ {
@@ -401,7 +402,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideSourceURNs([]serviceJob.ResourceURN{rootJobDestination}).
 				Build(tnnt)
 
-			_, err = repo.Add(ctx, []*serviceJob.Job{currentJob})
+			_, err = repo.Add(ctx, []*serviceJob.Job{currentJob}, change)
 			assert.NoError(b, err)
 		}
 
@@ -426,7 +427,7 @@ func BenchmarkJobRepository(b *testing.B) {
 			OverrideDestinationURN(rootJobDestination).
 			Build(tnnt)
 
-		_, err = repo.Add(ctx, []*serviceJob.Job{rootJob})
+		_, err = repo.Add(ctx, []*serviceJob.Job{rootJob}, change)
 		assert.NoError(b, err)
 
 		for i := 0; i < maxNumberOfDownstreams; i++ {
@@ -441,7 +442,7 @@ func BenchmarkJobRepository(b *testing.B) {
 					serviceJob.SpecUpstreamName(rootJobName),
 				}).Build(tnnt)
 
-			_, err = repo.Add(ctx, []*serviceJob.Job{currentJob})
+			_, err = repo.Add(ctx, []*serviceJob.Job{currentJob}, change)
 			assert.NoError(b, err)
 
 			upstream := serviceJob.NewUpstreamUnresolvedStatic(rootJobName, tnnt.ProjectName())
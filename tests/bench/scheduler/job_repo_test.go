@@ -13,6 +13,7 @@ import (
 	serviceJob "github.com/raystack/optimus/core/job"
 	serviceScheduler "github.com/raystack/optimus/core/scheduler"
 	serviceTenant "github.com/raystack/optimus/core/tenant"
+	tenantService "github.com/raystack/optimus/core/tenant/service"
 	repoJob "github.com/raystack/optimus/internal/store/postgres/job"
 	repoScheduler "github.com/raystack/optimus/internal/store/postgres/scheduler"
 	repoTenant "github.com/raystack/optimus/internal/store/postgres/tenant"
@@ -37,6 +38,7 @@ func BenchmarkJobRepository(b *testing.B) {
 	assert.NoError(b, err)
 
 	ctx := context.Background()
+	change := serviceJob.NewSpecChange("", serviceJob.ChangeSourceAPI)
 
 	dbSetup := func(b *testing.B) *pgxpool.Pool {
 		b.Helper()
@@ -58,7 +60,7 @@ func BenchmarkJobRepository(b *testing.B) {
 	b.Run("GetJob", func(b *testing.B) {
 		db := dbSetup(b)
 		jobRepo := repoJob.NewJobRepository(db)
-		schedulerJobRepo := repoScheduler.NewJobProviderRepository(db)
+		schedulerJobRepo := repoScheduler.NewJobProviderRepository(db, tenantService.NewProjectService(repoTenant.NewProjectRepository(db)))
 		jobs := make([]*serviceJob.Job, maxNumberOfJobs)
 		for i := 0; i < maxNumberOfJobs; i++ {
 			name := fmt.Sprintf("job_test_%d", i)
@@ -69,7 +71,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideName(jobName).
 				Build(tnnt)
 		}
-		storedJobs, err := jobRepo.Add(ctx, jobs)
+		storedJobs, err := jobRepo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -90,7 +92,7 @@ func BenchmarkJobRepository(b *testing.B) {
 	b.Run("GetJobDetails", func(b *testing.B) {
 		db := dbSetup(b)
 		jobRepo := repoJob.NewJobRepository(db)
-		schedulerJobRepo := repoScheduler.NewJobProviderRepository(db)
+		schedulerJobRepo := repoScheduler.NewJobProviderRepository(db, tenantService.NewProjectService(repoTenant.NewProjectRepository(db)))
 
 		jobs := make([]*serviceJob.Job, maxNumberOfJobs)
 		for i := 0; i < maxNumberOfJobs; i++ {
@@ -102,7 +104,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideName(jobName).
 				Build(tnnt)
 		}
-		storedJobs, err := jobRepo.Add(ctx, jobs)
+		storedJobs, err := jobRepo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
@@ -123,7 +125,7 @@ func BenchmarkJobRepository(b *testing.B) {
 	b.Run("GetAll", func(b *testing.B) {
 		db := dbSetup(b)
 		jobRepo := repoJob.NewJobRepository(db)
-		schedulerJobRepo := repoScheduler.NewJobProviderRepository(db)
+		schedulerJobRepo := repoScheduler.NewJobProviderRepository(db, tenantService.NewProjectService(repoTenant.NewProjectRepository(db)))
 
 		jobs := make([]*serviceJob.Job, maxNumberOfJobs)
 		for i := 0; i < maxNumberOfJobs; i++ {
@@ -135,7 +137,7 @@ func BenchmarkJobRepository(b *testing.B) {
 				OverrideName(jobName).
 				Build(tnnt)
 		}
-		storedJobs, err := jobRepo.Add(ctx, jobs)
+		storedJobs, err := jobRepo.Add(ctx, jobs, change)
 		assert.Len(b, storedJobs, maxNumberOfJobs)
 		assert.NoError(b, err)
 
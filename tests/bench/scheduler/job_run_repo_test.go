@@ -38,6 +38,7 @@ func BenchmarkJobRunRepository(b *testing.B) {
 	assert.NoError(b, err)
 
 	ctx := context.Background()
+	change := serviceJob.NewSpecChange("", serviceJob.ChangeSourceAPI)
 
 	dbSetup := func(b *testing.B) *pgxpool.Pool {
 		b.Helper()
@@ -62,7 +63,7 @@ func BenchmarkJobRunRepository(b *testing.B) {
 		schedulerJobRunRepo := repoScheduler.NewJobRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -85,7 +86,7 @@ func BenchmarkJobRunRepository(b *testing.B) {
 		schedulerJobRunRepo := repoScheduler.NewJobRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -121,7 +122,7 @@ func BenchmarkJobRunRepository(b *testing.B) {
 		schedulerJobRunRepo := repoScheduler.NewJobRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -159,7 +160,7 @@ func BenchmarkJobRunRepository(b *testing.B) {
 		schedulerJobRunRepo := repoScheduler.NewJobRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -189,7 +190,7 @@ func BenchmarkJobRunRepository(b *testing.B) {
 		schedulerJobRunRepo := repoScheduler.NewJobRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -38,6 +38,7 @@ func BenchmarkOperatorRunRepository(b *testing.B) {
 	assert.NoError(b, err)
 
 	ctx := context.Background()
+	change := serviceJob.NewSpecChange("", serviceJob.ChangeSourceAPI)
 
 	dbSetup := func(b *testing.B) *pgxpool.Pool {
 		b.Helper()
@@ -63,7 +64,7 @@ func BenchmarkOperatorRunRepository(b *testing.B) {
 		schedulerOperatorRunRepo := repoScheduler.NewOperatorRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -95,7 +96,7 @@ func BenchmarkOperatorRunRepository(b *testing.B) {
 		schedulerOperatorRunRepo := repoScheduler.NewOperatorRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
@@ -139,7 +140,7 @@ func BenchmarkOperatorRunRepository(b *testing.B) {
 		schedulerOperatorRunRepo := repoScheduler.NewOperatorRunRepository(db)
 
 		job := setup.NewDummyJobBuilder().Build(tnnt)
-		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job})
+		storedJobs, err := jobRepo.Add(ctx, []*serviceJob.Job{job}, change)
 		assert.Len(b, storedJobs, 1)
 		assert.NoError(b, err)
 
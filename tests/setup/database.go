@@ -137,11 +137,15 @@ func TruncateTablesWith(pool *pgxpool.Pool) {
 	pool.Exec(ctx, "TRUNCATE TABLE resource CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE job_run CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_run_upstream CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_run_metric CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE sla_deadline_alert CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE sensor_run CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE task_run CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE hook_run CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE job CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE template_library CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE secret CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE namespace CASCADE")